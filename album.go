@@ -0,0 +1,207 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// AlbumGroupingTimeout is how long to wait for all the media of an album to arrive when Client.EnableAlbumGrouping is set.
+var AlbumGroupingTimeout = 1 * time.Minute
+
+// SendAlbum sends the given image and video messages as a single album, like the official clients do when
+// selecting multiple media at once. The media messages can be built with Client.BuildImage and Client.BuildVideo.
+//
+// The album message itself is sent first, followed by each media message with a reference to the album.
+// The returned slice contains the responses of the media messages. If sending one of them fails, the responses
+// of the already sent messages are returned along with the error.
+func (cli *Client) SendAlbum(ctx context.Context, to types.JID, media []*waProto.Message) (SendResponse, []SendResponse, error) {
+	if len(media) < 2 {
+		return SendResponse{}, nil, ErrAlbumTooSmall
+	}
+	album := &waProto.AlbumMessage{}
+	var imageCount, videoCount uint32
+	for _, msg := range media {
+		switch {
+		case msg.GetImageMessage() != nil:
+			imageCount++
+		case msg.GetVideoMessage() != nil:
+			videoCount++
+		default:
+			return SendResponse{}, nil, ErrInvalidAlbumMedia
+		}
+	}
+	if imageCount > 0 {
+		album.ExpectedImageCount = proto.Uint32(imageCount)
+	}
+	if videoCount > 0 {
+		album.ExpectedVideoCount = proto.Uint32(videoCount)
+	}
+	albumResp, err := cli.SendMessageContext(ctx, to, "", &waProto.Message{AlbumMessage: album})
+	if err != nil {
+		return albumResp, nil, err
+	}
+	parentKey := cli.buildMessageKey(to, types.EmptyJID, albumResp.ID)
+	responses := make([]SendResponse, 0, len(media))
+	for i, msg := range media {
+		msg = proto.Clone(msg).(*waProto.Message)
+		if msg.MessageContextInfo == nil {
+			msg.MessageContextInfo = &waProto.MessageContextInfo{}
+		}
+		msg.MessageContextInfo.MessageAssociation = &waProto.MessageAssociation{
+			AssociationType:  waProto.MessageAssociation_MEDIA_ALBUM.Enum(),
+			ParentMessageKey: parentKey,
+			MessageIndex:     proto.Int32(int32(i)),
+		}
+		resp, err := cli.SendMessageContext(ctx, to, "", msg)
+		if err != nil {
+			return albumResp, responses, err
+		}
+		responses = append(responses, resp)
+	}
+	return albumResp, responses, nil
+}
+
+type albumKey struct {
+	Chat   types.JID
+	Sender types.JID
+	ID     types.MessageID
+}
+
+type pendingAlbum struct {
+	album *events.Message
+	media []*events.Message
+	timer *time.Timer
+	// Set when an incomplete album has been emitted, so that late media is passed through as normal messages.
+	emitted bool
+}
+
+func (pending *pendingAlbum) isComplete() bool {
+	if pending.album == nil {
+		return false
+	}
+	var images, videos uint32
+	for _, media := range pending.media {
+		if media.Message.GetImageMessage() != nil {
+			images++
+		} else if media.Message.GetVideoMessage() != nil {
+			videos++
+		}
+	}
+	expected := pending.album.Message.GetAlbumMessage()
+	return images >= expected.GetExpectedImageCount() && videos >= expected.GetExpectedVideoCount()
+}
+
+func (pending *pendingAlbum) toEvent(incomplete bool) *events.Album {
+	sort.SliceStable(pending.media, func(i, j int) bool {
+		return getAlbumAssociation(pending.media[i]).GetMessageIndex() < getAlbumAssociation(pending.media[j]).GetMessageIndex()
+	})
+	return &events.Album{
+		Info:       pending.album.Info,
+		Album:      pending.album.Message.GetAlbumMessage(),
+		Media:      pending.media,
+		Incomplete: incomplete,
+	}
+}
+
+func getAlbumAssociation(evt *events.Message) *waProto.MessageAssociation {
+	assoc := evt.Message.GetMessageContextInfo().GetMessageAssociation()
+	if assoc == nil {
+		// The context info may be outside the ephemeral wrapper
+		assoc = evt.RawMessage.GetMessageContextInfo().GetMessageAssociation()
+	}
+	if assoc.GetAssociationType() != waProto.MessageAssociation_MEDIA_ALBUM || assoc.GetParentMessageKey() == nil {
+		return nil
+	}
+	return assoc
+}
+
+// trackAlbum collects the parts of albums if album grouping is enabled. If the given message is part of an album,
+// the returned bool is true and the Message event shouldn't be dispatched. If the album is complete after this message,
+// the returned event should be dispatched instead.
+func (cli *Client) trackAlbum(evt *events.Message) (bool, *events.Album) {
+	if !cli.EnableAlbumGrouping {
+		return false, nil
+	}
+	var key albumKey
+	isAlbum := evt.Message.GetAlbumMessage() != nil
+	if isAlbum {
+		key = albumKey{Chat: evt.Info.Chat, Sender: evt.Info.Sender.ToNonAD(), ID: evt.Info.ID}
+	} else if assoc := getAlbumAssociation(evt); assoc != nil {
+		key = albumKey{
+			Chat:   evt.Info.Chat,
+			Sender: cli.getKeySender(&evt.Info, assoc.GetParentMessageKey()),
+			ID:     assoc.GetParentMessageKey().GetId(),
+		}
+	} else {
+		return false, nil
+	}
+
+	cli.albumLock.Lock()
+	defer cli.albumLock.Unlock()
+	pending, ok := cli.albums[key]
+	if !ok {
+		pending = &pendingAlbum{}
+		pending.timer = time.AfterFunc(AlbumGroupingTimeout, func() {
+			cli.flushAlbum(key)
+		})
+		cli.albums[key] = pending
+	} else if pending.emitted {
+		return false, nil
+	}
+	if isAlbum {
+		pending.album = evt
+	} else {
+		pending.media = append(pending.media, evt)
+	}
+	if !pending.isComplete() {
+		return true, nil
+	}
+	pending.timer.Stop()
+	delete(cli.albums, key)
+	return true, pending.toEvent(false)
+}
+
+// flushAlbum emits an album whose media didn't all arrive within AlbumGroupingTimeout.
+func (cli *Client) flushAlbum(key albumKey) {
+	cli.albumLock.Lock()
+	pending, ok := cli.albums[key]
+	if !ok || pending.emitted {
+		delete(cli.albums, key)
+		cli.albumLock.Unlock()
+		return
+	}
+	var albumEvt *events.Album
+	if pending.album != nil {
+		albumEvt = pending.toEvent(true)
+	}
+	media := pending.media
+	// Keep the entry for a while so that media arriving after the timeout isn't grouped into a new album.
+	pending.emitted = true
+	pending.timer = time.AfterFunc(AlbumGroupingTimeout, func() {
+		cli.flushAlbum(key)
+	})
+	cli.albumLock.Unlock()
+
+	if albumEvt != nil {
+		cli.Log.Debugf("Emitting incomplete album %s in %s with %d media", key.ID, key.Chat, len(media))
+		cli.dispatchEvent(albumEvt)
+	} else {
+		cli.Log.Debugf("Album %s in %s never arrived, emitting %d media as normal messages", key.ID, key.Chat, len(media))
+		for _, evt := range media {
+			cli.dispatchEvent(evt)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestAlbumGrouping(t *testing.T) {
+	ownID := types.NewADJID("1111111111", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	cli.EnableAlbumGrouping = true
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	sender := types.NewADJID("2222222222", 0, 3)
+	info := func(id types.MessageID) types.MessageInfo {
+		return types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: group, Sender: sender, IsGroup: true},
+			ID:            id,
+		}
+	}
+	media := func(id types.MessageID, index int32, video bool) *events.Message {
+		msg := &waProto.Message{
+			ImageMessage: &waProto.ImageMessage{},
+			MessageContextInfo: &waProto.MessageContextInfo{MessageAssociation: &waProto.MessageAssociation{
+				AssociationType:  waProto.MessageAssociation_MEDIA_ALBUM.Enum(),
+				ParentMessageKey: &waProto.MessageKey{FromMe: proto.Bool(true), Id: proto.String("ALBUM")},
+				MessageIndex:     proto.Int32(index),
+			}},
+		}
+		if video {
+			msg.ImageMessage = nil
+			msg.VideoMessage = &waProto.VideoMessage{}
+		}
+		return &events.Message{Info: info(id), Message: msg}
+	}
+
+	// Media can arrive before the album message
+	if grouped, evt := cli.trackAlbum(media("VIDEO", 1, true)); !grouped || evt != nil {
+		t.Fatalf("Expected media to be held until album is complete")
+	}
+	album := &events.Message{Info: info("ALBUM"), Message: &waProto.Message{AlbumMessage: &waProto.AlbumMessage{
+		ExpectedImageCount: proto.Uint32(1),
+		ExpectedVideoCount: proto.Uint32(1),
+	}}}
+	if grouped, evt := cli.trackAlbum(album); !grouped || evt != nil {
+		t.Fatalf("Expected album to be held until media arrives")
+	}
+	grouped, evt := cli.trackAlbum(media("IMAGE", 0, false))
+	if !grouped || evt == nil {
+		t.Fatalf("Expected complete album event")
+	} else if evt.Info.ID != "ALBUM" || evt.Incomplete || len(evt.Media) != 2 {
+		t.Fatalf("Unexpected album event %+v", evt)
+	} else if evt.Media[0].Info.ID != "IMAGE" || evt.Media[1].Info.ID != "VIDEO" {
+		t.Errorf("Album media wasn't sorted by index")
+	}
+	if len(cli.albums) != 0 {
+		t.Errorf("Completed album wasn't removed")
+	}
+
+	if grouped, _ = cli.trackAlbum(&events.Message{Info: info("TEXT"), Message: &waProto.Message{Conversation: proto.String("hi")}}); grouped {
+		t.Errorf("Normal message was grouped")
+	}
+}
@@ -40,6 +40,13 @@ func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotS
 
 	state := appstate.HashState{Version: version, Hash: hash}
 
+	dispatchEvts := !fullSync || cli.EmitAppStateEventsOnFullSync
+	var unread unreadBatch
+	if !dispatchEvts {
+		unread = make(unreadBatch)
+		defer cli.flushUnreadBatch(unread)
+	}
+
 	hasMore := true
 	wantSnapshot := fullSync
 	for hasMore {
@@ -56,7 +63,7 @@ func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotS
 		}
 		state = newState
 		for _, mutation := range mutations {
-			cli.dispatchAppState(mutation, !fullSync || cli.EmitAppStateEventsOnFullSync)
+			cli.dispatchAppState(mutation, dispatchEvts, unread)
 		}
 	}
 	if fullSync {
@@ -68,7 +75,7 @@ func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotS
 	return nil
 }
 
-func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts bool) {
+func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts bool, unread unreadBatch) {
 	if mutation.Operation != waProto.SyncdMutation_SET {
 		return
 	}
@@ -108,6 +115,12 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts boo
 		if cli.Store.ChatSettings != nil {
 			storeUpdateError = cli.Store.ChatSettings.PutArchived(jid, act.GetArchived())
 		}
+	case "markChatAsRead":
+		eventToDispatch = &events.MarkChatAsRead{JID: jid, Timestamp: ts, Action: mutation.Action.GetMarkChatAsReadAction()}
+	case "clearChat":
+		eventToDispatch = &events.ClearChat{JID: jid, Timestamp: ts, Action: mutation.Action.GetClearChatAction()}
+	case "deleteChat":
+		eventToDispatch = &events.DeleteChat{JID: jid, Timestamp: ts, Action: mutation.Action.GetDeleteChatAction()}
 	case "contact":
 		act := mutation.Action.GetContactAction()
 		eventToDispatch = &events.Contact{JID: jid, Timestamp: ts, Action: act}
@@ -157,6 +170,11 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, dispatchEvts boo
 	if storeUpdateError != nil {
 		cli.Log.Errorf("Failed to update device store after app state mutation: %v", storeUpdateError)
 	}
+	if eventToDispatch != nil && unread != nil {
+		unread.add(cli, eventToDispatch)
+	} else if eventToDispatch != nil {
+		cli.trackUnread(eventToDispatch)
+	}
 	if dispatchEvts && eventToDispatch != nil {
 		cli.dispatchEvent(eventToDispatch)
 	}
@@ -0,0 +1,198 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// NewQuickReplyButton creates a button for BuildButtons. When the recipient taps it, a ButtonResponse event
+// with the given ID is emitted.
+func NewQuickReplyButton(id, displayText string) *waProto.Button {
+	return &waProto.Button{
+		ButtonId:   proto.String(id),
+		ButtonText: &waProto.ButtonText{DisplayText: proto.String(displayText)},
+		Type:       waProto.Button_RESPONSE.Enum(),
+	}
+}
+
+// NewNativeFlowButton creates a native flow button for BuildButtons, such as "cta_url" or "cta_copy".
+// The parameters are a JSON object whose structure depends on the flow name.
+func NewNativeFlowButton(name, paramsJSON string) *waProto.Button {
+	return &waProto.Button{
+		Type: waProto.Button_NATIVE_FLOW.Enum(),
+		NativeFlowInfo: &waProto.NativeFlowInfo{
+			Name:       proto.String(name),
+			ParamsJson: proto.String(paramsJSON),
+		},
+	}
+}
+
+// BuildButtons builds a text message with buttons under it. The footer is optional.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The official clients only show up to three quick reply buttons.
+func BuildButtons(text, footer string, buttons ...*waProto.Button) *waProto.Message {
+	msg := &waProto.ButtonsMessage{
+		ContentText: proto.String(text),
+		HeaderType:  waProto.ButtonsMessage_EMPTY.Enum(),
+		Buttons:     buttons,
+	}
+	if len(footer) > 0 {
+		msg.FooterText = proto.String(footer)
+	}
+	return &waProto.Message{ButtonsMessage: msg}
+}
+
+// NewListRow creates a row for a list section. The description is optional.
+func NewListRow(id, title, description string) *waProto.Row {
+	row := &waProto.Row{
+		RowId: proto.String(id),
+		Title: proto.String(title),
+	}
+	if len(description) > 0 {
+		row.Description = proto.String(description)
+	}
+	return row
+}
+
+// NewListSection creates a section with the given rows for BuildList.
+func NewListSection(title string, rows ...*waProto.Row) *waProto.Section {
+	return &waProto.Section{
+		Title: proto.String(title),
+		Rows:  rows,
+	}
+}
+
+// BuildList builds a list message. The recipient sees the title, description and a button with the given text,
+// which opens the list of sections. When the recipient selects a row, a ListResponse event is emitted.
+// The footer is optional. The built message can be sent normally using Client.SendMessage.
+func BuildList(title, description, buttonText, footer string, sections ...*waProto.Section) *waProto.Message {
+	msg := &waProto.ListMessage{
+		Title:       proto.String(title),
+		Description: proto.String(description),
+		ButtonText:  proto.String(buttonText),
+		ListType:    waProto.ListMessage_SINGLE_SELECT.Enum(),
+		Sections:    sections,
+	}
+	if len(footer) > 0 {
+		msg.FooterText = proto.String(footer)
+	}
+	return &waProto.Message{ListMessage: msg}
+}
+
+// NewTemplateQuickReplyButton creates a template button that emits a TemplateButtonResponse event with the given ID when tapped.
+func NewTemplateQuickReplyButton(id, displayText string) *waProto.HydratedTemplateButton {
+	return &waProto.HydratedTemplateButton{HydratedButton: &waProto.HydratedTemplateButton_QuickReplyButton{
+		QuickReplyButton: &waProto.HydratedQuickReplyButton{Id: proto.String(id), DisplayText: proto.String(displayText)},
+	}}
+}
+
+// NewTemplateURLButton creates a template button that opens the given URL.
+func NewTemplateURLButton(displayText, url string) *waProto.HydratedTemplateButton {
+	return &waProto.HydratedTemplateButton{HydratedButton: &waProto.HydratedTemplateButton_UrlButton{
+		UrlButton: &waProto.HydratedURLButton{Url: proto.String(url), DisplayText: proto.String(displayText)},
+	}}
+}
+
+// NewTemplateCallButton creates a template button that calls the given phone number.
+func NewTemplateCallButton(displayText, phoneNumber string) *waProto.HydratedTemplateButton {
+	return &waProto.HydratedTemplateButton{HydratedButton: &waProto.HydratedTemplateButton_CallButton{
+		CallButton: &waProto.HydratedCallButton{PhoneNumber: proto.String(phoneNumber), DisplayText: proto.String(displayText)},
+	}}
+}
+
+// BuildTemplate builds a template message with the given buttons. The title and footer are optional.
+// The built message can be sent normally using Client.SendMessage.
+func BuildTemplate(title, text, footer string, buttons ...*waProto.HydratedTemplateButton) *waProto.Message {
+	for i, button := range buttons {
+		button.Index = proto.Uint32(uint32(i))
+	}
+	template := &waProto.HydratedFourRowTemplate{
+		HydratedContentText: proto.String(text),
+		HydratedButtons:     buttons,
+	}
+	if len(title) > 0 {
+		template.Title = &waProto.HydratedFourRowTemplate_HydratedTitleText{HydratedTitleText: title}
+	}
+	if len(footer) > 0 {
+		template.HydratedFooterText = proto.String(footer)
+	}
+	return &waProto.Message{TemplateMessage: &waProto.TemplateMessage{
+		HydratedTemplate: template,
+		Format:           &waProto.TemplateMessage_HydratedFourRowTemplate{HydratedFourRowTemplate: template},
+	}}
+}
+
+// getBizNode returns the biz node that the official clients include in the message stanza of interactive messages.
+// Without it, the server may not deliver buttons and lists to recipients.
+func getBizNode(message *waProto.Message) *waBinary.Node {
+	switch {
+	case message.GetButtonsMessage() != nil, message.GetTemplateMessage() != nil:
+		flowName := "mixed"
+		for _, button := range message.GetButtonsMessage().GetButtons() {
+			if button.GetType() == waProto.Button_NATIVE_FLOW {
+				flowName = button.GetNativeFlowInfo().GetName()
+				break
+			}
+		}
+		return &waBinary.Node{Tag: "biz", Content: []waBinary.Node{{
+			Tag:   "interactive",
+			Attrs: waBinary.Attrs{"type": "native_flow", "v": "1"},
+			Content: []waBinary.Node{{
+				Tag:   "native_flow",
+				Attrs: waBinary.Attrs{"v": "9", "name": flowName},
+			}},
+		}}}
+	case message.GetListMessage() != nil:
+		return &waBinary.Node{Tag: "biz", Content: []waBinary.Node{{
+			Tag:   "list",
+			Attrs: waBinary.Attrs{"type": "product_list", "v": "2"},
+		}}}
+	default:
+		return nil
+	}
+}
+
+// parseInteractiveResponse parses responses to buttons, lists and templates into the corresponding events.
+func parseInteractiveResponse(evt *events.Message) interface{} {
+	msg := evt.Message
+	switch {
+	case msg.GetButtonsResponseMessage() != nil:
+		resp := msg.GetButtonsResponseMessage()
+		return &events.ButtonResponse{
+			Info:        evt.Info,
+			TargetID:    resp.GetContextInfo().GetStanzaId(),
+			SelectedID:  resp.GetSelectedButtonId(),
+			DisplayText: resp.GetSelectedDisplayText(),
+		}
+	case msg.GetListResponseMessage() != nil:
+		resp := msg.GetListResponseMessage()
+		return &events.ListResponse{
+			Info:          evt.Info,
+			TargetID:      resp.GetContextInfo().GetStanzaId(),
+			SelectedRowID: resp.GetSingleSelectReply().GetSelectedRowId(),
+			Title:         resp.GetTitle(),
+			Description:   resp.GetDescription(),
+		}
+	case msg.GetTemplateButtonReplyMessage() != nil:
+		resp := msg.GetTemplateButtonReplyMessage()
+		return &events.TemplateButtonResponse{
+			Info:          evt.Info,
+			TargetID:      resp.GetContextInfo().GetStanzaId(),
+			SelectedID:    resp.GetSelectedId(),
+			DisplayText:   resp.GetSelectedDisplayText(),
+			SelectedIndex: int(resp.GetSelectedIndex()),
+		}
+	default:
+		return nil
+	}
+}
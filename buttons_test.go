@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestGetBizNode(t *testing.T) {
+	buttons := BuildButtons("Pick one", "", NewQuickReplyButton("a", "A"), NewNativeFlowButton("cta_url", `{}`))
+	node := getBizNode(buttons)
+	if node == nil || node.Tag != "biz" {
+		t.Fatalf("Expected biz node for buttons message, got %v", node)
+	}
+	flow, ok := node.GetOptionalChildByTag("interactive", "native_flow")
+	if !ok || flow.AttrGetter().String("name") != "cta_url" {
+		t.Errorf("Unexpected native flow node %v", node)
+	}
+	list := BuildList("Menu", "Choose", "Open", "", NewListSection("Food", NewListRow("pizza", "Pizza", "")))
+	if _, ok = getBizNode(list).GetOptionalChildByTag("list"); !ok {
+		t.Errorf("Expected list node for list message")
+	}
+	if getBizNode(&waProto.Message{Conversation: proto.String("hi")}) != nil {
+		t.Errorf("Unexpected biz node for text message")
+	}
+}
+
+func TestParseInteractiveResponse(t *testing.T) {
+	evt := parseInteractiveResponse(&events.Message{Message: &waProto.Message{ListResponseMessage: &waProto.ListResponseMessage{
+		Title:             proto.String("Pizza"),
+		SingleSelectReply: &waProto.SingleSelectReply{SelectedRowId: proto.String("pizza")},
+		ContextInfo:       &waProto.ContextInfo{StanzaId: proto.String("LIST")},
+	}}})
+	resp, ok := evt.(*events.ListResponse)
+	if !ok || resp.SelectedRowID != "pizza" || resp.TargetID != "LIST" || resp.Title != "Pizza" {
+		t.Errorf("Unexpected list response %+v", evt)
+	}
+	if parseInteractiveResponse(&events.Message{Message: &waProto.Message{Conversation: proto.String("hi")}}) != nil {
+		t.Errorf("Unexpected response event for text message")
+	}
+}
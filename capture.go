@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	"go.mau.fi/libsignal/protocol"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// DecryptionFailureSink is a function that receives captured data about messages that failed to decrypt.
+// See Client.DecryptionFailureSink for more info.
+type DecryptionFailureSink func(capture *DecryptionFailureCapture)
+
+// DecryptionFailureCapture contains the raw data of a message that failed to decrypt, for debugging decryption errors.
+//
+// The ciphertext can't be decrypted without the session state, so it's safe to store, but the stanza metadata
+// includes the JIDs of the sender and chat.
+type DecryptionFailureCapture struct {
+	Info      types.MessageInfo
+	Timestamp time.Time // The time when the failure happened.
+
+	StanzaAttrs    waBinary.Attrs // The attributes of the <message> node.
+	EncAttrs       waBinary.Attrs // The attributes of the <enc> node that failed to decrypt.
+	CiphertextType string         // The type of the ciphertext (pkmsg, msg or skmsg).
+	Ciphertext     []byte         // The raw ciphertext, i.e. the content of the <enc> node.
+
+	Error    error
+	FailMode events.DecryptFailMode
+
+	// The state of the Signal session with the sender device after the failure, for pkmsg and msg ciphertexts.
+	Session *CapturedSessionState
+	// The state of the sender key of the sender in the group, for skmsg ciphertexts.
+	SenderKey *CapturedSenderKeyState
+}
+
+// CapturedSessionState contains the identifiers of a stored Signal session. It doesn't contain any key material
+// other than the public base key.
+type CapturedSessionState struct {
+	Exists               bool
+	Version              int
+	LocalRegistrationID  uint32
+	RemoteRegistrationID uint32
+	SenderBaseKey        []byte
+	PreviousStates       int
+}
+
+// CapturedSenderKeyState contains the identifiers of a stored group sender key.
+type CapturedSenderKeyState struct {
+	Exists bool
+	KeyIDs []uint32 // The IDs of all stored sender key states, newest first.
+}
+
+// captureDecryptionFailure passes data about a decryption failure to Client.DecryptionFailureSink, if it's set.
+func (cli *Client) captureDecryptionFailure(info *types.MessageInfo, node, child *waBinary.Node, encType string, err error, failMode events.DecryptFailMode) {
+	sink := cli.DecryptionFailureSink
+	if sink == nil {
+		return
+	}
+	ciphertext, _ := child.Content.([]byte)
+	capture := &DecryptionFailureCapture{
+		Info:           *info,
+		Timestamp:      time.Now(),
+		StanzaAttrs:    copyAttrs(node.Attrs),
+		EncAttrs:       copyAttrs(child.Attrs),
+		CiphertextType: encType,
+		Ciphertext:     append([]byte(nil), ciphertext...),
+		Error:          err,
+		FailMode:       failMode,
+	}
+	if encType == "skmsg" {
+		capture.SenderKey = cli.captureSenderKeyState(info.Chat, info.Sender)
+	} else {
+		capture.Session = cli.captureSessionState(info.Sender)
+	}
+	sink(capture)
+}
+
+func (cli *Client) captureSessionState(sender types.JID) *CapturedSessionState {
+	sess := cli.Store.LoadSession(sender.SignalAddress())
+	if sess.IsFresh() {
+		return &CapturedSessionState{}
+	}
+	state := sess.SessionState()
+	return &CapturedSessionState{
+		Exists:               true,
+		Version:              state.Version(),
+		LocalRegistrationID:  state.LocalRegistrationID(),
+		RemoteRegistrationID: state.RemoteRegistrationID(),
+		SenderBaseKey:        state.SenderBaseKey(),
+		PreviousStates:       len(sess.PreviousSessionStates()),
+	}
+}
+
+func (cli *Client) captureSenderKeyState(chat, sender types.JID) *CapturedSenderKeyState {
+	key := cli.Store.LoadSenderKey(protocol.NewSenderKeyName(chat.String(), sender.SignalAddress()))
+	if key.IsEmpty() {
+		return &CapturedSenderKeyState{}
+	}
+	structure := key.Structure()
+	keyIDs := make([]uint32, len(structure.SenderKeyStates))
+	for i, state := range structure.SenderKeyStates {
+		keyIDs[i] = state.KeyID
+	}
+	return &CapturedSenderKeyState{Exists: true, KeyIDs: keyIDs}
+}
+
+func copyAttrs(attrs waBinary.Attrs) waBinary.Attrs {
+	copied := make(waBinary.Attrs, len(attrs))
+	for key, value := range attrs {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type emptySignalStore struct {
+	store.SessionStore
+	store.SenderKeyStore
+}
+
+func (emptySignalStore) GetSession(string) ([]byte, error)           { return nil, nil }
+func (emptySignalStore) GetSenderKey(string, string) ([]byte, error) { return nil, nil }
+
+func TestCaptureDecryptionFailure(t *testing.T) {
+	signalStore := emptySignalStore{}
+	cli := &Client{
+		Log:   waLog.Noop,
+		Store: &store.Device{Log: waLog.Noop, Sessions: signalStore, SenderKeys: signalStore},
+	}
+	sender := types.NewADJID("1111", 0, 1)
+	group := types.NewJID("1234", types.GroupServer)
+	info := &types.MessageInfo{MessageSource: types.MessageSource{Chat: group, Sender: sender, IsGroup: true}, ID: "test"}
+	ciphertext := []byte{1, 2, 3}
+	node := &waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": "test", "from": group}}
+	child := &waBinary.Node{Tag: "enc", Attrs: waBinary.Attrs{"type": "skmsg", "v": "2"}, Content: ciphertext}
+	decryptErr := errors.New("bad mac")
+
+	// Nothing should happen without a sink
+	cli.captureDecryptionFailure(info, node, child, "skmsg", decryptErr, events.DecryptFailBadMAC)
+
+	var captures []*DecryptionFailureCapture
+	cli.DecryptionFailureSink = func(capture *DecryptionFailureCapture) {
+		captures = append(captures, capture)
+	}
+	cli.captureDecryptionFailure(info, node, child, "skmsg", decryptErr, events.DecryptFailBadMAC)
+	child.Attrs["type"] = "msg"
+	cli.captureDecryptionFailure(info, node, child, "msg", decryptErr, events.DecryptFailNoSession)
+	if len(captures) != 2 {
+		t.Fatalf("Expected 2 captures, got %d", len(captures))
+	}
+
+	groupCapture := captures[0]
+	if groupCapture.Info.ID != "test" || groupCapture.CiphertextType != "skmsg" || groupCapture.Error != decryptErr || groupCapture.FailMode != events.DecryptFailBadMAC {
+		t.Errorf("Unexpected capture metadata %+v", groupCapture)
+	}
+	if !bytes.Equal(groupCapture.Ciphertext, ciphertext) || groupCapture.EncAttrs["type"] != "skmsg" || groupCapture.StanzaAttrs["id"] != "test" {
+		t.Errorf("Capture didn't include a copy of the stanza data: %+v", groupCapture)
+	}
+	if groupCapture.SenderKey == nil || groupCapture.SenderKey.Exists || groupCapture.Session != nil {
+		t.Errorf("Expected empty sender key state in group capture, got %+v / %+v", groupCapture.SenderKey, groupCapture.Session)
+	}
+	dmCapture := captures[1]
+	if dmCapture.Session == nil || dmCapture.Session.Exists || dmCapture.SenderKey != nil {
+		t.Errorf("Expected empty session state in DM capture, got %+v / %+v", dmCapture.Session, dmCapture.SenderKey)
+	}
+}
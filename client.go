@@ -11,13 +11,16 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/gorilla/websocket"
+
 	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
@@ -31,6 +34,13 @@ import (
 
 // EventHandler is a function that can handle events from WhatsApp.
 type EventHandler func(evt interface{})
+
+// EventHandlerErrorCallback is a function that is called when an event handler panics.
+// It receives the event being handled, the value passed to panic() and the stack trace of the panic.
+type EventHandlerErrorCallback func(evt interface{}, recovered interface{}, stack []byte)
+
+// FrameHook is a function that receives the raw decrypted frames sent and received through the websocket.
+type FrameHook func(incoming bool, data []byte)
 type nodeHandler func(node *waBinary.Node)
 
 var nextHandlerID uint32
@@ -50,12 +60,102 @@ type Client struct {
 	socket     *socket.NoiseSocket
 	socketLock sync.RWMutex
 
+	proxy    socket.Proxy
+	wsDialer *websocket.Dialer
+	http     *http.Client
+
 	isLoggedIn            uint32
 	expectedDisconnectVal uint32
 	EnableAutoReconnect   bool
 	LastSuccessfulConnect time.Time
 	AutoReconnectErrors   int
 
+	// Auto-reconnect backoff settings, see the package-level variables with the same names for details.
+	AutoReconnectBaseDelay time.Duration
+	AutoReconnectMaxDelay  time.Duration
+	// PreReconnectHook is called before each automatic reconnection attempt with the attempt number (starting from 1)
+	// and the delay that will be waited before connecting. If it returns false, auto-reconnecting is stopped.
+	PreReconnectHook func(attempt int, delay time.Duration) bool
+
+	// SocketReadTimeout is the maximum time to wait for any data from the server before considering the connection
+	// dead and reconnecting. This catches stalled connections (e.g. after a NAT timeout) faster than TCP keepalives.
+	// It should be longer than KeepAliveIntervalMax + KeepAliveResponseDeadline, since keepalive pings are the
+	// only guaranteed traffic on an idle connection. Zero means no timeout. Changes take effect on the next connection.
+	SocketReadTimeout time.Duration
+	// SocketWriteTimeout is the maximum time that writing a single frame to the websocket can take.
+	// Zero means no timeout. Changes take effect on the next connection.
+	SocketWriteTimeout time.Duration
+
+	// TrafficHook is called after every frame that is sent or received through the websocket with the size of the
+	// frame in bytes. It can be used for per-account bandwidth accounting. The hook is called synchronously in the
+	// socket read and write paths, so it must return quickly. Changes take effect on the next connection.
+	TrafficHook func(incoming bool, size int)
+
+	// HandshakeTimeout is the maximum time that Connect() waits for the websocket connection and the noise handshake
+	// to complete. Zero means no timeout. Defaults to NoiseHandshakeResponseTimeout.
+	HandshakeTimeout time.Duration
+	// WebsocketURLs is the list of websocket URLs to try in order when connecting, e.g. to add fallback endpoints
+	// or relays that are reachable when the default one isn't. If empty, socket.Endpoints is used (or
+	// socket.URLOverride if it's set). Changes take effect on the next connection.
+	WebsocketURLs []string
+
+	// Keepalive settings, see the package-level variables with the same names for details.
+	// The fields are read when each ping is sent, so changing them affects the current connection too.
+	KeepAliveIntervalMin      time.Duration
+	KeepAliveIntervalMax      time.Duration
+	KeepAliveResponseDeadline time.Duration
+	KeepAliveMaxMissed        int
+
+	connState            types.ConnectionState
+	connStateEvents      []*events.ConnectionStateChanged
+	connStateDispatching bool
+	connStateChanged     chan struct{}
+	connStateLock        sync.Mutex
+	isAutoReconnecting   uint32
+	autoReconnectWake    chan struct{}
+
+	connStatsLock  sync.Mutex
+	lastPong       time.Time
+	lastRTT        time.Duration
+	rttSamples     []time.Duration
+	rttSamplePtr   int
+	reconnectCount int
+	hasConnected   bool
+
+	// InitialAutoPassive can be set to true to keep the device in passive mode after connecting, instead of
+	// automatically calling SetPassive(false). This is useful for read-only monitoring clients that shouldn't
+	// be promoted to active and take over events from other companion devices. SetPassive can still be called manually.
+	InitialAutoPassive bool
+
+	// AutoReconnectAfterPair controls whether the client should automatically reconnect when the server closes
+	// the connection after pairing. When the reconnection succeeds, events.Connected is emitted followed by
+	// events.PairComplete. If this is false (or if EnableAutoReconnect is false), the client will disconnect and
+	// emit events.ManualLoginReconnect after events.PairSuccess, and events.PairComplete will be emitted after
+	// Connect() is called manually.
+	AutoReconnectAfterPair bool
+
+	// AutoRefreshWAVersion can be set to true to automatically fetch the latest WhatsApp web version and reconnect
+	// when the server says the client is outdated (405 connect failure). events.ClientOutdated is only emitted
+	// if a newer version couldn't be found. The new version is set globally with store.SetWAVersion.
+	AutoRefreshWAVersion bool
+
+	// DontDeleteStoreOnLogout can be set to true to keep the device store when the server says the device has been
+	// logged out (events.LoggedOut), e.g. to inspect or archive the data. The session can't be used anymore after
+	// a logout, so the store must be deleted manually with Client.Store.Delete() before pairing again.
+	DontDeleteStoreOnLogout bool
+
+	// PrePairCallback is called after the phone has confirmed pairing and the device identity has been verified,
+	// but before it's saved. If the callback returns false, the pairing is rejected and events.PairError is
+	// emitted with ErrPairRejectedLocally. This can be used to only allow linking specific phone numbers.
+	PrePairCallback func(jid types.JID, platform, businessName string) bool
+	// PairBusinessOnly can be set to true to pair as a WhatsApp Business companion. Hosted (business API)
+	// accounts are accepted, other accounts are rejected with ErrPairNotBusinessAccount, and the verified
+	// name certificate of the account is always fetched after pairing (see events.PairComplete).
+	PairBusinessOnly bool
+
+	pendingPairComplete     *events.PairComplete
+	pendingPairCompleteLock sync.Mutex
+
 	// EmitAppStateEventsOnFullSync can be set to true if you want to get app state events emitted
 	// even when re-syncing the whole state.
 	EmitAppStateEventsOnFullSync bool
@@ -77,25 +177,215 @@ type Client struct {
 	eventHandlers     []wrappedEventHandler
 	eventHandlersLock sync.RWMutex
 
+	eventMiddlewares     []wrappedEventMiddleware
+	eventMiddlewaresLock sync.RWMutex
+	// EventDispatcher can be set to change how event handlers are called, e.g. to a WorkerPoolDispatcher to call
+	// them asynchronously. By default (nil), all handlers are called synchronously in the order they were added,
+	// which means that a slow handler delays handling any further messages.
+	//
+	// Dispatch traces (see EnableDispatchTracing) are only recorded with the default dispatcher.
+	EventDispatcher EventDispatcher
+
+	handlerQueueOverflow    HandlerQueueOverflowPolicy
+	handlerQueueOverflowing bool
+	handlerQueueSpill       *spillQueue
+
 	messageRetries     map[string]int
 	messageRetriesLock sync.Mutex
+	// MaxRetryReceipts is the maximum number of retry receipts to send for a single incoming message that can't be
+	// decrypted. When the limit is reached, events.RetryReceiptLimitReached is emitted.
+	MaxRetryReceipts int
+	// RetryReceiptDelay is the delay before sending the second retry receipt for a message. The delay is doubled
+	// for each subsequent receipt. The first retry receipt is always sent immediately.
+	RetryReceiptDelay time.Duration
+
+	// DecryptionConcurrency is the number of workers that decrypt incoming messages. Messages in different chats
+	// are decrypted concurrently, while messages in the same chat are always decrypted in order by the same worker.
+	// Values below 2 decrypt all messages serially in the handler queue. Changes take effect when reconnecting.
+	//
+	// When this is enabled, event handlers will be called concurrently for messages in different chats.
+	DecryptionConcurrency int
+	// PreDecryptHook is called for every incoming message stanza before it's decrypted, with the metadata parsed
+	// from the stanza. The returned PreDecryptDecision can drop or delay the message. The hook can also annotate
+	// the message by setting values in info.Annotations, which are included in the events dispatched for it.
+	//
+	// The hook is called synchronously in the handler queue, so it must return quickly. Use the Delay field
+	// of the decision instead of sleeping in the hook.
+	PreDecryptHook func(info *types.MessageInfo, node *waBinary.Node) PreDecryptDecision
+	// DecryptionFailureSink can be set to capture the raw ciphertext, stanza metadata and session state identifiers
+	// of messages that fail to decrypt. This is meant for debugging issues like bad MAC errors without enabling full
+	// wire logging. The sink is called synchronously before the retry receipt is sent, so it should return quickly.
+	DecryptionFailureSink DecryptionFailureSink
+	decryptQueue          *decryptionQueue
+	decryptQueueCtx       context.Context
+	decryptQueueLock      sync.Mutex
+	// sessionLocks prevents decrypting messages from the same device concurrently, as they use the same Signal session.
+	sessionLocks keyedMutex
+
+	phoneLinkingCache     *phoneLinkingCache
+	phoneLinkingCacheLock sync.Mutex
 
 	privacySettingsCache atomic.Value
+	frameHook            atomic.Value
+	handlerErrorCallback atomic.Value
 
-	recentMessagesMap  map[recentMessageKey]*waProto.Message
+	recentMessagesMap  map[recentMessageKey][]byte
 	recentMessagesList [recentMessagesSize]recentMessageKey
 	recentMessagesPtr  int
 	recentMessagesLock sync.RWMutex
 	// GetMessageForRetry is used to find the source message for handling retry receipts
 	// when the message is not found in the recently sent message cache.
 	GetMessageForRetry func(to types.JID, id types.MessageID) *waProto.Message
+	// EnablePersistentRetryCache can be set to true to also store sent messages in Store.SentMessages, so that
+	// retry receipts can be handled after restarting without implementing GetMessageForRetry.
+	// Messages are removed from the store after PersistentRetryCacheTTL.
+	EnablePersistentRetryCache bool
+	PersistentRetryCacheTTL    time.Duration
+	// MessageSecretTTL is how long message secrets (which are needed for poll votes) are kept in Store.MsgSecrets.
+	// Older secrets are removed after connecting if the store implements store.MsgSecretPruneStore.
+	// Set to zero to keep secrets forever.
+	MessageSecretTTL time.Duration
+	// AutoSendDeliveryReceipts specifies the chats where incoming messages are acknowledged with normal delivery
+	// receipts, like the official clients do when they're open. In other chats, "inactive" delivery receipts are
+	// sent, like the official clients do when running in the background, which don't always show up as delivered
+	// for the sender. Defaults to DeliveryReceiptsNone.
+	AutoSendDeliveryReceipts DeliveryReceiptChats
+	// AutomaticMessageRerequestFromPhone can be set to true to request messages that were received as unavailable
+	// from the primary device, if the sender doesn't resend them within RequestFromPhoneDelay.
+	// See BuildUnavailableMessageRequest for more info.
+	AutomaticMessageRerequestFromPhone bool
+	pendingPhoneRerequests             map[types.MessageID]context.CancelFunc
+	pendingPhoneRerequestsLock         sync.Mutex
+	// PreRetryCallback is called when another device sends a retry receipt for one of our messages, before the
+	// message is re-encrypted and sent again. If the callback returns false, the message is not re-sent.
+	// This can be used to stop retrying for specific senders or to log retries.
+	PreRetryCallback func(receipt *events.Receipt, id types.MessageID, retryCount int, msg *waProto.Message) bool
+
+	viewOnceMap   map[viewOnceMessageKey]*viewOnceMessage
+	viewOnceMedia map[string]*viewOnceMessage
+	viewOnceList  [viewOnceMessagesSize]viewOnceMessageKey
+	viewOncePtr   int
+	viewOnceLock  sync.RWMutex
+
+	// MarkAudioPlayedOnRead can be set to true to make MarkRead also send played receipts for incoming
+	// audio messages, which makes the official clients show them as listened to.
+	MarkAudioPlayedOnRead bool
+	audioMessages         map[audioMessageKey]struct{}
+	audioMessageList      [audioMessagesSize]audioMessageKey
+	audioMessagePtr       int
+	audioMessageLock      sync.Mutex
+
+	// MessageDedupeCacheSize can be set to make the client remember the given number of handled messages and ignore
+	// them if the server delivers them again, e.g. after a flaky connection. Zero disables deduplication.
+	// It must be set before connecting.
+	MessageDedupeCacheSize int
+	// MessageDedupeTTL is how long handled messages are remembered for deduplication. Zero means messages are
+	// remembered until they're pushed out of the cache by newer messages.
+	MessageDedupeTTL  time.Duration
+	messageDedupe     *messageDedupeCache
+	messageDedupeLock sync.Mutex
+
+	mediaRetryWaiters     map[types.MessageID]chan<- *events.MediaRetry
+	mediaRetryWaitersLock sync.Mutex
+
+	groupCache        map[types.JID]*cachedGroupInfo
+	groupCacheFetches map[types.JID]*groupInfoFetch
+	groupCacheLock    sync.Mutex
+	// GroupInfoCacheTTL is how long group info is cached for IsGroupAdmin before it's refetched from the server.
+	// The cache is also updated based on group change notifications, so this is only a safety net.
+	// If the TTL is zero or negative, cached info never expires and is only updated by notifications
+	// (or removed when a request for the group fails because the group doesn't exist or the user isn't in it).
+	GroupInfoCacheTTL time.Duration
+	// GroupInfoCacheSize is the maximum number of groups in the group info cache. When the cache is full,
+	// the group that was fetched longest ago is removed. If the size is zero or negative, the cache is unbounded.
+	GroupInfoCacheSize int
+
+	// The last known disappearing timers of private chats, used to avoid rewriting unchanged timers to the store.
+	ephemeralExpirations     map[types.JID]uint32
+	ephemeralExpirationsLock sync.Mutex
+
+	// EncryptionConcurrency is the maximum number of devices that a message is encrypted for in parallel
+	// when sending to groups with many participant devices. Set to 1 to always encrypt serially.
+	EncryptionConcurrency int
+
+	// EnableOutbox can be set to true to allow queueing messages with QueueMessage. Queued messages are stored in
+	// Store.Outbox and sent in the background whenever the client is connected.
+	EnableOutbox bool
+	// OutboxMaxAttempts is the number of times sending a queued message is attempted before giving up.
+	OutboxMaxAttempts int
+	// ScheduledMessageMaxDelay is how late a message scheduled with ScheduleMessage may be sent, e.g. if the client
+	// was offline at the scheduled time. Messages that are later than this are dropped instead of sent. Zero means no limit.
+	ScheduledMessageMaxDelay time.Duration
+	outboxWake               chan struct{}
+	// The IDs of outbox messages that are being sent right now, and the IDs of messages that were canceled or
+	// rescheduled after processOutbox fetched the outbox, so that they aren't sent based on the outdated data.
+	outboxSending map[types.MessageID]struct{}
+	outboxStale   map[types.MessageID]struct{}
+	outboxLock    sync.Mutex
+
+	// SendRateLimiter can be set to limit how fast messages are sent. See NewSendRateLimiter.
+	SendRateLimiter *SendRateLimiter
+
+	liveLocationShares map[types.MessageID]*LiveLocationShare
+	liveLocations      map[liveLocationKey]*receivedLiveLocation
+	liveLocationLock   sync.Mutex
+
+	// EnableUnreadTracking can be set to true to maintain unread message counts for each chat in the
+	// chat settings store, if it implements store.UnreadCountStore. Changes are emitted as events.UnreadCountChanged, except during full app
+	// state syncs that don't emit events (see EmitAppStateEventsOnFullSync), where the counts are only
+	// saved in one batch at the end.
+	EnableUnreadTracking bool
+	unreadLock           sync.Mutex
+
+	// AutoLinkPreview can be set to true to make SendText add link previews to messages containing links.
+	// See Client.AddLinkPreview for details.
+	AutoLinkPreview bool
+
+	// VideoProber is used by BuildVideo to fill in the dimensions, duration and thumbnail of videos.
+	// FFmpegProber can be used if ffmpeg is installed. If nil, videos are sent without that metadata,
+	// which makes the official clients show a grey box until the video is downloaded.
+	VideoProber VideoProber
+
+	// EnablePollTracking can be set to true to keep track of the votes in polls and emit events.PollResultsUpdated
+	// whenever someone votes. The results are only kept in memory, so votes from before the client was started
+	// aren't counted, and option names are only known for polls that were sent or received while tracking was enabled.
+	EnablePollTracking bool
+	polls              map[pollKey]*trackedPoll
+	pollLock           sync.Mutex
+
+	// EnableAlbumGrouping can be set to true to collect the parts of media albums and emit a single events.Album
+	// instead of separate Message events for the album and each image or video in it. If some media doesn't arrive
+	// within AlbumGroupingTimeout, an incomplete album is emitted.
+	EnableAlbumGrouping bool
+	albums              map[albumKey]*pendingAlbum
+	albumLock           sync.Mutex
+
+	// EnableDispatchTracing can be set to true to record which event handlers ran for each event and how long they took.
+	// The most recent traces are available in Client.DebugSnapshot.
+	EnableDispatchTracing bool
+	dispatchTraces        [dispatchTraceBufferSize]*DispatchTrace
+	dispatchTracePtr      int
+	dispatchTracesLock    sync.Mutex
+
+	serverProps        map[string]string
+	serverPropsRefresh *time.Timer
+	serverPropsLock    sync.RWMutex
+	// SkipFeatureChecks can be set to true to make methods try to use features even if SupportsFeature returns false.
+	SkipFeatureChecks bool
+	// FeatureProps contains the server or AB props that control each feature in SupportsFeature.
+	// The first prop with a boolean value in the fetched props is used, and features without props are assumed to be available.
+	//
+	// whatsmeow doesn't set any props by default, as the props that gate these features aren't known for the
+	// WhatsApp web version it identifies as. Applications that know them can fill this map.
+	FeatureProps map[Feature][]string
 
 	uniqueID  string
 	idCounter uint32
 }
 
-// Size of buffer for the channel that all incoming XML nodes go through.
+// Default size of buffer for the channel that all incoming XML nodes go through.
 // In general it shouldn't go past a few buffered messages, but the channel is big to be safe.
+// The size can be changed with ClientOptions.HandlerQueueSize.
 const handlerQueueSize = 2048
 
 // NewClient initializes a new WhatsApp web client.
@@ -114,6 +404,17 @@ const handlerQueueSize = 2048
 //     }
 //     client := whatsmeow.NewClient(deviceStore, nil)
 func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
+	return NewClientWithOptions(deviceStore, log, ClientOptions{})
+}
+
+// NewClientWithOptions initializes a new WhatsApp web client with the given options. See NewClient for details.
+func NewClientWithOptions(deviceStore *store.Device, log waLog.Logger, opts ClientOptions) *Client {
+	if opts.HandlerQueueSize <= 0 {
+		opts.HandlerQueueSize = handlerQueueSize
+	}
+	if len(opts.HandlerQueueOverflow) == 0 {
+		opts.HandlerQueueOverflow = HandlerQueueOverflowSpawn
+	}
 	if log == nil {
 		log = waLog.Noop
 	}
@@ -128,13 +429,60 @@ func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
 		responseWaiters: make(map[string]chan<- *waBinary.Node),
 		eventHandlers:   make([]wrappedEventHandler, 0, 1),
 		messageRetries:  make(map[string]int),
-		handlerQueue:    make(chan *waBinary.Node, handlerQueueSize),
+		handlerQueue:    make(chan *waBinary.Node, opts.HandlerQueueSize),
 		appStateProc:    appstate.NewProcessor(deviceStore, log.Sub("AppState")),
-
-		recentMessagesMap:  make(map[recentMessageKey]*waProto.Message, recentMessagesSize),
-		GetMessageForRetry: func(to types.JID, id types.MessageID) *waProto.Message { return nil },
-
-		EnableAutoReconnect: true,
+		proxy:           http.ProxyFromEnvironment,
+		http: &http.Client{
+			Transport: (http.DefaultTransport.(*http.Transport)).Clone(),
+		},
+
+		recentMessagesMap:       make(map[recentMessageKey][]byte, recentMessagesSize),
+		GetMessageForRetry:      func(to types.JID, id types.MessageID) *waProto.Message { return nil },
+		MaxRetryReceipts:        MaxRetryReceipts,
+		RetryReceiptDelay:       RetryReceiptDelay,
+		PersistentRetryCacheTTL: DefaultPersistentRetryCacheTTL,
+		MessageSecretTTL:        DefaultMessageSecretTTL,
+		pendingPhoneRerequests:  make(map[types.MessageID]context.CancelFunc),
+
+		viewOnceMap:   make(map[viewOnceMessageKey]*viewOnceMessage, viewOnceMessagesSize),
+		viewOnceMedia: make(map[string]*viewOnceMessage, viewOnceMessagesSize),
+		audioMessages: make(map[audioMessageKey]struct{}, audioMessagesSize),
+
+		mediaRetryWaiters: make(map[types.MessageID]chan<- *events.MediaRetry),
+
+		groupCache:         make(map[types.JID]*cachedGroupInfo),
+		groupCacheFetches:  make(map[types.JID]*groupInfoFetch),
+		GroupInfoCacheTTL:  DefaultGroupInfoCacheTTL,
+		GroupInfoCacheSize: DefaultGroupInfoCacheSize,
+
+		ephemeralExpirations: make(map[types.JID]uint32),
+
+		EncryptionConcurrency: DefaultEncryptionConcurrency,
+		OutboxMaxAttempts:     DefaultOutboxMaxAttempts,
+		outboxWake:            make(chan struct{}, 1),
+		outboxSending:         make(map[types.MessageID]struct{}),
+		outboxStale:           make(map[types.MessageID]struct{}),
+
+		liveLocationShares: make(map[types.MessageID]*LiveLocationShare),
+		liveLocations:      make(map[liveLocationKey]*receivedLiveLocation),
+		polls:              make(map[pollKey]*trackedPoll),
+		albums:             make(map[albumKey]*pendingAlbum),
+		autoReconnectWake:  make(chan struct{}, 1),
+
+		EnableAutoReconnect:    true,
+		AutoReconnectAfterPair: true,
+		AutoReconnectBaseDelay: AutoReconnectBaseDelay,
+		AutoReconnectMaxDelay:  AutoReconnectMaxDelay,
+		HandshakeTimeout:       NoiseHandshakeResponseTimeout,
+
+		KeepAliveIntervalMin:      KeepAliveIntervalMin,
+		KeepAliveIntervalMax:      KeepAliveIntervalMax,
+		KeepAliveResponseDeadline: KeepAliveResponseDeadline,
+		KeepAliveMaxMissed:        KeepAliveMaxMissed,
+	}
+	cli.handlerQueueOverflow = opts.HandlerQueueOverflow
+	if opts.HandlerQueueOverflow == HandlerQueueOverflowSpillToDisk {
+		cli.handlerQueueSpill = &spillQueue{dir: opts.HandlerQueueSpillDir}
 	}
 	cli.nodeHandlers = map[string]nodeHandler{
 		"message":      cli.handleEncryptedMessage,
@@ -152,6 +500,58 @@ func NewClient(deviceStore *store.Device, log waLog.Logger) *Client {
 	return cli
 }
 
+// SetProxyAddress is a helper method that parses a URL string and calls SetProxy.
+//
+// Returns an error if url.Parse fails to parse the given address, or if the scheme isn't http, https or socks5.
+func (cli *Client) SetProxyAddress(addr string) error {
+	parsed, err := url.Parse(addr)
+	if err != nil {
+		return err
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("%w %q", ErrUnsupportedProxyScheme, parsed.Scheme)
+	}
+	cli.SetProxy(http.ProxyURL(parsed))
+	return nil
+}
+
+// SetProxy sets the proxy to use for WhatsApp web websocket connections and media uploads/downloads.
+//
+// Must be called before Connect() to take effect for the websocket connection.
+// By default, the proxy is read from the HTTP_PROXY/HTTPS_PROXY environment variables.
+//
+// Example:
+//   client.SetProxy(http.ProxyURL(&url.URL{Scheme: "socks5", Host: "127.0.0.1:1080"}))
+func (cli *Client) SetProxy(proxy socket.Proxy) {
+	cli.proxy = proxy
+	cli.http.Transport.(*http.Transport).Proxy = proxy
+}
+
+// SetWSDialer sets the dialer to use for WhatsApp web websocket connections. This can be used to customize
+// TLS settings (e.g. custom root CAs), the websocket handshake timeout or the local address to connect from.
+//
+// The TLS config of the dialer is also used for media uploads and downloads. The Proxy field of the dialer is
+// ignored, use SetProxy to change the proxy. The dialer is copied when connecting, so changes only take effect
+// on the next Connect() call. Setting nil restores the default dialer.
+//
+// Example:
+//   client.SetWSDialer(&websocket.Dialer{
+//       TLSClientConfig:  &tls.Config{RootCAs: corporateRoots},
+//       HandshakeTimeout: 20 * time.Second,
+//       NetDial: (&net.Dialer{LocalAddr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10")}}).Dial,
+//   })
+func (cli *Client) SetWSDialer(dialer *websocket.Dialer) {
+	cli.wsDialer = dialer
+	transport := cli.http.Transport.(*http.Transport)
+	if dialer != nil {
+		transport.TLSClientConfig = dialer.TLSClientConfig
+	} else {
+		transport.TLSClientConfig = nil
+	}
+}
+
 // Connect connects the client to the WhatsApp web websocket. After connection, it will either
 // authenticate if there's data in the device store, or emit a QREvent to set up a new link.
 func (cli *Client) Connect() error {
@@ -166,14 +566,32 @@ func (cli *Client) Connect() error {
 	}
 
 	cli.resetExpectedDisconnect()
-	fs := socket.NewFrameSocket(cli.Log.Sub("Socket"), socket.WAConnHeader)
-	if err := fs.Connect(); err != nil {
+	cli.setConnectionState(types.ConnectionStateConnecting)
+	fs := socket.NewFrameSocket(cli.Log.Sub("Socket"), socket.WAConnHeader, cli.proxy)
+	fs.Dialer = cli.wsDialer
+	fs.ReadTimeout = cli.SocketReadTimeout
+	fs.WriteTimeout = cli.SocketWriteTimeout
+	fs.OnTraffic = cli.TrafficHook
+	if len(cli.WebsocketURLs) > 0 {
+		fs.URLs = cli.WebsocketURLs
+	}
+	ctx, cancel := context.Background(), func() {}
+	if cli.HandshakeTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, cli.HandshakeTimeout)
+	}
+	defer cancel()
+	if err := fs.ConnectContext(ctx); err != nil {
 		fs.Close(0)
+		cli.setConnectionState(cli.disconnectedState())
 		return err
-	} else if err = cli.doHandshake(fs, *keys.NewKeyPair()); err != nil {
+	}
+	cli.setConnectionState(types.ConnectionStateHandshaking)
+	if err := cli.doHandshake(ctx, fs, *keys.NewKeyPair()); err != nil {
 		fs.Close(0)
+		cli.setConnectionState(cli.disconnectedState())
 		return fmt.Errorf("noise handshake failed: %w", err)
 	}
+	cli.setConnectionState(types.ConnectionStateAuthenticating)
 	go cli.keepAliveLoop(cli.socket.Context())
 	go cli.handlerQueueLoop(cli.socket.Context())
 	return nil
@@ -192,12 +610,19 @@ func (cli *Client) onDisconnect(ns *socket.NoiseSocket, remote bool) {
 		cli.socket = nil
 		cli.clearResponseWaiters()
 		if !cli.isExpectedDisconnect() && remote {
+			if cli.EnableAutoReconnect && cli.Store.ID != nil {
+				cli.setConnectionState(types.ConnectionStateReconnecting)
+			} else {
+				cli.setConnectionState(types.ConnectionStateDisconnected)
+			}
 			cli.Log.Debugf("Emitting Disconnected event")
 			go cli.dispatchEvent(&events.Disconnected{})
 			go cli.autoReconnect()
 		} else if remote {
+			cli.setConnectionState(types.ConnectionStateDisconnected)
 			cli.Log.Debugf("OnDisconnect() called, but it was expected, so not emitting event")
 		} else {
+			cli.setConnectionState(types.ConnectionStateDisconnected)
 			cli.Log.Debugf("OnDisconnect() called after manual disconnection")
 		}
 	} else {
@@ -217,27 +642,6 @@ func (cli *Client) isExpectedDisconnect() bool {
 	return atomic.LoadUint32(&cli.expectedDisconnectVal) == 1
 }
 
-func (cli *Client) autoReconnect() {
-	if !cli.EnableAutoReconnect || cli.Store.ID == nil {
-		return
-	}
-	for {
-		cli.AutoReconnectErrors++
-		autoReconnectDelay := time.Duration(cli.AutoReconnectErrors) * 2 * time.Second
-		cli.Log.Debugf("Automatically reconnecting after %v", autoReconnectDelay)
-		time.Sleep(autoReconnectDelay)
-		err := cli.Connect()
-		if errors.Is(err, ErrAlreadyConnected) {
-			cli.Log.Debugf("Connect() said we're already connected after autoreconnect sleep")
-			return
-		} else if err != nil {
-			cli.Log.Errorf("Error reconnecting after autoreconnect sleep: %v", err)
-		} else {
-			return
-		}
-	}
-}
-
 // IsConnected checks if the client is connected to the WhatsApp web websocket.
 // Note that this doesn't check if the client is authenticated. See the IsLoggedIn field for that.
 func (cli *Client) IsConnected() bool {
@@ -247,8 +651,21 @@ func (cli *Client) IsConnected() bool {
 	return connected
 }
 
+// socketEndpoint returns the websocket URL that the client is currently connected to.
+func (cli *Client) socketEndpoint() string {
+	cli.socketLock.RLock()
+	defer cli.socketLock.RUnlock()
+	if cli.socket == nil {
+		return ""
+	}
+	return cli.socket.Endpoint()
+}
+
 // Disconnect disconnects from the WhatsApp web websocket.
+//
+// If HandlerQueueOverflowSpillToDisk is used, any nodes that are still waiting in the spill file are discarded.
 func (cli *Client) Disconnect() {
+	defer cli.clearSpilledNodes()
 	if cli.socket == nil {
 		return
 	}
@@ -262,6 +679,7 @@ func (cli *Client) unlockedDisconnect() {
 	if cli.socket != nil {
 		cli.socket.Stop(true)
 		cli.socket = nil
+		cli.setConnectionState(cli.disconnectedState())
 	}
 }
 
@@ -371,7 +789,34 @@ func (cli *Client) RemoveEventHandlers() {
 	cli.eventHandlersLock.Unlock()
 }
 
+// SetFrameHook sets a function that is called with every raw frame after decrypting incoming frames and before
+// encrypting outgoing frames. The frames are still compressed and in the binary XML format, see the binary package
+// for decoding them. The hook is called synchronously, so it should return quickly, and it must not modify the data.
+//
+// This is meant for protocol research and debugging. Set nil to remove the hook.
+func (cli *Client) SetFrameHook(hook FrameHook) {
+	cli.frameHook.Store(hook)
+}
+
+// SetEventHandlerErrorCallback sets a function that is called whenever an event handler panics, in addition to
+// logging the panic. Panics are always recovered so that other handlers still receive the event, which means
+// the panicking handler never finishes handling it. The callback can be used to alert on broken handlers,
+// or to store the event in a dead-letter queue so that it can be processed again later.
+//
+// The callback is called synchronously from the goroutine that ran the handler, and it must not panic itself.
+// Set nil to remove the callback.
+func (cli *Client) SetEventHandlerErrorCallback(callback EventHandlerErrorCallback) {
+	cli.handlerErrorCallback.Store(callback)
+}
+
+func (cli *Client) callFrameHook(incoming bool, data []byte) {
+	if hook, _ := cli.frameHook.Load().(FrameHook); hook != nil {
+		hook(incoming, data)
+	}
+}
+
 func (cli *Client) handleFrame(data []byte) {
+	cli.callFrameHook(true, data)
 	decompressed, err := waBinary.Unpack(data)
 	if err != nil {
 		cli.Log.Warnf("Failed to decompress frame: %v", err)
@@ -393,20 +838,14 @@ func (cli *Client) handleFrame(data []byte) {
 	} else if cli.receiveResponse(node) {
 		// handled
 	} else if _, ok := cli.nodeHandlers[node.Tag]; ok {
-		select {
-		case cli.handlerQueue <- node:
-		default:
-			cli.Log.Warnf("Handler queue is full, message ordering is no longer guaranteed")
-			go func() {
-				cli.handlerQueue <- node
-			}()
-		}
+		cli.enqueueNode(node)
 	} else {
 		cli.Log.Debugf("Didn't handle WhatsApp node %s", node.Tag)
 	}
 }
 
 func (cli *Client) handlerQueueLoop(ctx context.Context) {
+	cli.startDecryptionWorkers(ctx)
 	for {
 		select {
 		case node := <-cli.handlerQueue:
@@ -430,19 +869,72 @@ func (cli *Client) sendNode(node waBinary.Node) error {
 	}
 
 	cli.sendLog.Debugf("%s", node.XMLString())
+	cli.callFrameHook(false, payload)
 	return sock.SendFrame(payload)
 }
 
 func (cli *Client) dispatchEvent(evt interface{}) {
+	if evt = cli.applyEventMiddlewares(evt); evt == nil {
+		return
+	}
+	if dispatcher := cli.EventDispatcher; dispatcher != nil {
+		cli.eventHandlersLock.RLock()
+		calls := make([]EventHandlerCall, len(cli.eventHandlers))
+		for i, handler := range cli.eventHandlers {
+			handler := handler
+			calls[i] = EventHandlerCall{
+				HandlerID: handler.id,
+				Event:     evt,
+				run:       func() { cli.runEventHandler(handler, evt, nil) },
+			}
+		}
+		cli.eventHandlersLock.RUnlock()
+		dispatcher.Dispatch(calls)
+		return
+	}
 	cli.eventHandlersLock.RLock()
+	defer cli.eventHandlersLock.RUnlock()
+	var trace *DispatchTrace
+	if cli.EnableDispatchTracing {
+		trace = &DispatchTrace{
+			EventType: fmt.Sprintf("%T", evt),
+			StartedAt: time.Now(),
+			Handlers:  make([]HandlerTrace, 0, len(cli.eventHandlers)),
+		}
+	}
+	for _, handler := range cli.eventHandlers {
+		cli.runEventHandler(handler, evt, trace)
+	}
+	if trace != nil {
+		trace.Duration = time.Since(trace.StartedAt)
+		cli.addDispatchTrace(trace)
+	}
+}
+
+// runEventHandler runs a single event handler, making sure a panic in it doesn't prevent other handlers from running.
+func (cli *Client) runEventHandler(handler wrappedEventHandler, evt interface{}, trace *DispatchTrace) {
+	var start time.Time
+	if trace != nil {
+		start = time.Now()
+	}
 	defer func() {
-		cli.eventHandlersLock.RUnlock()
 		err := recover()
 		if err != nil {
-			cli.Log.Errorf("Event handler panicked while handling a %T: %v\n%s", evt, err, debug.Stack())
+			stack := debug.Stack()
+			cli.Log.Errorf("Event handler #%d panicked while handling a %T: %v\n%s", handler.id, evt, err, stack)
+			if callback, _ := cli.handlerErrorCallback.Load().(EventHandlerErrorCallback); callback != nil {
+				callback(evt, err, stack)
+			}
+		}
+		if trace != nil {
+			handlerTrace := HandlerTrace{HandlerID: handler.id, Duration: time.Since(start)}
+			if err != nil {
+				handlerTrace.Panicked = true
+				handlerTrace.PanicValue = fmt.Sprint(err)
+				trace.Panicked = true
+			}
+			trace.Handlers = append(trace.Handlers, handlerTrace)
 		}
 	}()
-	for _, handler := range cli.eventHandlers {
-		handler.fn(evt)
-	}
+	handler.fn(evt)
 }
@@ -21,23 +21,29 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 	conflict, _ := node.GetOptionalChildByTag("conflict")
 	conflictType := conflict.AttrGetter().OptionalString("type")
 	switch {
+	case code == "515" && (!cli.EnableAutoReconnect || (!cli.AutoReconnectAfterPair && cli.hasPendingPairComplete())):
+		cli.Log.Infof("Got 515 code, but automatic reconnection is disabled, sending ManualLoginReconnect event")
+		cli.expectDisconnect()
+		go func() {
+			cli.Disconnect()
+			cli.setConnectionState(types.ConnectionStateDisconnected)
+			cli.dispatchEvent(&events.ManualLoginReconnect{})
+		}()
 	case code == "515":
 		cli.Log.Infof("Got 515 code, reconnecting...")
 		go func() {
+			atomic.StoreUint32(&cli.isAutoReconnecting, 1)
+			defer atomic.StoreUint32(&cli.isAutoReconnecting, 0)
 			cli.Disconnect()
 			err := cli.Connect()
 			if err != nil {
 				cli.Log.Errorf("Failed to reconnect after 515 code:", err)
+				cli.setConnectionState(types.ConnectionStateDisconnected)
 			}
 		}()
 	case code == "401" && conflictType == "device_removed":
-		cli.expectDisconnect()
-		cli.Log.Infof("Got device removed stream error, sending LoggedOut event and deleting session")
-		go cli.dispatchEvent(&events.LoggedOut{OnConnect: false})
-		err := cli.Store.Delete()
-		if err != nil {
-			cli.Log.Warnf("Failed to delete store after device_removed error: %v", err)
-		}
+		cli.Log.Infof("Got device removed stream error, sending LoggedOut event")
+		cli.handleLoggedOut(false, conflictType)
 	case conflictType == "replaced":
 		cli.expectDisconnect()
 		cli.Log.Infof("Got replaced stream error, sending StreamReplaced event")
@@ -52,6 +58,20 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 	}
 }
 
+func (cli *Client) handleLoggedOut(onConnect bool, reason string) {
+	cli.expectDisconnect()
+	go cli.dispatchEvent(&events.LoggedOut{OnConnect: onConnect, Reason: reason})
+	if cli.DontDeleteStoreOnLogout {
+		cli.Log.Infof("Not deleting session after logout as DontDeleteStoreOnLogout is set")
+		return
+	}
+	cli.Log.Infof("Deleting session after logout")
+	err := cli.Store.Delete()
+	if err != nil {
+		cli.Log.Warnf("Failed to delete store after logout (%s): %v", reason, err)
+	}
+}
+
 func (cli *Client) handleIB(node *waBinary.Node) {
 	children := node.GetChildren()
 	if len(children) == 1 && children[0].Tag == "downgrade_webclient" {
@@ -63,12 +83,26 @@ func (cli *Client) handleConnectFailure(node *waBinary.Node) {
 	ag := node.AttrGetter()
 	reason := ag.String("reason")
 	if reason == "401" {
+		cli.Log.Infof("Got 401 connect failure, sending LoggedOut event")
+		cli.setPendingPairComplete(nil)
+		cli.handleLoggedOut(true, reason)
+	} else if reason == "402" || reason == "403" {
 		cli.expectDisconnect()
-		cli.Log.Infof("Got 401 connect failure, sending LoggedOut event and deleting session")
-		go cli.dispatchEvent(&events.LoggedOut{OnConnect: true})
-		err := cli.Store.Delete()
-		if err != nil {
-			cli.Log.Warnf("Failed to delete store after 401 failure: %v", err)
+		evt := &events.TemporaryBan{
+			Reason: reason,
+			Code:   events.TempBanReason(ag.OptionalInt("code")),
+			Expire: time.Duration(ag.OptionalInt("expire")) * time.Second,
+		}
+		cli.Log.Warnf("Got %s connect failure: %s", reason, evt)
+		go cli.dispatchEvent(evt)
+	} else if reason == "405" {
+		cli.expectDisconnect()
+		if cli.AutoRefreshWAVersion {
+			cli.Log.Warnf("Got 405 connect failure, trying to refresh client version")
+			go cli.refreshVersionAndReconnect()
+		} else {
+			cli.Log.Errorf("Got 405 connect failure, client version is outdated")
+			go cli.dispatchEvent(&events.ClientOutdated{})
 		}
 	} else {
 		cli.expectDisconnect()
@@ -77,10 +111,28 @@ func (cli *Client) handleConnectFailure(node *waBinary.Node) {
 	}
 }
 
+func (cli *Client) refreshVersionAndReconnect() {
+	if !cli.refreshWAVersion() {
+		cli.Log.Errorf("Couldn't find a newer client version after 405 connect failure")
+		cli.dispatchEvent(&events.ClientOutdated{})
+		return
+	}
+	atomic.StoreUint32(&cli.isAutoReconnecting, 1)
+	defer atomic.StoreUint32(&cli.isAutoReconnecting, 0)
+	cli.Disconnect()
+	err := cli.Connect()
+	if err != nil {
+		cli.Log.Errorf("Failed to reconnect after refreshing client version: %v", err)
+		cli.setConnectionState(types.ConnectionStateDisconnected)
+	}
+}
+
 func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 	cli.Log.Infof("Successfully authenticated")
 	cli.LastSuccessfulConnect = time.Now()
 	cli.AutoReconnectErrors = 0
+	cli.recordConnectSuccess()
+	cli.setConnectionState(types.ConnectionStateConnected)
 	atomic.StoreUint32(&cli.isLoggedIn, 1)
 	go func() {
 		if dbCount, err := cli.Store.PreKeys.UploadedPreKeyCount(); err != nil {
@@ -95,11 +147,24 @@ func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 				cli.Log.Debugf("Prekey count after upload: %d", sc)
 			}
 		}
-		err := cli.SetPassive(false)
-		if err != nil {
+		if cli.InitialAutoPassive {
+			cli.Log.Debugf("Staying in passive mode after connecting as InitialAutoPassive is set")
+		} else if err := cli.SetPassive(false); err != nil {
 			cli.Log.Warnf("Failed to send post-connect passive IQ: %v", err)
 		}
-		cli.dispatchEvent(&events.Connected{})
+		cli.dispatchEvent(&events.Connected{Endpoint: cli.socketEndpoint()})
+		go cli.refreshServerProps()
+		if evt := cli.takePendingPairComplete(); evt != nil {
+			if len(evt.BusinessName) > 0 || cli.PairBusinessOnly {
+				cli.fetchPairedVerifiedName(evt)
+			}
+			cli.Log.Infof("Reconnected after pairing, sending PairComplete event")
+			cli.dispatchEvent(evt)
+		}
+		cli.resumeLiveLocations()
+		cli.startOutbox()
+		cli.pruneSentMessages()
+		cli.pruneMessageSecrets()
 	}()
 }
 
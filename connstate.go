@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// GetConnectionState returns the current state of the connection to the WhatsApp servers.
+//
+// Unlike IsConnected and IsLoggedIn, this can also tell apart the different phases of connecting
+// and whether an automatic reconnection is pending.
+func (cli *Client) GetConnectionState() types.ConnectionState {
+	cli.connStateLock.Lock()
+	defer cli.connStateLock.Unlock()
+	if len(cli.connState) == 0 {
+		return types.ConnectionStateDisconnected
+	}
+	return cli.connState
+}
+
+// setConnectionState changes the connection state and queues a ConnectionStateChanged event if it actually changed.
+//
+// The state can change while the socket lock is held, so the events are dispatched in a separate goroutine,
+// but always in the same order as the state changes happened.
+func (cli *Client) setConnectionState(state types.ConnectionState) {
+	cli.connStateLock.Lock()
+	defer cli.connStateLock.Unlock()
+	previous := cli.connState
+	if len(previous) == 0 {
+		previous = types.ConnectionStateDisconnected
+	}
+	if previous == state {
+		return
+	}
+	cli.connState = state
+	if cli.connStateChanged != nil {
+		close(cli.connStateChanged)
+		cli.connStateChanged = nil
+	}
+	cli.connStateEvents = append(cli.connStateEvents, &events.ConnectionStateChanged{Previous: previous, State: state})
+	if !cli.connStateDispatching {
+		cli.connStateDispatching = true
+		go cli.dispatchConnectionStateEvents()
+	}
+}
+
+func (cli *Client) dispatchConnectionStateEvents() {
+	for {
+		cli.connStateLock.Lock()
+		if len(cli.connStateEvents) == 0 {
+			cli.connStateDispatching = false
+			cli.connStateLock.Unlock()
+			return
+		}
+		evt := cli.connStateEvents[0]
+		cli.connStateEvents = cli.connStateEvents[1:]
+		cli.connStateLock.Unlock()
+		cli.dispatchEvent(evt)
+	}
+}
+
+// WaitForConnection blocks until the client is connected and logged in, or until the timeout lapses.
+// See WaitForConnectionContext for details.
+func (cli *Client) WaitForConnection(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return cli.WaitForConnectionContext(ctx)
+}
+
+// WaitForConnectionContext blocks until the client is connected and logged in, or until the context is canceled.
+//
+// It returns true if the client is connected. It returns false if the context is canceled, or if the client
+// is disconnected without an automatic reconnection pending (e.g. after being logged out, after Disconnect()
+// is called, or when Connect() hasn't been called at all).
+func (cli *Client) WaitForConnectionContext(ctx context.Context) bool {
+	for {
+		cli.connStateLock.Lock()
+		state := cli.connState
+		if cli.connStateChanged == nil {
+			cli.connStateChanged = make(chan struct{})
+		}
+		changed := cli.connStateChanged
+		cli.connStateLock.Unlock()
+		switch state {
+		case types.ConnectionStateConnected:
+			return true
+		case types.ConnectionStateDisconnected, "":
+			return false
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
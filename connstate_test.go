@@ -0,0 +1,96 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestConnectionStateEventOrder(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	changes := make(chan *events.ConnectionStateChanged, 16)
+	cli.AddEventHandler(func(evt interface{}) {
+		if change, ok := evt.(*events.ConnectionStateChanged); ok {
+			changes <- change
+		}
+	})
+	if state := cli.GetConnectionState(); state != types.ConnectionStateDisconnected {
+		t.Fatalf("Expected initial state to be disconnected, got %s", state)
+	}
+	sequence := []types.ConnectionState{
+		types.ConnectionStateConnecting,
+		types.ConnectionStateHandshaking,
+		types.ConnectionStateAuthenticating,
+		types.ConnectionStateAuthenticating, // Duplicate changes must not emit events
+		types.ConnectionStateConnected,
+		types.ConnectionStateDisconnected,
+		types.ConnectionStateReconnecting,
+	}
+	for _, state := range sequence {
+		cli.setConnectionState(state)
+	}
+	if state := cli.GetConnectionState(); state != types.ConnectionStateReconnecting {
+		t.Errorf("Expected final state to be reconnecting, got %s", state)
+	}
+	previous := types.ConnectionStateDisconnected
+	for _, expected := range []types.ConnectionState{
+		types.ConnectionStateConnecting,
+		types.ConnectionStateHandshaking,
+		types.ConnectionStateAuthenticating,
+		types.ConnectionStateConnected,
+		types.ConnectionStateDisconnected,
+		types.ConnectionStateReconnecting,
+	} {
+		select {
+		case change := <-changes:
+			if change.Previous != previous || change.State != expected {
+				t.Fatalf("Expected change %s -> %s, got %s -> %s", previous, expected, change.Previous, change.State)
+			}
+			previous = expected
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for change to %s", expected)
+		}
+	}
+	select {
+	case change := <-changes:
+		t.Errorf("Unexpected extra change %s -> %s", change.Previous, change.State)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWaitForConnection(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	if cli.WaitForConnection(time.Second) {
+		t.Fatalf("WaitForConnection returned true before connecting")
+	}
+	cli.setConnectionState(types.ConnectionStateConnecting)
+	if cli.WaitForConnection(10 * time.Millisecond) {
+		t.Fatalf("WaitForConnection returned true while still connecting")
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cli.setConnectionState(types.ConnectionStateAuthenticating)
+		time.Sleep(10 * time.Millisecond)
+		cli.setConnectionState(types.ConnectionStateConnected)
+	}()
+	if !cli.WaitForConnection(5 * time.Second) {
+		t.Fatalf("WaitForConnection returned false after connecting")
+	}
+	cli.setConnectionState(types.ConnectionStateReconnecting)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cli.setConnectionState(types.ConnectionStateDisconnected)
+	}()
+	if cli.WaitForConnection(5 * time.Second) {
+		t.Fatalf("WaitForConnection returned true after giving up on reconnecting")
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sort"
+	"time"
+)
+
+// Number of keepalive round-trip times to remember for calculating the statistics in ConnectionStats.
+const rttSampleSize = 64
+
+// ConnectionStats contains information about the health of the connection to the WhatsApp servers.
+type ConnectionStats struct {
+	// Whether the websocket is currently connected.
+	Connected bool
+	// When the client last successfully authenticated.
+	LastConnected time.Time
+	// When the last response to a keepalive ping was received. Zero if no pings have succeeded yet.
+	LastPong time.Time
+
+	// Round-trip time statistics of the most recent successful keepalive pings.
+	// All of these are zero if there are no samples yet.
+	RTTSamples int
+	LastRTT    time.Duration
+	AverageRTT time.Duration
+	MedianRTT  time.Duration
+	P95RTT     time.Duration
+	MaxRTT     time.Duration
+
+	// The number of times the client has successfully reconnected after the first connection.
+	ReconnectCount int
+
+	// The number of bytes and frames sent and received through the current websocket.
+	// Byte counts include frame headers. These are reset when reconnecting.
+	BytesSent      uint64
+	BytesReceived  uint64
+	FramesSent     uint64
+	FramesReceived uint64
+}
+
+// recordKeepAliveRTT stores the round-trip time of a successful keepalive ping.
+func (cli *Client) recordKeepAliveRTT(rtt time.Duration) {
+	cli.connStatsLock.Lock()
+	cli.lastPong = time.Now()
+	if len(cli.rttSamples) < rttSampleSize {
+		cli.rttSamples = append(cli.rttSamples, rtt)
+	} else {
+		cli.rttSamples[cli.rttSamplePtr] = rtt
+	}
+	cli.lastRTT = rtt
+	cli.rttSamplePtr = (cli.rttSamplePtr + 1) % rttSampleSize
+	cli.connStatsLock.Unlock()
+}
+
+// recordConnectSuccess updates the reconnect counter after a successful authentication.
+func (cli *Client) recordConnectSuccess() {
+	cli.connStatsLock.Lock()
+	if cli.hasConnected {
+		cli.reconnectCount++
+	}
+	cli.hasConnected = true
+	cli.connStatsLock.Unlock()
+}
+
+// percentile returns the p-th percentile (0 < p <= 1) of the given sorted durations using the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	if rank < 0 {
+		rank = 0
+	} else if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// ConnectionStats returns statistics about the health of the connection, such as keepalive round-trip times.
+// This can be used to decide when to proactively reconnect.
+func (cli *Client) ConnectionStats() ConnectionStats {
+	stats := ConnectionStats{
+		LastConnected: cli.LastSuccessfulConnect,
+	}
+	cli.socketLock.RLock()
+	if cli.socket != nil {
+		stats.Connected = cli.socket.IsConnected()
+		stats.BytesSent = cli.socket.BytesSent()
+		stats.BytesReceived = cli.socket.BytesReceived()
+		stats.FramesSent = cli.socket.FramesSent()
+		stats.FramesReceived = cli.socket.FramesReceived()
+	}
+	cli.socketLock.RUnlock()
+
+	cli.connStatsLock.Lock()
+	stats.LastPong = cli.lastPong
+	stats.LastRTT = cli.lastRTT
+	stats.ReconnectCount = cli.reconnectCount
+	samples := make([]time.Duration, len(cli.rttSamples))
+	copy(samples, cli.rttSamples)
+	cli.connStatsLock.Unlock()
+
+	stats.RTTSamples = len(samples)
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i] < samples[j]
+		})
+		var total time.Duration
+		for _, sample := range samples {
+			total += sample
+		}
+		stats.AverageRTT = total / time.Duration(len(samples))
+		stats.MedianRTT = percentile(samples, 0.5)
+		stats.P95RTT = percentile(samples, 0.95)
+		stats.MaxRTT = samples[len(samples)-1]
+	}
+	return stats
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestConnectionStatsRTT(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	if stats := cli.ConnectionStats(); stats.RTTSamples != 0 || stats.AverageRTT != 0 || !stats.LastPong.IsZero() {
+		t.Fatalf("Expected empty stats, got %+v", stats)
+	}
+	// Fill the ring buffer with junk that should be overwritten by the real samples
+	for i := 0; i < rttSampleSize; i++ {
+		cli.recordKeepAliveRTT(time.Hour)
+	}
+	for i := 1; i <= rttSampleSize; i++ {
+		cli.recordKeepAliveRTT(time.Duration(i) * time.Millisecond)
+	}
+	stats := cli.ConnectionStats()
+	if stats.RTTSamples != rttSampleSize {
+		t.Errorf("Expected %d samples, got %d", rttSampleSize, stats.RTTSamples)
+	}
+	if stats.LastRTT != rttSampleSize*time.Millisecond || stats.MaxRTT != rttSampleSize*time.Millisecond {
+		t.Errorf("Unexpected last/max RTT %s/%s", stats.LastRTT, stats.MaxRTT)
+	}
+	if stats.MedianRTT != 32*time.Millisecond {
+		t.Errorf("Unexpected median RTT %s", stats.MedianRTT)
+	}
+	if stats.P95RTT != 61*time.Millisecond {
+		t.Errorf("Unexpected 95th percentile RTT %s", stats.P95RTT)
+	}
+	if expected := 32500 * time.Microsecond; stats.AverageRTT != expected {
+		t.Errorf("Unexpected average RTT %s (expected %s)", stats.AverageRTT, expected)
+	}
+
+	cli.recordConnectSuccess()
+	cli.recordConnectSuccess()
+	cli.recordConnectSuccess()
+	if stats = cli.ConnectionStats(); stats.ReconnectCount != 2 {
+		t.Errorf("Expected 2 reconnects, got %d", stats.ReconnectCount)
+	}
+}
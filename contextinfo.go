@@ -0,0 +1,187 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/internal/ctxinfoutil"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// GetContextInfo returns the ContextInfo of the content in the given message, or nil if it doesn't have one.
+func GetContextInfo(msg *waProto.Message) *waProto.ContextInfo {
+	return ctxinfoutil.Find(msg, false)
+}
+
+// NewReply fills the ContextInfo of the given message so that it's sent as a reply to the original message.
+//
+// Plain text replies are converted into extended text messages. The reply message is modified in place and also returned
+// for convenience, e.g.
+//
+//	cli.SendMessage(evt.Info.Chat, "", whatsmeow.NewReply(evt, &waProto.Message{Conversation: proto.String("Hi")}))
+//
+// Messages that can't be replies, like reactions and protocol messages, don't have a ContextInfo and are returned unchanged.
+func NewReply(original *events.Message, reply *waProto.Message) *waProto.Message {
+	ctxInfo := ctxinfoutil.Find(reply, true)
+	if ctxInfo == nil {
+		return reply
+	}
+	quoted := proto.Clone(original.Message).(*waProto.Message)
+	// Don't include the message that the original message was replying to, official clients don't nest quotes either.
+	if quotedCtx := GetContextInfo(quoted); quotedCtx != nil {
+		quotedCtx.StanzaId = nil
+		quotedCtx.Participant = nil
+		quotedCtx.QuotedMessage = nil
+		quotedCtx.RemoteJid = nil
+	}
+	ctxInfo.StanzaId = proto.String(original.Info.ID)
+	// The participant must always be a plain user JID without the device part, even in DMs.
+	ctxInfo.Participant = proto.String(original.Info.Sender.ToNonAD().String())
+	ctxInfo.QuotedMessage = quoted
+	return reply
+}
+
+var mentionRegex = regexp.MustCompile(`(?:^|[^\w@])@(\d{5,20})\b`)
+
+// ParseMentions finds all @<phone> tokens in the given text and returns the corresponding user JIDs.
+// Each user is only included once, even if they're mentioned multiple times.
+func ParseMentions(text string) []types.JID {
+	matches := mentionRegex.FindAllStringSubmatch(text, -1)
+	mentions := make([]types.JID, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		mentions = append(mentions, types.NewJID(match[1], types.DefaultUserServer))
+	}
+	return mentions
+}
+
+// NewMentionMessage builds an extended text message that mentions the given users.
+//
+// The text should contain @<phone> tokens for each mentioned user, as that's what the official clients replace with the
+// user's name. If no JIDs are given, the mentions are parsed from the text using ParseMentions.
+func NewMentionMessage(text string, mentions ...types.JID) *waProto.Message {
+	if len(mentions) == 0 {
+		mentions = ParseMentions(text)
+	}
+	mentionStrings := make([]string, len(mentions))
+	for i, jid := range mentions {
+		mentionStrings[i] = jid.ToNonAD().String()
+	}
+	return &waProto.Message{ExtendedTextMessage: &waProto.ExtendedTextMessage{
+		Text:        proto.String(text),
+		ContextInfo: &waProto.ContextInfo{MentionedJid: mentionStrings},
+	}}
+}
+
+// BuildForward builds a copy of the given message that can be sent to another chat as a forwarded message.
+//
+// Quotes and other chat-specific context like disappearing message timers are removed, and the forwarding score is
+// incremented like the official clients do. Media messages keep the original media keys and paths, as the uploaded file
+// can be reused as long as it hasn't expired on the server. Use Client.BuildForwardWithMedia to re-upload expired media.
+func BuildForward(msg *waProto.Message) *waProto.Message {
+	// Messages sent from other devices of the current user are wrapped in a DeviceSentMessage,
+	// which may contain an EphemeralMessage, so the wrappers must be removed in this order.
+	if deviceSent := msg.GetDeviceSentMessage().GetMessage(); deviceSent != nil {
+		msg = deviceSent
+	}
+	if ephemeral := msg.GetEphemeralMessage().GetMessage(); ephemeral != nil {
+		msg = ephemeral
+	}
+	forward := proto.Clone(msg).(*waProto.Message)
+	forward.MessageContextInfo = nil
+	ctxInfo := ctxinfoutil.Find(forward, true)
+	if ctxInfo == nil {
+		return forward
+	}
+	score, mentions := ctxInfo.GetForwardingScore(), ctxInfo.MentionedJid
+	proto.Reset(ctxInfo)
+	ctxInfo.IsForwarded = proto.Bool(true)
+	ctxInfo.ForwardingScore = proto.Uint32(score + 1)
+	ctxInfo.MentionedJid = mentions
+	return forward
+}
+
+// forwardMediaMinLifetime is how long forwarded media must still be downloadable for BuildForwardWithMedia to reuse it.
+const forwardMediaMinLifetime = 1 * time.Hour
+
+// BuildForwardWithMedia builds a forwarded copy of the given message like BuildForward, but also re-uploads the media
+// in the message if it has expired or is about to expire (according to MediaExpiresAt), so that recipients can
+// still download it. Media that isn't expiring soon is reused without downloading it.
+func (cli *Client) BuildForwardWithMedia(ctx context.Context, msg *waProto.Message) (*waProto.Message, error) {
+	forward := BuildForward(msg)
+	media := getForwardMedia(forward)
+	if media == nil {
+		return forward, nil
+	} else if expiry, ok := MediaExpiresAt(media); ok && time.Until(expiry) > forwardMediaMinLifetime {
+		return forward, nil
+	}
+	data, err := cli.Download(media)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download media to re-upload: %w", err)
+	}
+	uploaded, err := cli.Upload(ctx, data, classToMediaType[media.ProtoReflect().Descriptor().Name()])
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-upload media: %w", err)
+	}
+	setUploadedMedia(media, uploaded, time.Now())
+	return forward, nil
+}
+
+// getForwardMedia returns the media content of the given message that BuildForwardWithMedia can re-upload.
+func getForwardMedia(msg *waProto.Message) DownloadableMessage {
+	switch {
+	case msg.ImageMessage != nil:
+		return msg.ImageMessage
+	case msg.VideoMessage != nil:
+		return msg.VideoMessage
+	case msg.AudioMessage != nil:
+		return msg.AudioMessage
+	case msg.DocumentMessage != nil:
+		return msg.DocumentMessage
+	case msg.StickerMessage != nil:
+		return msg.StickerMessage
+	default:
+		return nil
+	}
+}
+
+// setUploadedMedia replaces the media handles in the given message with a new upload.
+// Thumbnails that were uploaded separately are removed, as they're encrypted with the old media key.
+func setUploadedMedia(media DownloadableMessage, uploaded UploadResponse, now time.Time) {
+	content := media.ProtoReflect()
+	fields := content.Descriptor().Fields()
+	set := func(name protoreflect.Name, value protoreflect.Value) {
+		if field := fields.ByName(name); field != nil {
+			content.Set(field, value)
+		}
+	}
+	set("url", protoreflect.ValueOfString(uploaded.URL))
+	set("directPath", protoreflect.ValueOfString(uploaded.DirectPath))
+	set("mediaKey", protoreflect.ValueOfBytes(uploaded.MediaKey))
+	set("fileEncSha256", protoreflect.ValueOfBytes(uploaded.FileEncSHA256))
+	set("fileSha256", protoreflect.ValueOfBytes(uploaded.FileSHA256))
+	set("fileLength", protoreflect.ValueOfUint64(uploaded.FileLength))
+	set("mediaKeyTimestamp", protoreflect.ValueOfInt64(now.Unix()))
+	for _, name := range []protoreflect.Name{"thumbnailDirectPath", "thumbnailSha256", "thumbnailEncSha256", "midQualityFileSha256", "midQualityFileEncSha256", "staticUrl"} {
+		if field := fields.ByName(name); field != nil {
+			content.Clear(field)
+		}
+	}
+}
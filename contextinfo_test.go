@@ -0,0 +1,224 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestNewReply(t *testing.T) {
+	original := &events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:    types.NewJID("123456789-1234567890", types.GroupServer),
+				Sender:  types.NewADJID("1234567890", 0, 5),
+				IsGroup: true,
+			},
+			ID: "ABCDEF",
+		},
+		Message: &waProto.Message{ExtendedTextMessage: &waProto.ExtendedTextMessage{
+			Text: proto.String("Hello"),
+			ContextInfo: &waProto.ContextInfo{
+				StanzaId:      proto.String("OLDER"),
+				QuotedMessage: &waProto.Message{Conversation: proto.String("Older message")},
+				MentionedJid:  []string{"1234567890@s.whatsapp.net"},
+			},
+		}},
+	}
+	reply := NewReply(original, &waProto.Message{Conversation: proto.String("Hi")})
+	if reply.Conversation != nil || reply.GetExtendedTextMessage().GetText() != "Hi" {
+		t.Fatalf("Plain text reply wasn't converted to an extended text message")
+	}
+	ctxInfo := reply.GetExtendedTextMessage().GetContextInfo()
+	if ctxInfo.GetStanzaId() != "ABCDEF" {
+		t.Errorf("Unexpected stanza ID %q", ctxInfo.GetStanzaId())
+	}
+	if ctxInfo.GetParticipant() != "1234567890@s.whatsapp.net" {
+		t.Errorf("Unexpected participant %q", ctxInfo.GetParticipant())
+	}
+	quotedCtx := ctxInfo.GetQuotedMessage().GetExtendedTextMessage().GetContextInfo()
+	if quotedCtx.GetQuotedMessage() != nil || quotedCtx.GetStanzaId() != "" {
+		t.Errorf("Quoted message contains nested quote")
+	} else if len(quotedCtx.GetMentionedJid()) != 1 {
+		t.Errorf("Mentions in quoted message were removed")
+	}
+	if original.Message.GetExtendedTextMessage().GetContextInfo().GetQuotedMessage() == nil {
+		t.Errorf("Original message was modified")
+	}
+}
+
+func TestNewReplyWithoutContextInfo(t *testing.T) {
+	original := &events.Message{
+		Info:    types.MessageInfo{ID: "ABCDEF"},
+		Message: &waProto.Message{Conversation: proto.String("Hello")},
+	}
+	reaction := &waProto.Message{ReactionMessage: &waProto.ReactionMessage{Text: proto.String("👍")}}
+	if reply := NewReply(original, reaction); reply != reaction || reply.GetReactionMessage().GetText() != "👍" {
+		t.Errorf("Expected reaction to be returned unchanged, got %+v", reply)
+	}
+}
+
+func TestGetContextInfoEphemeral(t *testing.T) {
+	msg := &waProto.Message{EphemeralMessage: &waProto.FutureProofMessage{Message: &waProto.Message{
+		ImageMessage: &waProto.ImageMessage{ContextInfo: &waProto.ContextInfo{Expiration: proto.Uint32(86400)}},
+	}}}
+	if GetContextInfo(msg).GetExpiration() != 86400 {
+		t.Errorf("Didn't find context info inside ephemeral message")
+	}
+	if GetContextInfo(&waProto.Message{Conversation: proto.String("Hi")}) != nil {
+		t.Errorf("Found context info in plain text message")
+	}
+}
+
+func TestNewMentionMessage(t *testing.T) {
+	msg := NewMentionMessage("Hi @1234567890 and @9876543210, @1234567890 again. Not email@11111111 or @123")
+	mentions := msg.GetExtendedTextMessage().GetContextInfo().GetMentionedJid()
+	if len(mentions) != 2 || mentions[0] != "1234567890@s.whatsapp.net" || mentions[1] != "9876543210@s.whatsapp.net" {
+		t.Fatalf("Unexpected mentions %v", mentions)
+	}
+	evt := &events.Message{Message: msg}
+	parsed := evt.GetMentionedJIDs()
+	if len(parsed) != 2 || parsed[0].User != "1234567890" || parsed[1].Server != types.DefaultUserServer {
+		t.Fatalf("Unexpected parsed mentions %v", parsed)
+	}
+}
+
+func TestBuildForward(t *testing.T) {
+	original := &waProto.Message{EphemeralMessage: &waProto.FutureProofMessage{Message: &waProto.Message{
+		ImageMessage: &waProto.ImageMessage{
+			DirectPath: proto.String("/v/t62.7118-24/123"),
+			MediaKey:   []byte{1, 2, 3},
+			ContextInfo: &waProto.ContextInfo{
+				StanzaId:        proto.String("ABCDEF"),
+				QuotedMessage:   &waProto.Message{Conversation: proto.String("Hello")},
+				Expiration:      proto.Uint32(86400),
+				ForwardingScore: proto.Uint32(2),
+			},
+		},
+	}}}
+	forward := BuildForward(original)
+	img := forward.GetImageMessage()
+	if forward.EphemeralMessage != nil || img == nil {
+		t.Fatalf("Ephemeral wrapper wasn't removed")
+	} else if img.GetDirectPath() != "/v/t62.7118-24/123" || len(img.GetMediaKey()) != 3 {
+		t.Errorf("Media handles weren't kept")
+	}
+	ctxInfo := img.GetContextInfo()
+	if !ctxInfo.GetIsForwarded() || ctxInfo.GetForwardingScore() != 3 {
+		t.Errorf("Unexpected forwarding info: forwarded=%t score=%d", ctxInfo.GetIsForwarded(), ctxInfo.GetForwardingScore())
+	} else if ctxInfo.QuotedMessage != nil || ctxInfo.StanzaId != nil || ctxInfo.Expiration != nil {
+		t.Errorf("Chat-specific context info wasn't removed")
+	}
+	if original.GetEphemeralMessage().GetMessage().GetImageMessage().GetContextInfo().GetIsForwarded() {
+		t.Errorf("Original message was modified")
+	}
+
+	deviceSent := &waProto.Message{DeviceSentMessage: &waProto.DeviceSentMessage{
+		DestinationJid: proto.String("1234567890@s.whatsapp.net"),
+		Message:        original,
+	}}
+	if forward = BuildForward(deviceSent); forward.GetImageMessage() == nil {
+		t.Errorf("Ephemeral message inside device sent message wasn't unwrapped: %+v", forward)
+	}
+}
+
+// newFakeMediaServer starts a HTTPS server that accepts media uploads and serves the uploaded files.
+// All URLs it returns have already expired according to MediaExpiresAt.
+func newFakeMediaServer(t *testing.T, cli *Client) {
+	var lock sync.Mutex
+	files := make(map[string][]byte)
+	expired := fmt.Sprintf("%x", time.Now().Add(-time.Hour).Unix())
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch {
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/mms/"):
+			data, _ := io.ReadAll(r.Body)
+			path := fmt.Sprintf("/media/%d", len(files))
+			files[path] = data
+			_, _ = fmt.Fprintf(w, `{"url": "https://%s%s?oe=%s"}`, r.Host, path, expired)
+		case r.Method == http.MethodGet && files[r.URL.Path] != nil:
+			_, _ = w.Write(files[r.URL.Path])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	cli.http = server.Client()
+	cli.mediaConn = &MediaConn{
+		Auth:      "auth",
+		TTL:       3600,
+		FetchedAt: time.Now(),
+		Hosts:     []MediaConnHost{{Hostname: strings.TrimPrefix(server.URL, "https://")}},
+	}
+}
+
+func TestBuildForwardWithMedia(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	newFakeMediaServer(t, cli)
+	ctx := context.Background()
+	data := []byte("fake image data")
+	uploaded, err := cli.Upload(ctx, data, MediaImage)
+	if err != nil {
+		t.Fatalf("Failed to upload original media: %v", err)
+	}
+	original := &waProto.Message{ImageMessage: &waProto.ImageMessage{
+		Url:                 proto.String(uploaded.URL),
+		MediaKey:            uploaded.MediaKey,
+		FileEncSha256:       uploaded.FileEncSHA256,
+		FileSha256:          uploaded.FileSHA256,
+		FileLength:          proto.Uint64(uploaded.FileLength),
+		ThumbnailDirectPath: proto.String("/v/t62.36145-24/thumb"),
+		JpegThumbnail:       []byte("thumbnail"),
+		ContextInfo:         &waProto.ContextInfo{StanzaId: proto.String("ABCDEF")},
+	}}
+
+	forward, err := cli.BuildForwardWithMedia(ctx, original)
+	if err != nil {
+		t.Fatalf("Failed to build forward: %v", err)
+	}
+	img := forward.GetImageMessage()
+	if img.GetUrl() == uploaded.URL || bytes.Equal(img.GetMediaKey(), uploaded.MediaKey) {
+		t.Errorf("Expired media wasn't re-uploaded")
+	} else if img.ThumbnailDirectPath != nil || !bytes.Equal(img.GetJpegThumbnail(), []byte("thumbnail")) {
+		t.Errorf("Expected only the separately uploaded thumbnail to be removed")
+	} else if !img.GetContextInfo().GetIsForwarded() || img.GetContextInfo().StanzaId != nil {
+		t.Errorf("Re-uploaded message isn't marked as forwarded")
+	}
+	if redownloaded, err := cli.Download(img); err != nil {
+		t.Errorf("Failed to download re-uploaded media: %v", err)
+	} else if !bytes.Equal(redownloaded, data) {
+		t.Errorf("Re-uploaded media doesn't match original")
+	}
+	if original.GetImageMessage().GetUrl() != uploaded.URL {
+		t.Errorf("Original message was modified")
+	}
+
+	valid := proto.Clone(original).(*waProto.Message)
+	valid.ImageMessage.Url = proto.String(fmt.Sprintf("https://mmg.whatsapp.net/v/123?oe=%x", time.Now().Add(24*time.Hour).Unix()))
+	if forward, err = cli.BuildForwardWithMedia(ctx, valid); err != nil {
+		t.Errorf("Failed to build forward with valid media: %v", err)
+	} else if forward.GetImageMessage().GetUrl() != valid.GetImageMessage().GetUrl() {
+		t.Errorf("Media that hasn't expired wasn't reused")
+	}
+}
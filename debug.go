@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+)
+
+// dispatchTraceBufferSize is the number of event dispatch traces to keep when Client.EnableDispatchTracing is set.
+const dispatchTraceBufferSize = 128
+
+// HandlerTrace contains information about a single event handler call.
+type HandlerTrace struct {
+	HandlerID  uint32        // The ID returned by Client.AddEventHandler.
+	Duration   time.Duration // How long the handler took to return.
+	Panicked   bool          // Whether the handler panicked.
+	PanicValue string        // The value passed to panic(), if the handler panicked.
+}
+
+// DispatchTrace contains information about dispatching a single event to all event handlers.
+type DispatchTrace struct {
+	EventType string         // The Go type of the event, e.g. *events.Message.
+	StartedAt time.Time      // When the dispatch started.
+	Duration  time.Duration  // How long it took for all handlers to run.
+	Panicked  bool           // Whether any of the handlers panicked.
+	Handlers  []HandlerTrace // The handlers that ran, in order.
+}
+
+// DebugSnapshot contains information about the internal state of the client, intended for debugging.
+type DebugSnapshot struct {
+	Connected bool
+	LoggedIn  bool
+
+	EventHandlerIDs []uint32        // The IDs of all currently registered event handlers, in the order they're called.
+	DispatchTraces  []DispatchTrace // The most recent event dispatches (oldest first), if Client.EnableDispatchTracing is set.
+}
+
+func (cli *Client) addDispatchTrace(trace *DispatchTrace) {
+	cli.dispatchTracesLock.Lock()
+	cli.dispatchTraces[cli.dispatchTracePtr] = trace
+	cli.dispatchTracePtr = (cli.dispatchTracePtr + 1) % len(cli.dispatchTraces)
+	cli.dispatchTracesLock.Unlock()
+}
+
+// DebugSnapshot returns a snapshot of the client's internal state.
+//
+// This is mostly useful for diagnosing problems like event handlers not being called:
+// set Client.EnableDispatchTracing to see which handlers ran for recent events.
+func (cli *Client) DebugSnapshot() *DebugSnapshot {
+	snapshot := &DebugSnapshot{
+		Connected: cli.IsConnected(),
+		LoggedIn:  cli.IsLoggedIn(),
+	}
+
+	cli.eventHandlersLock.RLock()
+	snapshot.EventHandlerIDs = make([]uint32, len(cli.eventHandlers))
+	for i, handler := range cli.eventHandlers {
+		snapshot.EventHandlerIDs[i] = handler.id
+	}
+	cli.eventHandlersLock.RUnlock()
+
+	cli.dispatchTracesLock.Lock()
+	for i := 0; i < len(cli.dispatchTraces); i++ {
+		trace := cli.dispatchTraces[(cli.dispatchTracePtr+i)%len(cli.dispatchTraces)]
+		if trace != nil {
+			traceCopy := *trace
+			traceCopy.Handlers = append([]HandlerTrace(nil), trace.Handlers...)
+			snapshot.DispatchTraces = append(snapshot.DispatchTraces, traceCopy)
+		}
+	}
+	cli.dispatchTracesLock.Unlock()
+	return snapshot
+}
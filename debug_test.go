@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestDispatchEventPanicRecovery(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	cli.EnableDispatchTracing = true
+	var called []int
+	for i := 0; i < 5; i++ {
+		i := i
+		cli.AddEventHandler(func(evt interface{}) {
+			called = append(called, i)
+			if i == 2 {
+				panic("handler 2 failed")
+			}
+		})
+	}
+	cli.dispatchEvent(&events.Connected{})
+	if len(called) != 5 {
+		t.Fatalf("Expected all 5 handlers to be called, got %v", called)
+	}
+
+	traces := cli.DebugSnapshot().DispatchTraces
+	if len(traces) != 1 {
+		t.Fatalf("Expected 1 dispatch trace, got %d", len(traces))
+	}
+	trace := traces[0]
+	if trace.EventType != "*events.Connected" || !trace.Panicked || len(trace.Handlers) != 5 {
+		t.Fatalf("Unexpected dispatch trace %+v", trace)
+	}
+	for i, handler := range trace.Handlers {
+		if handler.Panicked != (i == 2) {
+			t.Errorf("Unexpected panic status for handler #%d: %+v", handler.HandlerID, handler)
+		}
+	}
+	if trace.Handlers[2].PanicValue != "handler 2 failed" {
+		t.Errorf("Unexpected panic value %q", trace.Handlers[2].PanicValue)
+	}
+
+	for i := 0; i < dispatchTraceBufferSize+10; i++ {
+		cli.dispatchEvent(&events.Disconnected{})
+	}
+	traces = cli.DebugSnapshot().DispatchTraces
+	if len(traces) != dispatchTraceBufferSize {
+		t.Errorf("Expected trace buffer to be bounded to %d, got %d", dispatchTraceBufferSize, len(traces))
+	} else if traces[0].EventType != "*events.Disconnected" {
+		t.Errorf("Expected oldest traces to be dropped, got %s first", traces[0].EventType)
+	}
+}
+
+func TestEventHandlerErrorCallback(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	var failedEvt, recovered interface{}
+	var stack []byte
+	cli.SetEventHandlerErrorCallback(func(evt interface{}, rec interface{}, st []byte) {
+		failedEvt, recovered, stack = evt, rec, st
+	})
+	cli.AddEventHandler(func(evt interface{}) {
+		panic("handler failed")
+	})
+	evt := &events.Connected{}
+	cli.dispatchEvent(evt)
+	if failedEvt != evt || recovered != "handler failed" || len(stack) == 0 {
+		t.Errorf("Unexpected callback parameters: %v, %v, %d byte stack", failedEvt, recovered, len(stack))
+	}
+
+	failedEvt = nil
+	cli.SetEventHandlerErrorCallback(nil)
+	cli.dispatchEvent(evt)
+	if failedEvt != nil {
+		t.Errorf("Callback was called after removing it")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// Size of the buffer of each decryption worker. When a worker's buffer is full, the handler queue waits for it.
+const decryptionWorkerQueueSize = 256
+
+type decryptionJob struct {
+	info *types.MessageInfo
+	node *waBinary.Node
+}
+
+// decryptionQueue distributes incoming messages to a fixed number of workers based on the chat,
+// so that messages in different chats are decrypted concurrently, while messages in the same chat
+// are always handled by the same worker in the order they were received.
+type decryptionQueue struct {
+	workers []chan decryptionJob
+}
+
+func newDecryptionQueue(ctx context.Context, workers int, handle func(info *types.MessageInfo, node *waBinary.Node)) *decryptionQueue {
+	dq := &decryptionQueue{workers: make([]chan decryptionJob, workers)}
+	for i := range dq.workers {
+		jobs := make(chan decryptionJob, decryptionWorkerQueueSize)
+		dq.workers[i] = jobs
+		go func() {
+			for {
+				select {
+				case job := <-jobs:
+					handle(job.info, job.node)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	return dq
+}
+
+func (dq *decryptionQueue) workerFor(chat types.JID) chan<- decryptionJob {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(chat.String()))
+	return dq.workers[h.Sum32()%uint32(len(dq.workers))]
+}
+
+// push adds a message to the queue of the worker responsible for its chat. It returns false if the context was
+// canceled before the message could be queued.
+func (dq *decryptionQueue) push(ctx context.Context, info *types.MessageInfo, node *waBinary.Node) bool {
+	select {
+	case dq.workerFor(info.Chat) <- decryptionJob{info: info, node: node}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// startDecryptionWorkers creates the decryption workers for the current connection if Client.DecryptionConcurrency
+// is more than 1. It's called when starting the handler queue, and the workers stop when the connection is closed.
+func (cli *Client) startDecryptionWorkers(ctx context.Context) {
+	var dq *decryptionQueue
+	if cli.DecryptionConcurrency > 1 {
+		dq = newDecryptionQueue(ctx, cli.DecryptionConcurrency, cli.decryptMessages)
+	}
+	cli.decryptQueueLock.Lock()
+	cli.decryptQueue = dq
+	cli.decryptQueueCtx = ctx
+	cli.decryptQueueLock.Unlock()
+}
+
+// queueDecryption passes the message to the decryption workers, or decrypts it immediately if parallel
+// decryption isn't enabled.
+//
+// If the connection is closed before the message is queued, the message is dropped without acknowledging it,
+// so the server will send it again after reconnecting.
+func (cli *Client) queueDecryption(info *types.MessageInfo, node *waBinary.Node) {
+	cli.decryptQueueLock.Lock()
+	dq, ctx := cli.decryptQueue, cli.decryptQueueCtx
+	cli.decryptQueueLock.Unlock()
+	if dq == nil {
+		cli.decryptMessages(info, node)
+	} else if !dq.push(ctx, info, node) {
+		cli.Log.Debugf("Dropped message %s from %s as the connection was closed before decrypting it", info.ID, info.SourceString())
+	}
+}
+
+// PreDecryptDecision is returned by Client.PreDecryptHook to decide what to do with an incoming message.
+// The zero value decrypts the message normally.
+type PreDecryptDecision struct {
+	// Drop can be set to true to acknowledge the message without decrypting it or sending a delivery receipt.
+	// The server won't send dropped messages again.
+	//
+	// Group messages that contain a pairwise encrypted part (pkmsg or msg) are never dropped, because that part
+	// usually carries the sender's sender key distribution message, and dropping it would make all later messages
+	// from the same sender undecryptable. Such messages are decrypted and dispatched normally, so the hook should
+	// check node itself if it needs to filter them some other way.
+	Drop bool
+	// Delay postpones decrypting the message by the given duration. Delayed messages are not kept in order
+	// with other messages in the same chat. If the connection is closed while waiting, the message is dropped
+	// without acknowledging it, so the server will send it again after reconnecting.
+	Delay time.Duration
+}
+
+func (cli *Client) delayDecryption(info *types.MessageInfo, node *waBinary.Node, delay time.Duration) {
+	cli.decryptQueueLock.Lock()
+	ctx := cli.decryptQueueCtx
+	cli.decryptQueueLock.Unlock()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		cli.queueDecryption(info, node)
+	case <-ctx.Done():
+		cli.Log.Debugf("Dropped delayed message %s from %s as the connection was closed before decrypting it", info.ID, info.SourceString())
+	}
+}
+
+// keyedMutex is a set of mutexes identified by string keys. Mutexes are created when needed and removed
+// when nothing is holding or waiting for them.
+type keyedMutex struct {
+	lock  sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock locks the mutex for the given key and returns a function that unlocks it.
+func (km *keyedMutex) Lock(key string) (unlock func()) {
+	km.lock.Lock()
+	if km.locks == nil {
+		km.locks = make(map[string]*keyedMutexEntry)
+	}
+	entry, ok := km.locks[key]
+	if !ok {
+		entry = &keyedMutexEntry{}
+		km.locks[key] = entry
+	}
+	entry.refs++
+	km.lock.Unlock()
+
+	entry.Lock()
+	return func() {
+		entry.Unlock()
+		km.lock.Lock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(km.locks, key)
+		}
+		km.lock.Unlock()
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestDecryptionQueueOrdering(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	const chats = 8
+	const perChat = 100
+	var wg sync.WaitGroup
+	wg.Add(chats * perChat)
+	var lock sync.Mutex
+	received := make(map[types.JID][]string)
+	dq := newDecryptionQueue(ctx, 4, func(info *types.MessageInfo, node *waBinary.Node) {
+		lock.Lock()
+		received[info.Chat] = append(received[info.Chat], info.ID)
+		lock.Unlock()
+		wg.Done()
+	})
+	for i := 0; i < perChat; i++ {
+		for c := 0; c < chats; c++ {
+			info := &types.MessageInfo{ID: fmt.Sprintf("%d", i)}
+			info.Chat = types.NewJID(fmt.Sprintf("12345-%d", c), types.GroupServer)
+			if !dq.push(ctx, info, &waBinary.Node{Tag: "message"}) {
+				t.Fatalf("Failed to push message")
+			}
+		}
+	}
+	wg.Wait()
+	for chat, ids := range received {
+		for i, id := range ids {
+			if id != fmt.Sprintf("%d", i) {
+				t.Fatalf("Messages in %s were handled out of order: %v", chat, ids)
+			}
+		}
+	}
+	if len(received) != chats {
+		t.Errorf("Expected messages from %d chats, got %d", chats, len(received))
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	var km keyedMutex
+	unlockA := km.Lock("a")
+	// A different key must not block
+	unlockB := km.Lock("b")
+	unlockB()
+	done := make(chan struct{})
+	go func() {
+		unlock := km.Lock("a")
+		unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatalf("Second lock of the same key didn't block")
+	default:
+	}
+	unlockA()
+	<-done
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if len(km.locks) != 0 {
+		t.Errorf("Expected all mutexes to be removed after unlocking, got %d", len(km.locks))
+	}
+}
+
+func TestDelayDecryption(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handled := make(chan *types.MessageInfo, 1)
+	cli := &Client{Log: waLog.Noop}
+	cli.decryptQueue = newDecryptionQueue(ctx, 2, func(info *types.MessageInfo, node *waBinary.Node) {
+		handled <- info
+	})
+	cli.decryptQueueCtx = ctx
+	info := &types.MessageInfo{ID: "delayed", Annotations: map[string]interface{}{"spam": false}}
+	go cli.delayDecryption(info, &waBinary.Node{Tag: "message"}, 10*time.Millisecond)
+	select {
+	case got := <-handled:
+		if got.ID != "delayed" || got.Annotations["spam"] != false {
+			t.Errorf("Unexpected message info %+v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Delayed message wasn't decrypted")
+	}
+
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		cli.delayDecryption(info, &waBinary.Node{Tag: "message"}, time.Hour)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Delayed decryption didn't stop when the connection was closed")
+	}
+}
+
+func TestHasSenderKeyDistribution(t *testing.T) {
+	encNode := func(encTypes ...string) *waBinary.Node {
+		children := make([]waBinary.Node, len(encTypes))
+		for i, encType := range encTypes {
+			children[i] = waBinary.Node{Tag: "enc", Attrs: waBinary.Attrs{"type": encType}}
+		}
+		return &waBinary.Node{Tag: "message", Content: children}
+	}
+	group := &types.MessageInfo{MessageSource: types.MessageSource{IsGroup: true}}
+	direct := &types.MessageInfo{}
+	tests := []struct {
+		name     string
+		info     *types.MessageInfo
+		node     *waBinary.Node
+		expected bool
+	}{
+		{"group skmsg only", group, encNode("skmsg"), false},
+		{"group pkmsg with skmsg", group, encNode("pkmsg", "skmsg"), true},
+		{"group msg with skmsg", group, encNode("msg", "skmsg"), true},
+		{"direct pkmsg", direct, encNode("pkmsg"), false},
+	}
+	for _, test := range tests {
+		if got := hasSenderKeyDistribution(test.info, test.node); got != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+type messageDedupeKey struct {
+	Chat   types.JID
+	Sender types.JID
+	ID     types.MessageID
+}
+
+// messageDedupeCache remembers a fixed number of the most recently handled messages.
+type messageDedupeCache struct {
+	seen map[messageDedupeKey]time.Time
+	list []messageDedupeKey
+	ptr  int
+}
+
+func newMessageDedupeCache(size int) *messageDedupeCache {
+	return &messageDedupeCache{
+		seen: make(map[messageDedupeKey]time.Time, size),
+		list: make([]messageDedupeKey, size),
+	}
+}
+
+func (mdc *messageDedupeCache) add(key messageDedupeKey, now time.Time) {
+	if _, ok := mdc.seen[key]; ok {
+		mdc.seen[key] = now
+		return
+	}
+	if oldKey := mdc.list[mdc.ptr]; oldKey.ID != "" {
+		delete(mdc.seen, oldKey)
+	}
+	mdc.seen[key] = now
+	mdc.list[mdc.ptr] = key
+	mdc.ptr = (mdc.ptr + 1) % len(mdc.list)
+}
+
+func (mdc *messageDedupeCache) has(key messageDedupeKey, now time.Time, ttl time.Duration) bool {
+	seenAt, ok := mdc.seen[key]
+	return ok && (ttl <= 0 || now.Sub(seenAt) < ttl)
+}
+
+func dedupeKey(info *types.MessageInfo) messageDedupeKey {
+	return messageDedupeKey{Chat: info.Chat, Sender: info.Sender.ToNonAD(), ID: info.ID}
+}
+
+// isDuplicateMessage checks if the given message has already been handled according to Client.MessageDedupeCacheSize.
+func (cli *Client) isDuplicateMessage(info *types.MessageInfo) bool {
+	cli.messageDedupeLock.Lock()
+	defer cli.messageDedupeLock.Unlock()
+	if cli.messageDedupe == nil {
+		return false
+	}
+	return cli.messageDedupe.has(dedupeKey(info), time.Now(), cli.MessageDedupeTTL)
+}
+
+// markMessageHandled adds the given message to the deduplication cache if deduplication is enabled.
+func (cli *Client) markMessageHandled(info *types.MessageInfo) {
+	if cli.MessageDedupeCacheSize <= 0 {
+		return
+	}
+	cli.messageDedupeLock.Lock()
+	if cli.messageDedupe == nil {
+		cli.messageDedupe = newMessageDedupeCache(cli.MessageDedupeCacheSize)
+	}
+	cli.messageDedupe.add(dedupeKey(info), time.Now())
+	cli.messageDedupeLock.Unlock()
+}
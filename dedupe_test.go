@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestMessageDedupe(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	sender := types.NewADJID("1111", 0, 1)
+	info := func(id types.MessageID) *types.MessageInfo {
+		return &types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: sender.ToNonAD(), Sender: sender},
+			ID:            id,
+		}
+	}
+
+	cli.markMessageHandled(info("1"))
+	if cli.isDuplicateMessage(info("1")) {
+		t.Errorf("Message was marked as duplicate with deduplication disabled")
+	}
+
+	cli.MessageDedupeCacheSize = 2
+	cli.markMessageHandled(info("1"))
+	cli.markMessageHandled(info("2"))
+	if !cli.isDuplicateMessage(info("1")) || !cli.isDuplicateMessage(info("2")) {
+		t.Errorf("Handled messages weren't detected as duplicates")
+	}
+	if cli.isDuplicateMessage(info("3")) {
+		t.Errorf("New message was detected as duplicate")
+	}
+	cli.markMessageHandled(info("3"))
+	if cli.isDuplicateMessage(info("1")) {
+		t.Errorf("Oldest message wasn't pushed out of the cache")
+	}
+
+	cli.MessageDedupeTTL = time.Minute
+	cli.messageDedupe.seen[dedupeKey(info("2"))] = time.Now().Add(-2 * time.Minute)
+	if cli.isDuplicateMessage(info("2")) {
+		t.Errorf("Expired message was detected as duplicate")
+	} else if !cli.isDuplicateMessage(info("3")) {
+		t.Errorf("Unexpired message wasn't detected as duplicate")
+	}
+}
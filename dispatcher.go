@@ -0,0 +1,138 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// EventHandlerCall is a single call of an event handler with an event, passed to EventDispatcher.
+type EventHandlerCall struct {
+	HandlerID uint32      // The ID returned by Client.AddEventHandler.
+	Event     interface{} // The event that the handler will be called with.
+
+	run func()
+}
+
+// Run calls the event handler. Panics in the handler are recovered and logged.
+func (call EventHandlerCall) Run() {
+	call.run()
+}
+
+// EventDispatcher decides how and when event handlers are called, see Client.EventDispatcher.
+type EventDispatcher interface {
+	// Dispatch is called for every event with one call for each registered event handler, in the order the handlers
+	// were registered. The dispatcher must call Run for each of them, either before returning or asynchronously.
+	Dispatch(calls []EventHandlerCall)
+}
+
+// WorkerPoolDispatcher is an EventDispatcher that calls event handlers asynchronously, so that slow handlers don't
+// block receiving messages.
+//
+// Each event handler has its own queue, so a slow handler only delays its own events, and each handler receives
+// events in the same order as they were dispatched. Handlers are not called concurrently with themselves,
+// but different handlers may be running at the same time.
+type WorkerPoolDispatcher struct {
+	// DropWhenFull can be set to true to drop events when a handler's queue is full.
+	// By default, dispatching waits until there's space in the queue, which blocks receiving more messages.
+	DropWhenFull bool
+
+	queueSize int
+	workers   chan struct{}
+	queues    map[uint32]*handlerCallQueue
+	lock      sync.Mutex
+	spaceFree *sync.Cond
+	dropped   uint64
+}
+
+type handlerCallQueue struct {
+	calls   []EventHandlerCall
+	running bool
+}
+
+var _ EventDispatcher = (*WorkerPoolDispatcher)(nil)
+
+// NewWorkerPoolDispatcher creates a new WorkerPoolDispatcher, which runs at most concurrency handler calls at once
+// and buffers at most queueSize events for each handler.
+func NewWorkerPoolDispatcher(concurrency, queueSize int) *WorkerPoolDispatcher {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	wpd := &WorkerPoolDispatcher{
+		queueSize: queueSize,
+		workers:   make(chan struct{}, concurrency),
+		queues:    make(map[uint32]*handlerCallQueue),
+	}
+	wpd.spaceFree = sync.NewCond(&wpd.lock)
+	return wpd
+}
+
+// Dropped returns the number of handler calls that have been dropped because of DropWhenFull.
+func (wpd *WorkerPoolDispatcher) Dropped() uint64 {
+	return atomic.LoadUint64(&wpd.dropped)
+}
+
+// Dispatch adds the calls to the queues of their handlers.
+func (wpd *WorkerPoolDispatcher) Dispatch(calls []EventHandlerCall) {
+	wpd.lock.Lock()
+	defer wpd.lock.Unlock()
+	for _, call := range calls {
+		queue := wpd.getQueue(call.HandlerID)
+		for len(queue.calls) >= wpd.queueSize {
+			if wpd.DropWhenFull {
+				break
+			}
+			wpd.spaceFree.Wait()
+			// The queue may have been removed while waiting
+			queue = wpd.getQueue(call.HandlerID)
+		}
+		if len(queue.calls) >= wpd.queueSize {
+			atomic.AddUint64(&wpd.dropped, 1)
+			continue
+		}
+		queue.calls = append(queue.calls, call)
+		if !queue.running {
+			queue.running = true
+			go wpd.drain(call.HandlerID, queue)
+		}
+	}
+}
+
+func (wpd *WorkerPoolDispatcher) getQueue(handlerID uint32) *handlerCallQueue {
+	queue, ok := wpd.queues[handlerID]
+	if !ok {
+		queue = &handlerCallQueue{}
+		wpd.queues[handlerID] = queue
+	}
+	return queue
+}
+
+// drain runs the calls in a handler's queue until it's empty.
+func (wpd *WorkerPoolDispatcher) drain(handlerID uint32, queue *handlerCallQueue) {
+	for {
+		wpd.lock.Lock()
+		if len(queue.calls) == 0 {
+			queue.running = false
+			delete(wpd.queues, handlerID)
+			wpd.lock.Unlock()
+			return
+		}
+		call := queue.calls[0]
+		queue.calls[0] = EventHandlerCall{}
+		queue.calls = queue.calls[1:]
+		wpd.spaceFree.Broadcast()
+		wpd.lock.Unlock()
+
+		wpd.workers <- struct{}{}
+		call.Run()
+		<-wpd.workers
+	}
+}
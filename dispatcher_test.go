@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestWorkerPoolDispatcher(t *testing.T) {
+	cli := &Client{Log: waLog.Noop, EventDispatcher: NewWorkerPoolDispatcher(2, 100)}
+	unblock := make(chan struct{})
+	cli.AddEventHandler(func(evt interface{}) {
+		<-unblock
+	})
+	var lock sync.Mutex
+	var received []int
+	var wg sync.WaitGroup
+	wg.Add(50)
+	cli.AddEventHandler(func(evt interface{}) {
+		lock.Lock()
+		received = append(received, evt.(int))
+		lock.Unlock()
+		wg.Done()
+	})
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 50; i++ {
+			cli.dispatchEvent(i)
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Blocked handler stalled other handler")
+	}
+	close(unblock)
+	for i, val := range received {
+		if val != i {
+			t.Fatalf("Events were handled out of order: %v", received)
+		}
+	}
+}
+
+func TestWorkerPoolDispatcherDropWhenFull(t *testing.T) {
+	dispatcher := NewWorkerPoolDispatcher(1, 2)
+	dispatcher.DropWhenFull = true
+	cli := &Client{Log: waLog.Noop, EventDispatcher: dispatcher}
+	started := make(chan struct{}, 10)
+	unblock := make(chan struct{})
+	cli.AddEventHandler(func(evt interface{}) {
+		started <- struct{}{}
+		<-unblock
+	})
+	cli.dispatchEvent(0)
+	// Wait for the first call to start, so that the queue is empty
+	<-started
+	for i := 1; i <= 4; i++ {
+		cli.dispatchEvent(i)
+	}
+	if dropped := dispatcher.Dropped(); dropped != 2 {
+		t.Errorf("Expected 2 dropped events, got %d", dropped)
+	}
+	close(unblock)
+}
@@ -34,6 +34,9 @@ const (
 	MediaDocument MediaType = "WhatsApp Document Keys"
 	MediaHistory  MediaType = "WhatsApp History Keys"
 	MediaAppState MediaType = "WhatsApp App State Keys"
+
+	MediaLinkThumbnail MediaType = "WhatsApp Link Thumbnail Keys"
+	MediaStickerPack   MediaType = "WhatsApp Sticker Pack Keys"
 )
 
 // DownloadableMessage represents a protobuf message that contains attachment info.
@@ -52,6 +55,7 @@ var (
 	_ DownloadableMessage = (*waProto.VideoMessage)(nil)
 	_ DownloadableMessage = (*waProto.DocumentMessage)(nil)
 	_ DownloadableMessage = (*waProto.StickerMessage)(nil)
+	_ DownloadableMessage = (*waProto.StickerPackMessage)(nil)
 	_ DownloadableMessage = (*waProto.HistorySyncNotification)(nil)
 	_ DownloadableMessage = (*waProto.ExternalBlobReference)(nil)
 )
@@ -78,6 +82,8 @@ var classToMediaType = map[protoreflect.Name]MediaType{
 	"DocumentMessage": MediaDocument,
 	"StickerMessage":  MediaImage,
 
+	"StickerPackMessage": MediaStickerPack,
+
 	"HistorySyncNotification": MediaHistory,
 	"ExternalBlobReference":   MediaAppState,
 }
@@ -89,9 +95,14 @@ var mediaTypeToMMSType = map[MediaType]string{
 	MediaDocument: "document",
 	MediaHistory:  "md-msg-hist",
 	MediaAppState: "md-app-state",
+
+	MediaLinkThumbnail: "thumbnail-link",
+	MediaStickerPack:   "sticker-pack",
 }
 
 // DownloadAny loops through the downloadable parts of the given message and downloads the first non-nil item.
+//
+// If the message is a view-once message that has already been opened on another device, ErrViewOnceConsumed is returned.
 func (cli *Client) DownloadAny(msg *waProto.Message) (data []byte, err error) {
 	downloadables := []DownloadableMessage{msg.GetImageMessage(), msg.GetAudioMessage(), msg.GetVideoMessage(), msg.GetDocumentMessage(), msg.GetStickerMessage()}
 	for _, downloadable := range downloadables {
@@ -118,10 +129,12 @@ func (cli *Client) Download(msg DownloadableMessage) (data []byte, err error) {
 	mediaType, ok := classToMediaType[msg.ProtoReflect().Descriptor().Name()]
 	if !ok {
 		return nil, fmt.Errorf("%w '%s'", ErrUnknownMediaType, string(msg.ProtoReflect().Descriptor().Name()))
+	} else if cli.isViewOnceConsumed(msg) {
+		return nil, ErrViewOnceConsumed
 	}
 	urlable, ok := msg.(downloadableMessageWithURL)
 	if ok && len(urlable.GetUrl()) > 0 {
-		return downloadAndDecrypt(urlable.GetUrl(), msg.GetMediaKey(), mediaType, getSize(msg), msg.GetFileEncSha256(), msg.GetFileSha256())
+		return cli.downloadAndDecrypt(urlable.GetUrl(), msg.GetMediaKey(), mediaType, getSize(msg), msg.GetFileEncSha256(), msg.GetFileSha256())
 	} else if len(msg.GetDirectPath()) > 0 {
 		return cli.downloadMediaWithPath(msg.GetDirectPath(), msg.GetFileEncSha256(), msg.GetFileSha256(), msg.GetMediaKey(), getSize(msg), mediaType, mediaTypeToMMSType[mediaType])
 	} else {
@@ -136,22 +149,24 @@ func (cli *Client) downloadMediaWithPath(directPath string, encFileHash, fileHas
 	}
 	for i, host := range cli.mediaConn.Hosts {
 		mediaURL := fmt.Sprintf("https://%s%s&hash=%s&mms-type=%s&__wa-mms=", host.Hostname, directPath, base64.URLEncoding.EncodeToString(encFileHash), mmsType)
-		data, err = downloadAndDecrypt(mediaURL, mediaKey, mediaType, fileLength, encFileHash, fileHash)
+		data, err = cli.downloadAndDecrypt(mediaURL, mediaKey, mediaType, fileLength, encFileHash, fileHash)
 		// TODO there are probably some errors that shouldn't retry
 		if err != nil {
 			if i >= len(cli.mediaConn.Hosts)-1 {
 				return nil, fmt.Errorf("failed to download media from last host: %w", err)
 			}
 			cli.Log.Warnf("Failed to download media: %s, trying with next host...", err)
+		} else {
+			break
 		}
 	}
 	return
 }
 
-func downloadAndDecrypt(url string, mediaKey []byte, appInfo MediaType, fileLength int, fileEncSha256, fileSha256 []byte) (data []byte, err error) {
+func (cli *Client) downloadAndDecrypt(url string, mediaKey []byte, appInfo MediaType, fileLength int, fileEncSha256, fileSha256 []byte) (data []byte, err error) {
 	iv, cipherKey, macKey, _ := getMediaKeys(mediaKey, appInfo)
 	var ciphertext, mac []byte
-	if ciphertext, mac, err = downloadEncryptedMedia(url, fileEncSha256); err != nil {
+	if ciphertext, mac, err = cli.downloadEncryptedMedia(url, fileEncSha256); err != nil {
 
 	} else if err = validateMedia(iv, ciphertext, macKey, mac); err != nil {
 
@@ -170,8 +185,8 @@ func getMediaKeys(mediaKey []byte, appInfo MediaType) (iv, cipherKey, macKey, re
 	return mediaKeyExpanded[:16], mediaKeyExpanded[16:48], mediaKeyExpanded[48:80], mediaKeyExpanded[80:]
 }
 
-func downloadEncryptedMedia(url string, checksum []byte) (file, mac []byte, err error) {
-	resp, err := http.Get(url)
+func (cli *Client) downloadEncryptedMedia(url string, checksum []byte) (file, mac []byte, err error) {
+	resp, err := cli.http.Get(url)
 	if err != nil {
 		return nil, nil, err
 	}
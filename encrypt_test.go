@@ -0,0 +1,221 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"go.mau.fi/libsignal/keys/prekey"
+	"go.mau.fi/libsignal/protocol"
+	"go.mau.fi/libsignal/session"
+	"go.mau.fi/libsignal/state/record"
+	signaltest "go.mau.fi/libsignal/tests"
+	"go.mau.fi/libsignal/util/keyhelper"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// memorySignalStore is a minimal thread-safe identity and session store for encryption tests.
+type memorySignalStore struct {
+	lock       sync.Mutex
+	identities map[string][32]byte
+	sessions   map[string][]byte
+}
+
+func newMemorySignalStore() *memorySignalStore {
+	return &memorySignalStore{identities: make(map[string][32]byte), sessions: make(map[string][]byte)}
+}
+
+func (mss *memorySignalStore) PutIdentity(address string, key [32]byte) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	mss.identities[address] = key
+	return nil
+}
+
+func (mss *memorySignalStore) DeleteAllIdentities(string) error { return nil }
+
+func (mss *memorySignalStore) DeleteIdentity(address string) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	delete(mss.identities, address)
+	return nil
+}
+
+func (mss *memorySignalStore) IsTrustedIdentity(address string, key [32]byte) (bool, error) {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	existing, ok := mss.identities[address]
+	return !ok || existing == key, nil
+}
+
+func (mss *memorySignalStore) GetSession(address string) ([]byte, error) {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	return mss.sessions[address], nil
+}
+
+func (mss *memorySignalStore) HasSession(address string) (bool, error) {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	_, ok := mss.sessions[address]
+	return ok, nil
+}
+
+func (mss *memorySignalStore) PutSession(address string, session []byte) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	mss.sessions[address] = session
+	return nil
+}
+
+func (mss *memorySignalStore) DeleteAllSessions(string) error { return nil }
+
+func (mss *memorySignalStore) DeleteSession(address string) error {
+	mss.lock.Lock()
+	defer mss.lock.Unlock()
+	delete(mss.sessions, address)
+	return nil
+}
+
+// testRecipientDevice is the other end of a Signal session, used to decrypt what the client encrypted.
+type testRecipientDevice struct {
+	jid    types.JID
+	cipher *session.Cipher
+}
+
+func newTestRecipientDevice(t *testing.T, jid types.JID, sender types.JID) (*testRecipientDevice, *prekey.Bundle) {
+	t.Helper()
+	identityKeyPair, err := keyhelper.GenerateIdentityKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate identity key: %v", err)
+	}
+	registrationID := keyhelper.GenerateRegistrationID()
+	preKeys, err := keyhelper.GeneratePreKeys(1, 1, pbSerializer.PreKeyRecord)
+	if err != nil {
+		t.Fatalf("Failed to generate prekey: %v", err)
+	}
+	signedPreKey, err := keyhelper.GenerateSignedPreKey(identityKeyPair, 1, pbSerializer.SignedPreKeyRecord)
+	if err != nil {
+		t.Fatalf("Failed to generate signed prekey: %v", err)
+	}
+	preKeyStore := signaltest.NewInMemoryPreKey()
+	preKeyStore.StorePreKey(1, record.NewPreKey(1, preKeys[0].KeyPair(), pbSerializer.PreKeyRecord))
+	signedPreKeyStore := signaltest.NewInMemorySignedPreKey()
+	signedPreKeyStore.StoreSignedPreKey(1, signedPreKey)
+	senderAddress := sender.SignalAddress()
+	builder := session.NewBuilder(
+		signaltest.NewInMemorySession(pbSerializer),
+		preKeyStore,
+		signedPreKeyStore,
+		signaltest.NewInMemoryIdentityKey(identityKeyPair, registrationID),
+		senderAddress,
+		pbSerializer,
+	)
+	bundle := prekey.NewBundle(
+		registrationID, uint32(jid.Device), preKeys[0].ID(), signedPreKey.ID(),
+		preKeys[0].KeyPair().PublicKey(), signedPreKey.KeyPair().PublicKey(), signedPreKey.Signature(),
+		identityKeyPair.PublicKey(),
+	)
+	return &testRecipientDevice{jid: jid, cipher: session.NewCipher(builder, senderAddress)}, bundle
+}
+
+func (trd *testRecipientDevice) decrypt(node waBinary.Node) ([]byte, error) {
+	encNode, ok := node.GetOptionalChildByTag("enc")
+	if !ok {
+		return nil, fmt.Errorf("no enc node")
+	} else if encNode.AttrGetter().String("type") != "pkmsg" {
+		return nil, fmt.Errorf("unexpected enc type %s", encNode.AttrGetter().String("type"))
+	}
+	preKeyMsg, err := protocol.NewPreKeySignalMessageFromBytes(encNode.Content.([]byte), pbSerializer.PreKeySignalMessage, pbSerializer.SignalMessage)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := trd.cipher.DecryptMessage(preKeyMsg)
+	if err != nil {
+		return nil, err
+	}
+	return unpadMessage(plaintext)
+}
+
+func TestEncryptMessageForDevicesParallel(t *testing.T) {
+	ownID := types.NewADJID("1111111111", 0, 1)
+	signalStore := newMemorySignalStore()
+	cli := NewClient(&store.Device{
+		Log:            waLog.Noop,
+		ID:             &ownID,
+		IdentityKey:    keys.NewKeyPair(),
+		RegistrationID: keyhelper.GenerateRegistrationID(),
+		Identities:     signalStore,
+		Sessions:       signalStore,
+	}, nil)
+
+	const deviceCount = 3 * minParallelEncryptionDevices / 2
+	devices := make([]types.JID, deviceCount)
+	recipients := make(map[types.JID]*testRecipientDevice, deviceCount)
+	for i := range devices {
+		if i < 2 {
+			// The user's own other devices get the device sent message
+			devices[i] = types.NewADJID(ownID.User, 0, uint8(i+2))
+		} else {
+			devices[i] = types.NewADJID(fmt.Sprintf("2%09d", i), 0, uint8(i%3))
+		}
+		recipient, bundle := newTestRecipientDevice(t, devices[i], ownID)
+		recipients[devices[i]] = recipient
+		err := session.NewBuilderFromSignal(cli.Store, devices[i].SignalAddress(), pbSerializer).ProcessBundle(bundle)
+		if err != nil {
+			t.Fatalf("Failed to process bundle of %s: %v", devices[i], err)
+		}
+	}
+
+	msgPlaintext, dsmPlaintext := []byte("message"), []byte("device sent message")
+	check := func(name string, nodes []waBinary.Node, includeIdentity bool) {
+		if len(nodes) != deviceCount {
+			t.Fatalf("%s: expected %d nodes, got %d", name, deviceCount, len(nodes))
+		} else if !includeIdentity {
+			t.Errorf("%s: expected device identity to be included for prekey messages", name)
+		}
+		for i, node := range nodes {
+			jid := node.AttrGetter().JID("jid")
+			if jid != devices[i] {
+				t.Fatalf("%s: expected node #%d to be for %s, got %s", name, i, devices[i], jid)
+			}
+			plaintext, err := recipients[jid].decrypt(node)
+			expected := msgPlaintext
+			if jid.User == ownID.User {
+				expected = dsmPlaintext
+			}
+			if err != nil {
+				t.Errorf("%s: failed to decrypt message for %s: %v", name, jid, err)
+			} else if !bytes.Equal(plaintext, expected) {
+				t.Errorf("%s: unexpected plaintext for %s: %q", name, jid, plaintext)
+			}
+		}
+	}
+
+	cli.EncryptionConcurrency = DefaultEncryptionConcurrency
+	parallelNodes, parallelIdentity, err := cli.encryptMessageForDevices(context.Background(), devices, "test", msgPlaintext, dsmPlaintext)
+	if err != nil {
+		t.Fatalf("Failed to encrypt in parallel: %v", err)
+	}
+	check("parallel", parallelNodes, parallelIdentity)
+
+	cli.EncryptionConcurrency = 1
+	sequentialNodes, sequentialIdentity, err := cli.encryptMessageForDevices(context.Background(), devices, "test", msgPlaintext, dsmPlaintext)
+	if err != nil {
+		t.Fatalf("Failed to encrypt sequentially: %v", err)
+	}
+	check("sequential", sequentialNodes, sequentialIdentity)
+}
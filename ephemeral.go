@@ -0,0 +1,131 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/internal/ctxinfoutil"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// The disappearing message timers that the official clients allow choosing.
+const (
+	DisappearingTimerOff     = time.Duration(0)
+	DisappearingTimer24Hours = 24 * time.Hour
+	DisappearingTimer7Days   = 7 * 24 * time.Hour
+	DisappearingTimer90Days  = 90 * 24 * time.Hour
+)
+
+// WrapEphemeral makes the given message disappear after the given duration by setting the expiration in its
+// ContextInfo and wrapping it in an EphemeralMessage. The given message is not modified.
+// If the expiration is zero, the message is returned as-is.
+//
+// The expiration should usually match the chat's disappearing timer (see Client.GetChatEphemeralExpiration),
+// as the official clients show a notice when a message with a different timer is received.
+func WrapEphemeral(message *waProto.Message, expiration time.Duration) *waProto.Message {
+	if expiration <= 0 || message.GetEphemeralMessage() != nil {
+		return message
+	}
+	inner := proto.Clone(message).(*waProto.Message)
+	ctxinfoutil.Find(inner, true).Expiration = proto.Uint32(uint32(expiration / time.Second))
+	// The official clients put the message context info outside the ephemeral wrapper.
+	outer := &waProto.Message{
+		EphemeralMessage:   &waProto.FutureProofMessage{Message: inner},
+		MessageContextInfo: inner.MessageContextInfo,
+	}
+	inner.MessageContextInfo = nil
+	return outer
+}
+
+// GetChatEphemeralExpiration returns the current disappearing message timer of the given chat, or zero if
+// disappearing messages are disabled.
+//
+// For groups, the timer is taken from the group info. For private chats, it's the last timer seen in the chat,
+// which is tracked from incoming disappearing messages and timer changes, so it may be out of date if the timer
+// was changed while the client wasn't running.
+func (cli *Client) GetChatEphemeralExpiration(chat types.JID) (time.Duration, error) {
+	if chat.Server == types.GroupServer {
+		info, err := cli.getCachedGroupInfo(chat)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get group info: %w", err)
+		}
+		return time.Duration(info.DisappearingTimer) * time.Second, nil
+	}
+	settings, err := cli.Store.ChatSettings.GetChatSettings(chat)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chat settings: %w", err)
+	}
+	return time.Duration(settings.EphemeralExpiration) * time.Second, nil
+}
+
+// BuildEphemeral wraps the given message using WrapEphemeral with the current disappearing timer of the given chat.
+// If disappearing messages aren't enabled in the chat, the message is returned as-is.
+func (cli *Client) BuildEphemeral(chat types.JID, message *waProto.Message) (*waProto.Message, error) {
+	expiration, err := cli.GetChatEphemeralExpiration(chat)
+	if err != nil {
+		return nil, err
+	}
+	return WrapEphemeral(message, expiration), nil
+}
+
+// SendEphemeral sends the given message as a disappearing message that expires after the given duration.
+// If the expiration is zero, the current disappearing timer of the chat is used.
+func (cli *Client) SendEphemeral(ctx context.Context, to types.JID, message *waProto.Message, expiration time.Duration) (SendResponse, error) {
+	if expiration == 0 {
+		var err error
+		expiration, err = cli.GetChatEphemeralExpiration(to)
+		if err != nil {
+			return SendResponse{}, err
+		}
+	}
+	return cli.SendMessageContext(ctx, to, "", WrapEphemeral(message, expiration))
+}
+
+// trackEphemeralExpiration updates the stored disappearing timer of a chat based on an incoming message.
+// The last stored timer of each chat is kept in memory, so the store is only written to when the timer changes.
+func (cli *Client) trackEphemeralExpiration(evt *events.Message) {
+	var expiration uint32
+	if protoMsg := evt.Message.GetProtocolMessage(); protoMsg.GetType() == waProto.ProtocolMessage_EPHEMERAL_SETTING {
+		expiration = protoMsg.GetEphemeralExpiration()
+		if evt.Info.IsGroup {
+			// The group info cache has the old timer
+			cli.invalidateGroupInfo(evt.Info.Chat)
+			return
+		}
+	} else if evt.IsEphemeral && !evt.Info.IsGroup {
+		expiration = GetContextInfo(evt.Message).GetExpiration()
+		if expiration == 0 {
+			return
+		}
+	} else {
+		return
+	}
+	expirationStore, ok := cli.Store.ChatSettings.(store.EphemeralExpirationStore)
+	if !ok {
+		return
+	}
+	cli.ephemeralExpirationsLock.Lock()
+	defer cli.ephemeralExpirationsLock.Unlock()
+	if cached, ok := cli.ephemeralExpirations[evt.Info.Chat]; ok && cached == expiration {
+		return
+	}
+	cli.Log.Debugf("Storing disappearing timer of %s (%d seconds)", evt.Info.Chat, expiration)
+	err := expirationStore.PutEphemeralExpiration(evt.Info.Chat, expiration)
+	if err != nil {
+		cli.Log.Warnf("Failed to store disappearing timer of %s: %v", evt.Info.Chat, err)
+	} else {
+		cli.ephemeralExpirations[evt.Info.Chat] = expiration
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestWrapEphemeral(t *testing.T) {
+	original := &waProto.Message{
+		Conversation:       proto.String("Hello"),
+		MessageContextInfo: &waProto.MessageContextInfo{MessageSecret: []byte("secret")},
+	}
+	wrapped := WrapEphemeral(original, DisappearingTimer7Days)
+	inner := wrapped.GetEphemeralMessage().GetMessage()
+	if inner.GetExtendedTextMessage().GetText() != "Hello" {
+		t.Fatalf("Expected text to be converted to extended text inside wrapper, got %v", wrapped)
+	}
+	if exp := inner.GetExtendedTextMessage().GetContextInfo().GetExpiration(); exp != 7*24*60*60 {
+		t.Errorf("Expected expiration of 7 days, got %d", exp)
+	}
+	if string(wrapped.GetMessageContextInfo().GetMessageSecret()) != "secret" || inner.MessageContextInfo != nil {
+		t.Errorf("Expected message context info to be moved outside the wrapper")
+	}
+	if original.Conversation == nil || original.ExtendedTextMessage != nil {
+		t.Errorf("Original message was modified")
+	}
+	if WrapEphemeral(original, DisappearingTimerOff) != original {
+		t.Errorf("Expected message to be returned as-is with zero expiration")
+	}
+}
+
+type minimalChatSettingsStore struct {
+	store.ChatSettingsStore
+}
+
+func TestTrackEphemeralExpirationOptionalStore(t *testing.T) {
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	evt := &events.Message{
+		Info: types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}},
+		Message: &waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{
+			Type:                waProto.ProtocolMessage_EPHEMERAL_SETTING.Enum(),
+			EphemeralExpiration: proto.Uint32(86400),
+		}},
+	}
+	mem := &memoryChatSettingsStore{settings: make(map[types.JID]types.LocalChatSettings)}
+	// Stores that don't implement store.EphemeralExpirationStore just don't track timers
+	cli := NewClient(&store.Device{ChatSettings: minimalChatSettingsStore{mem}}, nil)
+	cli.trackEphemeralExpiration(evt)
+	if mem.settings[chat].EphemeralExpiration != 0 {
+		t.Errorf("Expected timer not to be stored without EphemeralExpirationStore")
+	}
+	cli.Store.ChatSettings = mem
+	cli.trackEphemeralExpiration(evt)
+	if expiration, err := cli.GetChatEphemeralExpiration(chat); err != nil || expiration != DisappearingTimer24Hours {
+		t.Errorf("Expected stored timer to be 24 hours, got %s (err: %v)", expiration, err)
+	}
+}
+
+func TestTrackEphemeralExpirationOnlyWritesChanges(t *testing.T) {
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	ephemeralMsg := func(expiration uint32) *events.Message {
+		return &events.Message{
+			Info:        types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}},
+			IsEphemeral: true,
+			Message: &waProto.Message{ExtendedTextMessage: &waProto.ExtendedTextMessage{
+				Text:        proto.String("hi"),
+				ContextInfo: &waProto.ContextInfo{Expiration: proto.Uint32(expiration)},
+			}},
+		}
+	}
+	mem := &memoryChatSettingsStore{settings: make(map[types.JID]types.LocalChatSettings)}
+	cli := NewClient(&store.Device{ChatSettings: mem}, nil)
+	for i := 0; i < 5; i++ {
+		cli.trackEphemeralExpiration(ephemeralMsg(86400))
+	}
+	if mem.expirationPuts != 1 {
+		t.Errorf("Expected unchanged timer to be stored once, got %d writes", mem.expirationPuts)
+	}
+	cli.trackEphemeralExpiration(ephemeralMsg(604800))
+	if mem.expirationPuts != 2 || mem.settings[chat].EphemeralExpiration != 604800 {
+		t.Errorf("Expected changed timer to be stored, got %d writes and timer %d", mem.expirationPuts, mem.settings[chat].EphemeralExpiration)
+	}
+	if mem.gets != 0 {
+		t.Errorf("Expected no chat settings reads on the message path, got %d", mem.gets)
+	}
+}
@@ -9,6 +9,7 @@ package whatsmeow
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
 )
@@ -21,12 +22,34 @@ var (
 	ErrNotConnected   = errors.New("websocket not connected")
 	ErrNotLoggedIn    = errors.New("the store doesn't contain a device JID")
 
+	// ErrDisconnectedBeforeResponse is returned (wrapped in a DisconnectedBeforeResponseError) when the websocket
+	// is disconnected while waiting for the response to an info query or the server ack to a sent message.
+	ErrDisconnectedBeforeResponse = errors.New("websocket disconnected before response was received")
+
 	ErrAlreadyConnected = errors.New("websocket is already connected")
 
+	ErrUnsupportedProxyScheme = errors.New("unsupported proxy scheme")
+
 	ErrQRAlreadyConnected = errors.New("GetQRChannel must be called before connecting")
 	ErrQRStoreContainsID  = errors.New("GetQRChannel can only be called when there's no user ID in the client's Store")
 
+	ErrPairPhoneStoreContainsID      = errors.New("PairPhone can only be called when there's no user ID in the client's Store")
+	ErrPhoneNumberTooShort           = errors.New("phone number too short")
+	ErrPhoneNumberIsNotInternational = errors.New("international phone number required (must not start with 0)")
+
+	// ErrPairRejectedLocally is returned (inside events.PairError) if Client.PrePairCallback rejects the pairing.
+	ErrPairRejectedLocally = errors.New("pairing rejected locally")
+	// ErrPairNotBusinessAccount is returned (inside events.PairError) if Client.PairBusinessOnly is set and
+	// the phone isn't a WhatsApp Business account.
+	ErrPairNotBusinessAccount = errors.New("pairing rejected as the phone is not a business account")
+
 	ErrNoPushName = errors.New("can't send presence without PushName set")
+	// ErrNoMessageIDs is returned by MarkRead and MarkPlayed if the list of message IDs is empty.
+	ErrNoMessageIDs = errors.New("no message IDs given")
+
+	// ErrFeatureNotAvailable is returned by methods that use features that Client.SupportsFeature says aren't available.
+	// Set Client.SkipFeatureChecks to try anyway.
+	ErrFeatureNotAvailable = errors.New("feature is not available for this account")
 )
 
 var (
@@ -39,6 +62,8 @@ var (
 	ErrNotInGroup = errors.New("you're not participating in that group")
 	// ErrGroupNotFound is returned by group info getting methods if the group doesn't exist (status code 404).
 	ErrGroupNotFound = errors.New("that group does not exist")
+	// ErrNotGroupAdmin is returned by group setting methods if you're not an admin in the group (status code 401).
+	ErrNotGroupAdmin = errors.New("you must be a group admin to change that setting")
 	// ErrInviteLinkInvalid is returned by methods that use group invite links if the invite link is malformed.
 	ErrInviteLinkInvalid = errors.New("that group invite link is not valid")
 	// ErrInviteLinkRevoked is returned by methods that use group invite links if the invite link was valid, but has been revoked and can no longer be used.
@@ -47,13 +72,87 @@ var (
 	ErrBusinessMessageLinkNotFound = errors.New("that business message link does not exist or has been revoked")
 )
 
+// Errors that can be in ParticipantChangeResult.Error when changing a group participant fails
+var (
+	// ErrParticipantRequiresInvite means that the user's privacy settings don't allow adding them to groups directly (status code 403).
+	// The ParticipantChangeResult will contain an invite code that can be sent to the user instead.
+	ErrParticipantRequiresInvite = errors.New("user can't be added to groups directly and must be invited")
+	// ErrParticipantNotFound means that the user isn't in the group or isn't on WhatsApp (status code 404).
+	ErrParticipantNotFound = errors.New("user is not in the group or not on WhatsApp")
+	// ErrParticipantRecentlyLeft means that the user left the group recently and can't be added back yet (status code 408).
+	ErrParticipantRecentlyLeft = errors.New("user left the group recently")
+	// ErrParticipantAlreadyInGroup means that the user being added is already in the group (status code 409).
+	ErrParticipantAlreadyInGroup = errors.New("user is already in the group")
+)
+
+// Some errors that Client.SetGroupPhoto can return
+var (
+	ErrGroupPhotoNotJPEG   = errors.New("group photo must be a jpeg image")
+	ErrGroupPhotoNotSquare = errors.New("group photo must be square")
+)
+
+// Some errors that group invite message helpers can return
+var (
+	// ErrNoInviteCode is returned by Client.SendGroupInvite if the participant change result doesn't contain an invite code.
+	ErrNoInviteCode = errors.New("participant change result doesn't contain an invite code")
+	// ErrGroupInviteExpired is returned by Client.AcceptGroupInvite if the invite message has already expired.
+	ErrGroupInviteExpired = errors.New("group invite has expired")
+)
+
 // Some errors that Client.SendMessage can return
 var (
-	ErrBroadcastListUnsupported = errors.New("sending to broadcast lists is not yet supported")
+	ErrBroadcastListUnsupported = errors.New("sending to non-status broadcast lists is not yet supported")
 	ErrUnknownServer            = errors.New("can't send message to unknown server")
 	ErrRecipientADJID           = errors.New("message recipient must be normal (non-AD) JID")
+	ErrNoStatusRecipients       = errors.New("no recipients for status broadcast")
+	ErrInvalidPeerRecipient     = errors.New("peer messages can only be sent to your own devices")
+	ErrTooManySendExtras        = errors.New("only one extra parameter may be provided to SendMessage")
+	ErrMessageTimedOut          = errors.New("timed out waiting for message to be acknowledged by the server")
+)
+
+// Some errors that sticker validation and BuildStickerPack can return
+var (
+	ErrInvalidWebP              = errors.New("sticker is not a valid webp image")
+	ErrStickerTooLarge          = errors.New("sticker file is too large")
+	ErrInvalidStickerDimensions = errors.New("sticker must be 512x512 pixels")
+	ErrInvalidStickerPackSize   = errors.New("sticker pack must contain between 3 and 30 stickers")
+)
+
+// Some errors that Client.SendAlbum can return
+var (
+	ErrAlbumTooSmall     = errors.New("album must contain at least 2 images or videos")
+	ErrInvalidAlbumMedia = errors.New("albums can only contain images and videos")
+)
+
+// ErrOutboxDisabled is returned by Client.QueueMessage if Client.EnableOutbox isn't set.
+var ErrOutboxDisabled = errors.New("outbox is not enabled")
+
+// ErrUnreadCountsNotSupported is returned by Client.GetUnreadCounts if the chat settings store doesn't
+// implement store.UnreadCountStore.
+var ErrUnreadCountsNotSupported = errors.New("chat settings store doesn't support unread counts")
+
+// Some errors that scheduling messages can return
+var (
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+	ErrScheduledMessageInFlight = errors.New("scheduled message is already being sent")
+	ErrScheduledMessageTooLate  = errors.New("scheduled message was not sent within the maximum delay")
+)
+
+// ErrLiveLocationStopped is returned by LiveLocationShare.Update if the share has already been stopped or has expired.
+var ErrLiveLocationStopped = errors.New("live location share has been stopped")
+
+// Some errors that poll vote building and decryption can return
+var (
+	ErrNotPollUpdateMessage          = errors.New("given message isn't a poll update message")
+	ErrOriginalMessageSecretNotFound = errors.New("original message secret key not found")
 )
 
+// ErrInvalidOggOpus is returned by voice note helpers if the given file isn't an Opus audio file in an Ogg container.
+var ErrInvalidOggOpus = errors.New("voice note is not a valid ogg opus file")
+
+// ErrLinkPreviewNotAvailable is returned by Client.GetLinkPreview if the page doesn't have any metadata to preview.
+var ErrLinkPreviewNotAvailable = errors.New("link preview not available")
+
 // Some errors that Client.Download can return
 var (
 	ErrMediaDownloadFailedWith404 = errors.New("download failed with status code 404")
@@ -66,8 +165,26 @@ var (
 	ErrInvalidMediaSHA256         = errors.New("hash of media plaintext doesn't match")
 	ErrUnknownMediaType           = errors.New("unknown media type")
 	ErrNothingDownloadableFound   = errors.New("didn't find any attachments in message")
+	ErrViewOnceConsumed           = errors.New("view-once message has already been opened")
+	ErrMediaRetryFailed           = errors.New("sender failed to re-upload media")
 )
 
+// DisconnectedBeforeResponseError is returned when the websocket is disconnected while waiting for a response.
+//
+// It matches both ErrDisconnectedBeforeResponse and ErrIQDisconnected when using errors.Is.
+type DisconnectedBeforeResponseError struct {
+	RequestID string        // The ID of the request (or message) whose response was being waited for.
+	Elapsed   time.Duration // How long the request had been waiting for a response before the disconnection.
+}
+
+func (err *DisconnectedBeforeResponseError) Error() string {
+	return fmt.Sprintf("%v (request %s, waited %s)", ErrDisconnectedBeforeResponse, err.RequestID, err.Elapsed)
+}
+
+func (err *DisconnectedBeforeResponseError) Is(other error) bool {
+	return other == ErrDisconnectedBeforeResponse || other == ErrIQDisconnected
+}
+
 type wrappedIQError struct {
 	HumanError error
 	IQError    error
@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/crypto/hkdf"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/socket"
+)
+
+// fakeServerHandler is called for every node the client sends to a fakeServer and returns the nodes to respond with.
+type fakeServerHandler func(node *waBinary.Node) []waBinary.Node
+
+// fakeServer is a websocket server that speaks the noise transport of an already completed handshake,
+// so that tests can exercise code that sends nodes and waits for responses.
+type fakeServer struct {
+	t       *testing.T
+	server  *httptest.Server
+	handler fakeServerHandler
+
+	readKey, writeKey cipher.AEAD
+	writeCounter      uint32
+	connLock          sync.Mutex
+	conn              *websocket.Conn
+
+	receivedLock sync.Mutex
+	received     []*waBinary.Node
+}
+
+// fakeServerKeys derives the transport keys that NoiseHandshake.Finish derives right after NoiseHandshake.Start.
+func fakeServerKeys(t *testing.T) (clientWrite, clientRead cipher.AEAD) {
+	h := hkdf.New(sha256.New, nil, []byte(socket.NoiseStartPattern), nil)
+	keys := make([]cipher.AEAD, 2)
+	for i := range keys {
+		key := make([]byte, 32)
+		if _, err := io.ReadFull(h, key); err != nil {
+			t.Fatalf("Failed to derive key: %v", err)
+		}
+		block, _ := aes.NewCipher(key)
+		keys[i], _ = cipher.NewGCM(block)
+	}
+	return keys[0], keys[1]
+}
+
+func fakeServerIV(count uint32) []byte {
+	iv := make([]byte, 12)
+	binary.BigEndian.PutUint32(iv[8:], count)
+	return iv
+}
+
+// connectFakeServer starts a fakeServer and connects the client to it.
+func connectFakeServer(t *testing.T, cli *Client, handler fakeServerHandler) *fakeServer {
+	t.Helper()
+	fs := &fakeServer{t: t, handler: handler}
+	fs.readKey, fs.writeKey = fakeServerKeys(t)
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	fs.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		fs.connLock.Lock()
+		fs.conn = conn
+		fs.connLock.Unlock()
+		fs.readLoop(conn)
+	}))
+
+	frameSocket := socket.NewFrameSocket(cli.Log.Sub("Socket"), nil, nil)
+	frameSocket.URLs = []string{"ws" + strings.TrimPrefix(fs.server.URL, "http")}
+	if err := frameSocket.Connect(); err != nil {
+		fs.server.Close()
+		t.Fatalf("Failed to connect to fake server: %v", err)
+	}
+	nh := socket.NewNoiseHandshake()
+	nh.Start(socket.NoiseStartPattern, nil)
+	ns, err := nh.Finish(frameSocket, cli.handleFrame, cli.onDisconnect)
+	if err != nil {
+		t.Fatalf("Failed to create noise socket: %v", err)
+	}
+	cli.socketLock.Lock()
+	cli.socket = ns
+	cli.socketLock.Unlock()
+	t.Cleanup(func() {
+		cli.socketLock.Lock()
+		cli.socket = nil
+		cli.socketLock.Unlock()
+		ns.Stop(true)
+		fs.server.Close()
+	})
+	return fs
+}
+
+func (fs *fakeServer) readLoop(conn *websocket.Conn) {
+	var readCounter uint32
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		for len(data) >= socket.FrameLengthSize {
+			length := int(data[0])<<16 | int(data[1])<<8 | int(data[2])
+			frame := data[socket.FrameLengthSize : socket.FrameLengthSize+length]
+			data = data[socket.FrameLengthSize+length:]
+			plaintext, err := fs.readKey.Open(nil, fakeServerIV(readCounter), frame, nil)
+			readCounter++
+			if err != nil {
+				fs.t.Errorf("Fake server failed to decrypt frame: %v", err)
+				return
+			}
+			if plaintext, err = waBinary.Unpack(plaintext); err != nil {
+				fs.t.Errorf("Fake server failed to unpack frame: %v", err)
+				return
+			}
+			node, err := waBinary.Unmarshal(plaintext)
+			if err != nil {
+				fs.t.Errorf("Fake server failed to decode node: %v", err)
+				return
+			}
+			fs.receivedLock.Lock()
+			fs.received = append(fs.received, node)
+			fs.receivedLock.Unlock()
+			if fs.handler != nil {
+				for _, resp := range fs.handler(node) {
+					fs.Send(resp)
+				}
+			}
+		}
+	}
+}
+
+// Send sends a node from the fake server to the client.
+func (fs *fakeServer) Send(node waBinary.Node) {
+	payload, err := waBinary.Marshal(node)
+	if err != nil {
+		fs.t.Errorf("Fake server failed to marshal node: %v", err)
+		return
+	}
+	fs.connLock.Lock()
+	defer fs.connLock.Unlock()
+	ciphertext := fs.writeKey.Seal(nil, fakeServerIV(fs.writeCounter), payload, nil)
+	fs.writeCounter++
+	frame := make([]byte, socket.FrameLengthSize+len(ciphertext))
+	frame[0], frame[1], frame[2] = byte(len(ciphertext)>>16), byte(len(ciphertext)>>8), byte(len(ciphertext))
+	copy(frame[socket.FrameLengthSize:], ciphertext)
+	if err = fs.conn.WriteMessage(websocket.BinaryMessage, frame); err != nil {
+		fs.t.Errorf("Fake server failed to send frame: %v", err)
+	}
+}
+
+// Received returns all the nodes with the given tag that the client has sent so far.
+func (fs *fakeServer) Received(tag string) []*waBinary.Node {
+	fs.receivedLock.Lock()
+	defer fs.receivedLock.Unlock()
+	var nodes []*waBinary.Node
+	for _, node := range fs.received {
+		if node.Tag == tag {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// fakeIQResult builds a successful response to the given iq node.
+func fakeIQResult(req *waBinary.Node, content ...waBinary.Node) waBinary.Node {
+	resp := waBinary.Node{Tag: "iq", Attrs: waBinary.Attrs{"id": req.Attrs["id"], "type": "result", "from": req.Attrs["to"]}}
+	if len(content) > 0 {
+		resp.Content = content
+	}
+	return resp
+}
+
+// fakeIQError builds an error response to the given iq node.
+func fakeIQError(req *waBinary.Node, code int, text string) waBinary.Node {
+	return waBinary.Node{
+		Tag:     "iq",
+		Attrs:   waBinary.Attrs{"id": req.Attrs["id"], "type": "error", "from": req.Attrs["to"]},
+		Content: []waBinary.Node{{Tag: "error", Attrs: waBinary.Attrs{"code": code, "text": text}}},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// Feature is a WhatsApp feature that may or may not be available depending on the account and server-side gating.
+type Feature string
+
+// The features that can be checked with Client.SupportsFeature.
+const (
+	FeatureNewsletters       Feature = "newsletters"
+	FeatureCommunities       Feature = "communities"
+	FeatureMessageEdit       Feature = "message_edit"
+	FeaturePolls             Feature = "polls"
+	FeatureGroupJoinApproval Feature = "group_join_approval"
+)
+
+// defaultServerPropsRefresh is how often server props are refetched if the server doesn't specify a refresh interval.
+const defaultServerPropsRefresh = 24 * time.Hour
+
+type featureRequirement struct {
+	// Whether the feature is unavailable for business accounts.
+	personalOnly bool
+}
+
+var featureRequirements = map[Feature]featureRequirement{
+	FeatureNewsletters:       {},
+	FeatureCommunities:       {personalOnly: true},
+	FeatureMessageEdit:       {},
+	FeaturePolls:             {},
+	FeatureGroupJoinApproval: {},
+}
+
+func parsePropBool(value string) (enabled, ok bool) {
+	switch value {
+	case "1", "true":
+		return true, true
+	case "0", "false":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// SupportsFeature checks whether the given feature is available for the connected account.
+//
+// The result is based on the account type and the server and AB props listed in Client.FeatureProps
+// (which are fetched after connecting and refreshed periodically). Methods that use gated features will return
+// ErrFeatureNotAvailable if this returns false, unless Client.SkipFeatureChecks is set.
+func (cli *Client) SupportsFeature(feature Feature) bool {
+	req, ok := featureRequirements[feature]
+	if !ok {
+		return false
+	}
+	cli.serverPropsLock.RLock()
+	props := cli.serverProps
+	cli.serverPropsLock.RUnlock()
+	return req.check(props, cli.FeatureProps[feature], len(cli.Store.BusinessName) > 0)
+}
+
+func (req featureRequirement) check(props map[string]string, featureProps []string, isBusiness bool) bool {
+	if req.personalOnly && isBusiness {
+		return false
+	}
+	for _, prop := range featureProps {
+		if enabled, ok := parsePropBool(props[prop]); ok {
+			return enabled
+		}
+	}
+	return true
+}
+
+// checkFeature returns ErrFeatureNotAvailable if the given feature isn't supported, unless Client.SkipFeatureChecks is set.
+func (cli *Client) checkFeature(feature Feature) error {
+	if cli.SkipFeatureChecks || cli.SupportsFeature(feature) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrFeatureNotAvailable, feature)
+}
+
+// GetServerProps returns the server and AB props that were fetched from the server after connecting.
+func (cli *Client) GetServerProps() map[string]string {
+	cli.serverPropsLock.RLock()
+	defer cli.serverPropsLock.RUnlock()
+	props := make(map[string]string, len(cli.serverProps))
+	for key, value := range cli.serverProps {
+		props[key] = value
+	}
+	return props
+}
+
+func (cli *Client) fetchProps(namespace, protocol string, props map[string]string) (time.Duration, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: namespace,
+		Type:      iqGet,
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag:   "props",
+			Attrs: waBinary.Attrs{"protocol": protocol},
+		}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	propsNode, ok := resp.GetOptionalChildByTag("props")
+	if !ok {
+		return 0, &ElementMissingError{Tag: "props", In: "response to " + namespace + " props query"}
+	}
+	for _, prop := range propsNode.GetChildrenByTag("prop") {
+		ag := prop.AttrGetter()
+		if name := ag.OptionalString("name"); len(name) > 0 {
+			props[name] = ag.OptionalString("value")
+		} else if code := ag.OptionalString("config_code"); len(code) > 0 {
+			props[code] = ag.OptionalString("config_value")
+		}
+	}
+	return time.Duration(propsNode.AttrGetter().OptionalInt("refresh")) * time.Second, nil
+}
+
+// refreshServerProps fetches the server and AB props that are used for SupportsFeature.
+//
+// This is called in a goroutine after connecting, so features are assumed to be available until the first fetch finishes.
+func (cli *Client) refreshServerProps() {
+	props := make(map[string]string)
+	refresh, err := cli.fetchProps("w", "2", props)
+	if err != nil {
+		cli.Log.Warnf("Failed to fetch server props: %v", err)
+		return
+	}
+	abRefresh, err := cli.fetchProps("abt", "1", props)
+	if err != nil {
+		cli.Log.Warnf("Failed to fetch AB props: %v", err)
+	} else if abRefresh > 0 && (refresh <= 0 || abRefresh < refresh) {
+		refresh = abRefresh
+	}
+	if refresh <= 0 {
+		refresh = defaultServerPropsRefresh
+	}
+	cli.serverPropsLock.Lock()
+	cli.serverProps = props
+	if cli.serverPropsRefresh != nil {
+		cli.serverPropsRefresh.Stop()
+	}
+	cli.serverPropsRefresh = time.AfterFunc(refresh, func() {
+		if cli.IsConnected() {
+			cli.refreshServerProps()
+		}
+	})
+	cli.serverPropsLock.Unlock()
+	cli.Log.Debugf("Fetched %d server props, refreshing in %s", len(props), refresh)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestFeatureRequirements(t *testing.T) {
+	pollProps := []string{"polls_enabled", "poll_creation_enabled"}
+	tests := []struct {
+		name         string
+		feature      Feature
+		props        map[string]string
+		featureProps []string
+		isBusiness   bool
+		expected     bool
+	}{
+		{"enabled without feature props", FeaturePolls, map[string]string{"polls_enabled": "0"}, nil, false, true},
+		{"enabled if props are missing", FeaturePolls, nil, pollProps, false, true},
+		{"disabled by prop", FeaturePolls, map[string]string{"polls_enabled": "0"}, pollProps, false, false},
+		{"second prop is used if first is missing", FeaturePolls, map[string]string{"poll_creation_enabled": "false"}, pollProps, false, false},
+		{"invalid prop value is ignored", FeaturePolls, map[string]string{"polls_enabled": "maybe", "poll_creation_enabled": "0"}, pollProps, false, false},
+		{"enabled by prop", FeatureNewsletters, map[string]string{"channels": "1"}, []string{"channels"}, false, true},
+		{"communities enabled", FeatureCommunities, nil, nil, false, true},
+		{"no communities for businesses", FeatureCommunities, nil, nil, true, false},
+		{"join approval enabled for businesses", FeatureGroupJoinApproval, nil, nil, true, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := featureRequirements[test.feature].check(test.props, test.featureProps, test.isBusiness); result != test.expected {
+				t.Errorf("Expected %t, got %t", test.expected, result)
+			}
+		})
+	}
+
+	cli := NewClient(&store.Device{}, nil)
+	if !cli.SupportsFeature(FeatureMessageEdit) {
+		t.Errorf("Expected features to be available by default")
+	}
+	cli.FeatureProps = map[Feature][]string{FeaturePolls: pollProps}
+	cli.serverProps = map[string]string{"polls_enabled": "0"}
+	if err := cli.checkFeature(FeaturePolls); !errors.Is(err, ErrFeatureNotAvailable) {
+		t.Errorf("Expected ErrFeatureNotAvailable, got %v", err)
+	}
+	cli.SkipFeatureChecks = true
+	if err := cli.checkFeature(FeaturePolls); err != nil {
+		t.Errorf("Expected no error with SkipFeatureChecks, got %v", err)
+	}
+}
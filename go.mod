@@ -1,9 +1,10 @@
 module go.mau.fi/whatsmeow
 
-go 1.17
+go 1.18
 
 require (
 	github.com/gorilla/websocket v1.4.2
+	github.com/mattn/go-sqlite3 v1.14.8
 	go.mau.fi/libsignal v0.0.0-20211109153248-a67163214910
 	golang.org/x/crypto v0.0.0-20211108221036-ceb1ce70b4fa
 	google.golang.org/protobuf v1.27.1
@@ -7,8 +7,13 @@
 package whatsmeow
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"image"
+	_ "image/jpeg"
+	"strconv"
 	"strings"
 	"time"
 
@@ -79,19 +84,57 @@ const (
 	ParticipantChangeDemote  ParticipantChange = "demote"
 )
 
+// ParticipantRequestChange is an action on a request to join a group, see UpdateGroupRequestParticipants.
+type ParticipantRequestChange string
+
+const (
+	ParticipantRequestChangeApprove ParticipantRequestChange = "approve"
+	ParticipantRequestChangeReject  ParticipantRequestChange = "reject"
+)
+
+// ParticipantChangeResult contains the result of changing a single participant with UpdateGroupParticipants.
+type ParticipantChangeResult struct {
+	JID    types.JID
+	Change ParticipantChange
+
+	// The status code returned by the server for this participant, or zero if the change was successful.
+	StatusCode int
+	// The error corresponding to StatusCode, e.g. ErrParticipantRequiresInvite or ErrParticipantAlreadyInGroup.
+	Error error
+
+	// If adding the participant failed with ErrParticipantRequiresInvite, the server returns an invite code
+	// that can be sent to the user in a GroupInviteMessage.
+	InviteCode       string
+	InviteExpiration time.Time
+}
+
 // UpdateGroupParticipants can be used to add, remove, promote and demote members in a WhatsApp group.
-func (cli *Client) UpdateGroupParticipants(jid types.JID, participantChanges map[types.JID]ParticipantChange) (*waBinary.Node, error) {
-	content := make([]waBinary.Node, len(participantChanges))
-	i := 0
+//
+// The returned error is only set if the whole request failed. Failures for individual participants are returned
+// in the result list, which contains one entry for each participant in the response:
+//
+//	results, err := cli.UpdateGroupParticipants(groupJID, map[types.JID]whatsmeow.ParticipantChange{
+//		userJID: whatsmeow.ParticipantChangeAdd,
+//	})
+//	for _, result := range results {
+//		if errors.Is(result.Error, whatsmeow.ErrParticipantRequiresInvite) {
+//			// Send result.InviteCode to the user
+//		}
+//	}
+func (cli *Client) UpdateGroupParticipants(jid types.JID, participantChanges map[types.JID]ParticipantChange) ([]ParticipantChangeResult, error) {
+	grouped := make(map[ParticipantChange][]waBinary.Node)
 	for participantJID, change := range participantChanges {
-		content[i] = waBinary.Node{
-			Tag: string(change),
-			Content: []waBinary.Node{{
-				Tag:   "participant",
-				Attrs: waBinary.Attrs{"jid": participantJID},
-			}},
-		}
-		i++
+		grouped[change] = append(grouped[change], waBinary.Node{
+			Tag:   "participant",
+			Attrs: waBinary.Attrs{"jid": participantJID},
+		})
+	}
+	content := make([]waBinary.Node, 0, len(grouped))
+	for change, participants := range grouped {
+		content = append(content, waBinary.Node{
+			Tag:     string(change),
+			Content: participants,
+		})
 	}
 	resp, err := cli.sendIQ(infoQuery{
 		Namespace: "w:g2",
@@ -102,8 +145,185 @@ func (cli *Client) UpdateGroupParticipants(jid types.JID, participantChanges map
 	if err != nil {
 		return nil, err
 	}
-	// TODO proper return value?
-	return resp, nil
+	return parseParticipantChangeResults(resp), nil
+}
+
+func parseParticipantChangeResults(resp *waBinary.Node) []ParticipantChangeResult {
+	var results []ParticipantChangeResult
+	for _, changeNode := range resp.GetChildren() {
+		change := ParticipantChange(changeNode.Tag)
+		for _, child := range changeNode.GetChildren() {
+			if child.Tag != "participant" {
+				continue
+			}
+			ag := child.AttrGetter()
+			result := ParticipantChangeResult{
+				JID:        ag.JID("jid"),
+				Change:     change,
+				StatusCode: ag.OptionalInt("error"),
+			}
+			result.Error = participantStatusError(changeNode.Tag, result.StatusCode)
+			if addRequest, ok := child.GetOptionalChildByTag("add_request"); ok {
+				addAG := addRequest.AttrGetter()
+				result.InviteCode = addAG.String("code")
+				result.InviteExpiration = time.Unix(addAG.Int64("expiration"), 0)
+			}
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// participantStatusError converts the status code of a single participant in a participant change response to an error.
+func participantStatusError(change string, statusCode int) error {
+	switch statusCode {
+	case 0:
+		return nil
+	case 403:
+		return ErrParticipantRequiresInvite
+	case 404:
+		return ErrParticipantNotFound
+	case 408:
+		return ErrParticipantRecentlyLeft
+	case 409:
+		return ErrParticipantAlreadyInGroup
+	default:
+		return fmt.Errorf("%s participant failed with status code %d", change, statusCode)
+	}
+}
+
+// SetGroupMemberAddMode changes who is allowed to add new members to the group: either only admins
+// (types.GroupMemberAddModeAdmin) or all members (types.GroupMemberAddModeAllMember).
+//
+// The change is reflected in the MemberAddMode field of events.GroupInfo.
+func (cli *Client) SetGroupMemberAddMode(jid types.JID, mode types.GroupMemberAddMode) error {
+	if mode != types.GroupMemberAddModeAdmin && mode != types.GroupMemberAddModeAllMember {
+		return fmt.Errorf("invalid group member add mode %q", mode)
+	}
+	return cli.setGroupSetting(jid, waBinary.Node{
+		Tag:     "member_add_mode",
+		Content: []byte(mode),
+	})
+}
+
+// SetGroupJoinApprovalMode changes whether new members need to be approved by admins before they can join the group
+// with an invite link. Pending requests can be managed with GetGroupRequestParticipants and UpdateGroupRequestParticipants.
+func (cli *Client) SetGroupJoinApprovalMode(jid types.JID, on bool) error {
+	if err := cli.checkFeature(FeatureGroupJoinApproval); err != nil {
+		return err
+	}
+	state := "off"
+	if on {
+		state = "on"
+	}
+	return cli.setGroupSetting(jid, waBinary.Node{
+		Tag: "membership_approval_mode",
+		Content: []waBinary.Node{{
+			Tag:   "group_join",
+			Attrs: waBinary.Attrs{"state": state},
+		}},
+	})
+}
+
+// GetGroupRequestParticipants returns the list of users who have requested to join the given group.
+func (cli *Client) GetGroupRequestParticipants(jid types.JID) ([]types.GroupParticipantRequest, error) {
+	if err := cli.checkFeature(FeatureGroupJoinApproval); err != nil {
+		return nil, err
+	}
+	resp, err := cli.sendGroupIQ(iqGet, jid, waBinary.Node{Tag: "membership_approval_requests"})
+	if err != nil {
+		return nil, convertGroupSettingError(err)
+	}
+	return parseGroupRequestParticipants(resp)
+}
+
+func parseGroupRequestParticipants(resp *waBinary.Node) ([]types.GroupParticipantRequest, error) {
+	requestsNode, ok := resp.GetOptionalChildByTag("membership_approval_requests")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "membership_approval_requests", In: "response to group request participants query"}
+	}
+	children := requestsNode.GetChildren()
+	requests := make([]types.GroupParticipantRequest, 0, len(children))
+	for _, child := range children {
+		if child.Tag != "membership_approval_request" {
+			continue
+		}
+		ag := child.AttrGetter()
+		request := types.GroupParticipantRequest{
+			JID:         ag.JID("jid"),
+			RequestedAt: time.Unix(ag.Int64("request_time"), 0),
+		}
+		if !ag.OK() {
+			return nil, fmt.Errorf("failed to parse group join request: %w", ag.Error())
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// ParticipantRequestChangeResult contains the result of approving or rejecting a single request to join a group
+// with UpdateGroupRequestParticipants.
+type ParticipantRequestChangeResult struct {
+	JID    types.JID
+	Change ParticipantRequestChange
+
+	// The status code returned by the server for this user, or zero if the change was successful.
+	StatusCode int
+	// The error corresponding to StatusCode, e.g. ErrParticipantNotFound if the user didn't request to join.
+	Error error
+}
+
+// UpdateGroupRequestParticipants approves or rejects requests to join the given group.
+//
+// Like with UpdateGroupParticipants, failures for individual users are returned in the result list.
+func (cli *Client) UpdateGroupRequestParticipants(jid types.JID, change ParticipantRequestChange, participants []types.JID) ([]ParticipantRequestChangeResult, error) {
+	if change != ParticipantRequestChangeApprove && change != ParticipantRequestChangeReject {
+		return nil, fmt.Errorf("invalid group request participant change %q", change)
+	} else if err := cli.checkFeature(FeatureGroupJoinApproval); err != nil {
+		return nil, err
+	}
+	participantNodes := make([]waBinary.Node, len(participants))
+	for i, participant := range participants {
+		participantNodes[i] = waBinary.Node{
+			Tag:   "participant",
+			Attrs: waBinary.Attrs{"jid": participant},
+		}
+	}
+	resp, err := cli.sendGroupIQ(iqSet, jid, waBinary.Node{
+		Tag: "membership_requests_action",
+		Content: []waBinary.Node{{
+			Tag:     string(change),
+			Content: participantNodes,
+		}},
+	})
+	if err != nil {
+		return nil, convertGroupSettingError(err)
+	}
+	return parseParticipantRequestChangeResults(resp)
+}
+
+func parseParticipantRequestChangeResults(resp *waBinary.Node) ([]ParticipantRequestChangeResult, error) {
+	actionNode, ok := resp.GetOptionalChildByTag("membership_requests_action")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "membership_requests_action", In: "response to group request participants update"}
+	}
+	var results []ParticipantRequestChangeResult
+	for _, changeNode := range actionNode.GetChildren() {
+		for _, child := range changeNode.GetChildren() {
+			if child.Tag != "participant" {
+				continue
+			}
+			ag := child.AttrGetter()
+			result := ParticipantRequestChangeResult{
+				JID:        ag.JID("jid"),
+				Change:     ParticipantRequestChange(changeNode.Tag),
+				StatusCode: ag.OptionalInt("error"),
+			}
+			result.Error = participantStatusError(changeNode.Tag, result.StatusCode)
+			results = append(results, result)
+		}
+	}
+	return results, nil
 }
 
 // SetGroupName updates the name (subject) of the given group on WhatsApp.
@@ -145,29 +365,110 @@ func (cli *Client) SetGroupTopic(jid types.JID, previousID, newID, topic string)
 	return err
 }
 
+// setGroupSetting sends a group setting change and converts the common permission errors.
+func (cli *Client) setGroupSetting(jid types.JID, content waBinary.Node) error {
+	_, err := cli.sendGroupIQ(iqSet, jid, content)
+	return convertGroupSettingError(err)
+}
+
+func convertGroupSettingError(err error) error {
+	if errors.Is(err, ErrIQNotAuthorized) {
+		return wrapIQError(ErrNotGroupAdmin, err)
+	} else if errors.Is(err, ErrIQForbidden) {
+		return wrapIQError(ErrNotInGroup, err)
+	} else if errors.Is(err, ErrIQNotFound) {
+		return wrapIQError(ErrGroupNotFound, err)
+	}
+	return err
+}
+
 // SetGroupLocked changes whether the group is locked (i.e. whether only admins can modify group info).
+//
+// Only group admins can change the setting. The change is reflected in the Locked field of events.GroupInfo.
 func (cli *Client) SetGroupLocked(jid types.JID, locked bool) error {
 	tag := "locked"
 	if !locked {
 		tag = "unlocked"
 	}
-	_, err := cli.sendGroupIQ(iqSet, jid, waBinary.Node{Tag: tag})
-	return err
+	return cli.setGroupSetting(jid, waBinary.Node{Tag: tag})
 }
 
 // SetGroupAnnounce changes whether the group is in announce mode (i.e. whether only admins can send messages).
+//
+// Only group admins can change the setting. The change is reflected in the Announce field of events.GroupInfo.
 func (cli *Client) SetGroupAnnounce(jid types.JID, announce bool) error {
 	tag := "announcement"
 	if !announce {
 		tag = "not_announcement"
 	}
-	_, err := cli.sendGroupIQ(iqSet, jid, waBinary.Node{Tag: tag})
-	return err
+	return cli.setGroupSetting(jid, waBinary.Node{Tag: tag})
+}
+
+// SetGroupEphemeralTimer changes the disappearing message timer of the given group. A zero timer disables
+// disappearing messages. The official clients only allow the timers defined in the DisappearingTimer constants.
+//
+// The change is reflected in the Ephemeral field of events.GroupInfo.
+func (cli *Client) SetGroupEphemeralTimer(jid types.JID, timer time.Duration) error {
+	content := waBinary.Node{Tag: "not_ephemeral"}
+	if timer > 0 {
+		content = waBinary.Node{
+			Tag:   "ephemeral",
+			Attrs: waBinary.Attrs{"expiration": strconv.Itoa(int(timer / time.Second))},
+		}
+	}
+	return cli.setGroupSetting(jid, content)
+}
+
+// SetGroupPhoto updates the picture of the given group and returns the ID of the new picture, which will also be
+// in the events.Picture event that is emitted for the change. If the given data is empty, the picture is removed
+// and the returned ID is empty.
+//
+// The picture must be a square JPEG image. The official clients use 640x640 pictures.
+func (cli *Client) SetGroupPhoto(jid types.JID, jpegData []byte) (string, error) {
+	var content interface{}
+	if len(jpegData) > 0 {
+		if err := checkGroupPhoto(jpegData); err != nil {
+			return "", err
+		}
+		content = []waBinary.Node{{
+			Tag:     "picture",
+			Attrs:   waBinary.Attrs{"type": "image"},
+			Content: jpegData,
+		}}
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "w:profile:picture",
+		Type:      iqSet,
+		To:        jid,
+		Content:   content,
+	})
+	if err != nil {
+		return "", convertGroupSettingError(err)
+	} else if content == nil {
+		return "", nil
+	}
+	pictureID, ok := resp.GetChildByTag("picture").Attrs["id"].(string)
+	if !ok {
+		return "", &ElementMissingError{Tag: "picture", In: "response to set group photo query"}
+	}
+	return pictureID, nil
+}
+
+func checkGroupPhoto(data []byte) error {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || format != "jpeg" {
+		return ErrGroupPhotoNotJPEG
+	} else if config.Width != config.Height {
+		return fmt.Errorf("%w (got %dx%d)", ErrGroupPhotoNotSquare, config.Width, config.Height)
+	}
+	return nil
 }
 
 // GetGroupInviteLink requests the invite link to the group from the WhatsApp servers.
+// The returned link is the full URL, i.e. the code prefixed with InviteLinkPrefix.
 //
 // If reset is true, then the old invite link will be revoked and a new one generated.
+// Other participants will receive an events.GroupInfo with the NewInviteLink field set when the link is reset.
 func (cli *Client) GetGroupInviteLink(jid types.JID, reset bool) (string, error) {
 	iqType := iqGet
 	if reset {
@@ -230,10 +531,24 @@ func (cli *Client) JoinGroupWithInvite(jid, inviter types.JID, code string, expi
 	return err
 }
 
-// GetGroupInfoFromLink resolves the given invite link and asks the WhatsApp servers for info about the group.
+// parseInviteCode extracts the invite code from a group invite link. Links without the https:// scheme and plain
+// codes are also accepted.
+func parseInviteCode(link string) string {
+	link = strings.TrimSpace(link)
+	for _, prefix := range []string{"https://", "http://"} {
+		link = strings.TrimPrefix(link, prefix)
+	}
+	link = strings.TrimPrefix(link, strings.TrimPrefix(InviteLinkPrefix, "https://"))
+	if index := strings.IndexAny(link, "/?#"); index >= 0 {
+		link = link[:index]
+	}
+	return link
+}
+
+// GetGroupInfoFromLink resolves the given invite link or code and asks the WhatsApp servers for info about the group.
 // This will not cause the user to join the group.
 func (cli *Client) GetGroupInfoFromLink(code string) (*types.GroupInfo, error) {
-	code = strings.TrimPrefix(code, InviteLinkPrefix)
+	code = parseInviteCode(code)
 	resp, err := cli.sendGroupIQ(iqGet, types.GroupServerJID, waBinary.Node{
 		Tag:   "invite",
 		Attrs: waBinary.Attrs{"code": code},
@@ -252,9 +567,12 @@ func (cli *Client) GetGroupInfoFromLink(code string) (*types.GroupInfo, error) {
 	return cli.parseGroupNode(&groupNode)
 }
 
-// JoinGroupWithLink joins the group using the given invite link.
+// JoinGroupWithLink joins the group using the given invite link or code and returns the JID of the group.
+//
+// After joining, the server sends the group info, which is emitted as an events.JoinedGroup event like when
+// being added to a group by someone else.
 func (cli *Client) JoinGroupWithLink(code string) (types.JID, error) {
-	code = strings.TrimPrefix(code, InviteLinkPrefix)
+	code = parseInviteCode(code)
 	resp, err := cli.sendGroupIQ(iqSet, types.GroupServerJID, waBinary.Node{
 		Tag:   "invite",
 		Attrs: waBinary.Attrs{"code": code},
@@ -274,6 +592,7 @@ func (cli *Client) JoinGroupWithLink(code string) (types.JID, error) {
 }
 
 // GetJoinedGroups returns the list of groups the user is participating in.
+// The returned group info is also stored in the group info cache used by IsGroupAdmin and GetGroupInfos.
 func (cli *Client) GetJoinedGroups() ([]*types.GroupInfo, error) {
 	resp, err := cli.sendGroupIQ(iqGet, types.GroupServerJID, waBinary.Node{
 		Tag: "participating",
@@ -299,6 +618,8 @@ func (cli *Client) GetJoinedGroups() ([]*types.GroupInfo, error) {
 		parsed, parseErr := cli.parseGroupNode(&child)
 		if parseErr != nil {
 			cli.Log.Warnf("Error parsing group %s: %v", parsed.JID, parseErr)
+		} else {
+			cli.cacheGroupInfo(parsed)
 		}
 		infos = append(infos, parsed)
 	}
@@ -307,13 +628,25 @@ func (cli *Client) GetJoinedGroups() ([]*types.GroupInfo, error) {
 
 // GetGroupInfo requests basic info about a group chat from the WhatsApp servers.
 func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
-	res, err := cli.sendGroupIQ(iqGet, jid, waBinary.Node{
-		Tag:   "query",
-		Attrs: waBinary.Attrs{"request": "interactive"},
+	return cli.getGroupInfo(context.Background(), jid)
+}
+
+func (cli *Client) getGroupInfo(ctx context.Context, jid types.JID) (*types.GroupInfo, error) {
+	res, err := cli.sendIQ(infoQuery{
+		Namespace: "w:g2",
+		Type:      iqGet,
+		To:        jid,
+		Content: []waBinary.Node{{
+			Tag:   "query",
+			Attrs: waBinary.Attrs{"request": "interactive"},
+		}},
+		Context: ctx,
 	})
 	if errors.Is(err, ErrIQNotFound) {
+		cli.invalidateGroupInfo(jid)
 		return nil, wrapIQError(ErrGroupNotFound, err)
 	} else if errors.Is(err, ErrIQForbidden) {
+		cli.invalidateGroupInfo(jid)
 		return nil, wrapIQError(ErrNotInGroup, err)
 	} else if err != nil {
 		return nil, err
@@ -323,7 +656,12 @@ func (cli *Client) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
 	if !ok {
 		return nil, &ElementMissingError{Tag: "groups", In: "response to group info query"}
 	}
-	return cli.parseGroupNode(&groupNode)
+	info, err := cli.parseGroupNode(&groupNode)
+	if err != nil {
+		return nil, err
+	}
+	cli.cacheGroupInfo(info)
+	return info, nil
 }
 
 func (cli *Client) parseGroupNode(groupNode *waBinary.Node) (*types.GroupInfo, error) {
@@ -365,6 +703,13 @@ func (cli *Client) parseGroupNode(groupNode *waBinary.Node) (*types.GroupInfo, e
 			group.IsAnnounce = true
 		case "locked":
 			group.IsLocked = true
+		case "ephemeral":
+			group.IsEphemeral = true
+			group.DisappearingTimer = uint32(childAG.Uint64("expiration"))
+		case "membership_approval_mode":
+			group.IsJoinApprovalRequired = parseMembershipApprovalMode(&child)
+		case "member_add_mode":
+			group.MemberAddMode = parseMemberAddMode(&child)
 		default:
 			cli.Log.Debugf("Unknown element in group node %s: %s", group.JID.String(), child.XMLString())
 		}
@@ -389,6 +734,32 @@ func parseParticipantList(node *waBinary.Node) (participants []types.JID) {
 	return
 }
 
+func parseMembershipApprovalMode(node *waBinary.Node) bool {
+	groupJoin, ok := node.GetOptionalChildByTag("group_join")
+	return ok && groupJoin.AttrGetter().OptionalString("state") == "on"
+}
+
+func parseMemberAddMode(node *waBinary.Node) types.GroupMemberAddMode {
+	switch content := node.Content.(type) {
+	case []byte:
+		return types.GroupMemberAddMode(content)
+	case string:
+		return types.GroupMemberAddMode(content)
+	default:
+		return ""
+	}
+}
+
+func parseRequestedUserList(node *waBinary.Node) (users []types.JID) {
+	for _, child := range node.GetChildren() {
+		jid, ok := child.Attrs["jid"].(types.JID)
+		if child.Tag == "requested_user" && ok {
+			users = append(users, jid)
+		}
+	}
+	return
+}
+
 func (cli *Client) parseGroupCreate(node *waBinary.Node) (*events.JoinedGroup, error) {
 	groupNode, ok := node.GetOptionalChildByTag("group")
 	if !ok {
@@ -467,6 +838,24 @@ func (cli *Client) parseGroupChange(node *waBinary.Node) (*events.GroupInfo, err
 				IsAnnounce:        false,
 				AnnounceVersionID: cag.String("v_id"),
 			}
+		case "ephemeral":
+			evt.Ephemeral = &types.GroupEphemeral{
+				IsEphemeral:       true,
+				DisappearingTimer: uint32(cag.Uint64("expiration")),
+			}
+		case "not_ephemeral":
+			evt.Ephemeral = &types.GroupEphemeral{IsEphemeral: false}
+		case "membership_approval_mode":
+			evt.MembershipApprovalMode = &types.GroupMembershipApprovalMode{
+				IsJoinApprovalRequired: parseMembershipApprovalMode(&child),
+			}
+		case "member_add_mode":
+			mode := parseMemberAddMode(&child)
+			evt.MemberAddMode = &mode
+		case "created_membership_requests":
+			evt.JoinRequestsCreated = parseRequestedUserList(&child)
+		case "revoked_membership_requests":
+			evt.JoinRequestsRevoked = parseRequestedUserList(&child)
 		case "invite":
 			link := InviteLinkPrefix + cag.String("code")
 			evt.NewInviteLink = &link
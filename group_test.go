@@ -0,0 +1,391 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestParseParticipantChangeResults(t *testing.T) {
+	added := types.NewJID("1111", types.DefaultUserServer)
+	private := types.NewJID("2222", types.DefaultUserServer)
+	existing := types.NewJID("3333", types.DefaultUserServer)
+	removed := types.NewJID("4444", types.DefaultUserServer)
+	resp := &waBinary.Node{Tag: "iq", Content: []waBinary.Node{
+		{Tag: "add", Content: []waBinary.Node{
+			{Tag: "participant", Attrs: waBinary.Attrs{"jid": added}},
+			{Tag: "participant", Attrs: waBinary.Attrs{"jid": private, "error": "403"}, Content: []waBinary.Node{
+				{Tag: "add_request", Attrs: waBinary.Attrs{"code": "abcd", "expiration": "1700000000"}},
+			}},
+			{Tag: "participant", Attrs: waBinary.Attrs{"jid": existing, "error": "409"}},
+		}},
+		{Tag: "remove", Content: []waBinary.Node{
+			{Tag: "participant", Attrs: waBinary.Attrs{"jid": removed, "error": "500"}},
+		}},
+	}}
+	results := parseParticipantChangeResults(resp)
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(results))
+	}
+	if results[0].JID != added || results[0].Change != ParticipantChangeAdd || results[0].Error != nil {
+		t.Errorf("Expected successful add, got %+v", results[0])
+	}
+	if !errors.Is(results[1].Error, ErrParticipantRequiresInvite) || results[1].InviteCode != "abcd" || results[1].InviteExpiration.Unix() != 1700000000 {
+		t.Errorf("Expected add to require invite, got %+v", results[1])
+	}
+	if !errors.Is(results[2].Error, ErrParticipantAlreadyInGroup) || results[2].StatusCode != 409 {
+		t.Errorf("Expected participant to already be in group, got %+v", results[2])
+	}
+	if results[3].Change != ParticipantChangeRemove || results[3].StatusCode != 500 || results[3].Error == nil {
+		t.Errorf("Expected failed remove with unknown status code, got %+v", results[3])
+	}
+}
+
+func TestCheckGroupPhoto(t *testing.T) {
+	encode := func(width, height int, encoder func(*bytes.Buffer, image.Image) error) []byte {
+		var buf bytes.Buffer
+		if err := encoder(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+			t.Fatalf("Failed to encode test image: %v", err)
+		}
+		return buf.Bytes()
+	}
+	encodeJPEG := func(buf *bytes.Buffer, img image.Image) error { return jpeg.Encode(buf, img, nil) }
+	encodePNG := func(buf *bytes.Buffer, img image.Image) error { return png.Encode(buf, img) }
+
+	if err := checkGroupPhoto(encode(64, 64, encodeJPEG)); err != nil {
+		t.Errorf("Expected square jpeg to be accepted, got %v", err)
+	}
+	if err := checkGroupPhoto(encode(64, 32, encodeJPEG)); !errors.Is(err, ErrGroupPhotoNotSquare) {
+		t.Errorf("Expected ErrGroupPhotoNotSquare, got %v", err)
+	}
+	if err := checkGroupPhoto(encode(64, 64, encodePNG)); !errors.Is(err, ErrGroupPhotoNotJPEG) {
+		t.Errorf("Expected ErrGroupPhotoNotJPEG for png, got %v", err)
+	}
+}
+
+func TestParseGroupChangeInviteLink(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	group := types.NewJID("1234", types.GroupServer)
+	admin := types.NewJID("1111", types.DefaultUserServer)
+	evt, err := cli.parseGroupChange(&waBinary.Node{
+		Tag:   "notification",
+		Attrs: waBinary.Attrs{"from": group, "participant": admin, "t": "1700000000"},
+		Content: []waBinary.Node{
+			{Tag: "invite", Attrs: waBinary.Attrs{"code": "abcd"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group change: %v", err)
+	}
+	if evt.NewInviteLink == nil || *evt.NewInviteLink != InviteLinkPrefix+"abcd" {
+		t.Errorf("Expected new invite link with full URL, got %v", evt.NewInviteLink)
+	}
+	if evt.Sender == nil || *evt.Sender != admin {
+		t.Errorf("Expected link to be reset by %s, got %v", admin, evt.Sender)
+	}
+}
+
+func TestParseInviteCode(t *testing.T) {
+	for _, link := range []string{
+		"https://chat.whatsapp.com/AbCd123",
+		"http://chat.whatsapp.com/AbCd123",
+		"chat.whatsapp.com/AbCd123",
+		" https://chat.whatsapp.com/AbCd123/?utm=1 ",
+		"AbCd123",
+	} {
+		if code := parseInviteCode(link); code != "AbCd123" {
+			t.Errorf("Expected code AbCd123 from %q, got %q", link, code)
+		}
+	}
+}
+
+func TestGroupInvite(t *testing.T) {
+	group := types.NewJID("1234", types.GroupServer)
+	expiration := time.Unix(1700000000, 0)
+	msg := BuildGroupInvite(group, "Test group", "abcd", expiration, "Join us")
+	invite := msg.GetGroupInviteMessage()
+	if invite.GetGroupJid() != group.String() || invite.GetInviteCode() != "abcd" || invite.GetInviteExpiration() != expiration.Unix() {
+		t.Errorf("Unexpected invite message %+v", invite)
+	}
+
+	cli := &Client{Log: waLog.Noop}
+	inviter := types.NewJID("1111", types.DefaultUserServer)
+	if _, err := cli.AcceptGroupInvite(inviter, invite); !errors.Is(err, ErrGroupInviteExpired) {
+		t.Errorf("Expected ErrGroupInviteExpired, got %v", err)
+	}
+	if _, err := cli.SendGroupInvite(context.Background(), group, "Test group", ParticipantChangeResult{JID: inviter}, ""); !errors.Is(err, ErrNoInviteCode) {
+		t.Errorf("Expected ErrNoInviteCode, got %v", err)
+	}
+}
+
+func TestParseGroupChangeEphemeral(t *testing.T) {
+	group := types.NewJID("1234", types.GroupServer)
+	cli := &Client{Log: waLog.Noop, Store: &store.Device{}, groupCache: make(map[types.JID]*cachedGroupInfo)}
+	cli.cacheGroupInfo(&types.GroupInfo{JID: group})
+	evt, err := cli.parseGroupChange(&waBinary.Node{
+		Tag:   "notification",
+		Attrs: waBinary.Attrs{"from": group, "t": "1700000000"},
+		Content: []waBinary.Node{
+			{Tag: "ephemeral", Attrs: waBinary.Attrs{"expiration": "604800"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group change: %v", err)
+	} else if evt.Ephemeral == nil || !evt.Ephemeral.IsEphemeral || evt.Ephemeral.DisappearingTimer != 604800 {
+		t.Fatalf("Expected 7 day disappearing timer, got %+v", evt.Ephemeral)
+	}
+	cli.updateGroupCache(evt)
+	if expiration, err := cli.GetChatEphemeralExpiration(group); err != nil || expiration != DisappearingTimer7Days {
+		t.Errorf("Expected cached timer to be updated to 7 days, got %v (error: %v)", expiration, err)
+	}
+
+	evt, err = cli.parseGroupChange(&waBinary.Node{
+		Tag:     "notification",
+		Attrs:   waBinary.Attrs{"from": group, "t": "1700000000"},
+		Content: []waBinary.Node{{Tag: "not_ephemeral"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group change: %v", err)
+	} else if evt.Ephemeral == nil || evt.Ephemeral.IsEphemeral {
+		t.Errorf("Expected disappearing messages to be disabled, got %+v", evt.Ephemeral)
+	}
+}
+
+func TestGetGroupInfosCached(t *testing.T) {
+	cli := &Client{Log: waLog.Noop, groupCache: make(map[types.JID]*cachedGroupInfo)}
+	first := types.NewJID("1234", types.GroupServer)
+	second := types.NewJID("5678", types.GroupServer)
+	member := types.NewJID("1111", types.DefaultUserServer)
+	cli.cacheGroupInfo(&types.GroupInfo{JID: first, Participants: []types.GroupParticipant{{JID: member}}})
+	cli.cacheGroupInfo(&types.GroupInfo{JID: second})
+
+	// Both groups are cached, so this must not make any requests (which would fail without a connection)
+	infos, err := cli.GetGroupInfos([]types.JID{first, second, first})
+	if err != nil {
+		t.Fatalf("Failed to get cached group infos: %v", err)
+	} else if len(infos) != 2 || infos[first].JID != first || infos[second].JID != second {
+		t.Fatalf("Unexpected group infos %v", infos)
+	}
+	infos[first].Participants[0].IsAdmin = true
+	if isAdmin, _ := cli.IsGroupAdmin(first, member); isAdmin {
+		t.Errorf("Modifying returned group info changed the cache")
+	}
+}
+
+func TestParseGroupJoinApproval(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	group := types.NewJID("1234", types.GroupServer)
+	requester := types.NewJID("1111", types.DefaultUserServer)
+	approvalMode := waBinary.Node{Tag: "membership_approval_mode", Content: []waBinary.Node{
+		{Tag: "group_join", Attrs: waBinary.Attrs{"state": "on"}},
+	}}
+
+	info, err := cli.parseGroupNode(&waBinary.Node{
+		Tag:     "group",
+		Attrs:   waBinary.Attrs{"id": "1234", "subject": "Test", "s_t": "1700000000", "creation": "1700000000"},
+		Content: []waBinary.Node{approvalMode},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group node: %v", err)
+	} else if !info.IsJoinApprovalRequired {
+		t.Errorf("Expected join approval to be required")
+	}
+
+	evt, err := cli.parseGroupChange(&waBinary.Node{
+		Tag:   "notification",
+		Attrs: waBinary.Attrs{"from": group, "t": "1700000000"},
+		Content: []waBinary.Node{
+			approvalMode,
+			{Tag: "created_membership_requests", Content: []waBinary.Node{
+				{Tag: "requested_user", Attrs: waBinary.Attrs{"jid": requester}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group change: %v", err)
+	}
+	if evt.MembershipApprovalMode == nil || !evt.MembershipApprovalMode.IsJoinApprovalRequired {
+		t.Errorf("Expected join approval mode change, got %+v", evt.MembershipApprovalMode)
+	}
+	if len(evt.JoinRequestsCreated) != 1 || evt.JoinRequestsCreated[0] != requester {
+		t.Errorf("Expected join request from %s, got %v", requester, evt.JoinRequestsCreated)
+	}
+}
+
+func TestParseGroupMemberAddMode(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	group := types.NewJID("1234", types.GroupServer)
+	info, err := cli.parseGroupNode(&waBinary.Node{
+		Tag:   "group",
+		Attrs: waBinary.Attrs{"id": "1234", "subject": "Test", "s_t": "1700000000", "creation": "1700000000"},
+		Content: []waBinary.Node{
+			{Tag: "member_add_mode", Content: []byte("all_member_add")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group node: %v", err)
+	} else if info.MemberAddMode != types.GroupMemberAddModeAllMember {
+		t.Errorf("Expected all members to be able to add members, got %q", info.MemberAddMode)
+	}
+
+	evt, err := cli.parseGroupChange(&waBinary.Node{
+		Tag:   "notification",
+		Attrs: waBinary.Attrs{"from": group, "t": "1700000000"},
+		Content: []waBinary.Node{
+			{Tag: "member_add_mode", Content: []byte("admin_add")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse group change: %v", err)
+	} else if evt.MemberAddMode == nil || *evt.MemberAddMode != types.GroupMemberAddModeAdmin {
+		t.Errorf("Expected member add mode to change to admins only, got %v", evt.MemberAddMode)
+	}
+	if err = cli.SetGroupMemberAddMode(group, "everyone"); err == nil {
+		t.Errorf("Expected error for invalid member add mode")
+	}
+}
+
+func TestParseGroupRequestParticipants(t *testing.T) {
+	first := types.NewJID("1111", types.DefaultUserServer)
+	second := types.NewJID("2222", types.DefaultUserServer)
+	resp := &waBinary.Node{Tag: "iq", Content: []waBinary.Node{{
+		Tag: "membership_approval_requests",
+		Content: []waBinary.Node{
+			{Tag: "membership_approval_request", Attrs: waBinary.Attrs{"jid": first, "request_time": "1700000000"}},
+			{Tag: "unknown"},
+			{Tag: "membership_approval_request", Attrs: waBinary.Attrs{"jid": second, "request_time": "1700000100"}},
+		},
+	}}}
+	requests, err := parseGroupRequestParticipants(resp)
+	if err != nil {
+		t.Fatalf("Failed to parse join requests: %v", err)
+	} else if len(requests) != 2 {
+		t.Fatalf("Expected 2 join requests, got %+v", requests)
+	} else if requests[0].JID != first || !requests[0].RequestedAt.Equal(time.Unix(1700000000, 0)) || requests[1].JID != second {
+		t.Errorf("Unexpected join requests %+v", requests)
+	}
+
+	if _, err = parseGroupRequestParticipants(&waBinary.Node{Tag: "iq"}); err == nil {
+		t.Errorf("Expected error for response without request list")
+	}
+	invalid := &waBinary.Node{Tag: "iq", Content: []waBinary.Node{{
+		Tag:     "membership_approval_requests",
+		Content: []waBinary.Node{{Tag: "membership_approval_request", Attrs: waBinary.Attrs{"jid": first}}},
+	}}}
+	if _, err = parseGroupRequestParticipants(invalid); err == nil {
+		t.Errorf("Expected error for request without request time")
+	}
+}
+
+func TestParseParticipantRequestChangeResults(t *testing.T) {
+	approved := types.NewJID("1111", types.DefaultUserServer)
+	notRequested := types.NewJID("2222", types.DefaultUserServer)
+	rejected := types.NewJID("3333", types.DefaultUserServer)
+	resp := &waBinary.Node{Tag: "iq", Content: []waBinary.Node{{
+		Tag: "membership_requests_action",
+		Content: []waBinary.Node{{
+			Tag: "approve",
+			Content: []waBinary.Node{
+				{Tag: "participant", Attrs: waBinary.Attrs{"jid": approved}},
+				{Tag: "participant", Attrs: waBinary.Attrs{"jid": notRequested, "error": "404"}},
+			},
+		}, {
+			Tag:     "reject",
+			Content: []waBinary.Node{{Tag: "participant", Attrs: waBinary.Attrs{"jid": rejected}}},
+		}},
+	}}}
+	results, err := parseParticipantRequestChangeResults(resp)
+	if err != nil {
+		t.Fatalf("Failed to parse results: %v", err)
+	} else if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %+v", results)
+	}
+	if results[0].JID != approved || results[0].Change != ParticipantRequestChangeApprove || results[0].Error != nil {
+		t.Errorf("Unexpected result for approved user: %+v", results[0])
+	}
+	if results[1].JID != notRequested || results[1].StatusCode != 404 || !errors.Is(results[1].Error, ErrParticipantNotFound) {
+		t.Errorf("Unexpected result for user who didn't request to join: %+v", results[1])
+	}
+	if results[2].JID != rejected || results[2].Change != ParticipantRequestChangeReject || results[2].Error != nil {
+		t.Errorf("Unexpected result for rejected user: %+v", results[2])
+	}
+
+	if _, err = parseParticipantRequestChangeResults(&waBinary.Node{Tag: "iq"}); err == nil {
+		t.Errorf("Expected error for response without action node")
+	}
+}
+
+func TestGroupJoinApprovalFeatureCheck(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	cli.FeatureProps = map[Feature][]string{FeatureGroupJoinApproval: {"group_join_request_enabled"}}
+	cli.serverProps = map[string]string{"group_join_request_enabled": "0"}
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	if err := cli.SetGroupJoinApprovalMode(group, true); !errors.Is(err, ErrFeatureNotAvailable) {
+		t.Errorf("Expected ErrFeatureNotAvailable from SetGroupJoinApprovalMode, got %v", err)
+	}
+	if _, err := cli.GetGroupRequestParticipants(group); !errors.Is(err, ErrFeatureNotAvailable) {
+		t.Errorf("Expected ErrFeatureNotAvailable from GetGroupRequestParticipants, got %v", err)
+	}
+	if _, err := cli.UpdateGroupRequestParticipants(group, ParticipantRequestChangeApprove, nil); !errors.Is(err, ErrFeatureNotAvailable) {
+		t.Errorf("Expected ErrFeatureNotAvailable from UpdateGroupRequestParticipants, got %v", err)
+	}
+}
+
+func TestConvertGroupSettingError(t *testing.T) {
+	iqError := func(code, text string) error {
+		return parseIQError(&waBinary.Node{
+			Tag:     "iq",
+			Attrs:   waBinary.Attrs{"type": "error"},
+			Content: []waBinary.Node{{Tag: "error", Attrs: waBinary.Attrs{"code": code, "text": text}}},
+		})
+	}
+	groupErrors := []error{ErrNotGroupAdmin, ErrNotInGroup, ErrGroupNotFound}
+	tests := []struct {
+		name     string
+		input    error
+		expected error
+		iqError  error
+	}{
+		{"not authorized", iqError("401", "not-authorized"), ErrNotGroupAdmin, ErrIQNotAuthorized},
+		{"forbidden", iqError("403", "forbidden"), ErrNotInGroup, ErrIQForbidden},
+		{"not found", iqError("404", "item-not-found"), ErrGroupNotFound, ErrIQNotFound},
+		{"other iq error", iqError("406", "not-acceptable"), nil, ErrIQNotAcceptable},
+		{"timeout", ErrIQTimedOut, nil, ErrIQTimedOut},
+		{"no error", nil, nil, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := convertGroupSettingError(test.input)
+			if test.input == nil {
+				if err != nil {
+					t.Errorf("Expected nil error, got %v", err)
+				}
+				return
+			}
+			for _, groupErr := range groupErrors {
+				if is := errors.Is(err, groupErr); is != (groupErr == test.expected) {
+					t.Errorf("errors.Is(%v, %v) returned %t", err, groupErr, is)
+				}
+			}
+			if !errors.Is(err, test.iqError) {
+				t.Errorf("Expected %v to still match the original error %v", err, test.iqError)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// DefaultGroupInfoCacheTTL is the default value for Client.GroupInfoCacheTTL.
+const DefaultGroupInfoCacheTTL = 30 * time.Minute
+
+// DefaultGroupInfoCacheSize is the default value for Client.GroupInfoCacheSize.
+const DefaultGroupInfoCacheSize = 1024
+
+type cachedGroupInfo struct {
+	info      *types.GroupInfo
+	fetchedAt time.Time
+}
+
+type groupInfoFetch struct {
+	done chan struct{}
+	info *types.GroupInfo
+	err  error
+}
+
+func copyGroupInfo(info *types.GroupInfo) *types.GroupInfo {
+	infoCopy := *info
+	infoCopy.Participants = make([]types.GroupParticipant, len(info.Participants))
+	copy(infoCopy.Participants, info.Participants)
+	return &infoCopy
+}
+
+func (cli *Client) cacheGroupInfo(info *types.GroupInfo) {
+	cli.groupCacheLock.Lock()
+	_, alreadyCached := cli.groupCache[info.JID]
+	if !alreadyCached && cli.GroupInfoCacheSize > 0 && len(cli.groupCache) >= cli.GroupInfoCacheSize {
+		cli.evictOldestGroupInfo()
+	}
+	cli.groupCache[info.JID] = &cachedGroupInfo{info: copyGroupInfo(info), fetchedAt: time.Now()}
+	cli.groupCacheLock.Unlock()
+}
+
+// evictOldestGroupInfo removes the group that was fetched longest ago from the cache.
+// The group cache lock must be held when calling this.
+func (cli *Client) evictOldestGroupInfo() {
+	var oldestJID types.JID
+	var oldest *cachedGroupInfo
+	for jid, cached := range cli.groupCache {
+		if oldest == nil || cached.fetchedAt.Before(oldest.fetchedAt) {
+			oldestJID, oldest = jid, cached
+		}
+	}
+	if oldest != nil {
+		delete(cli.groupCache, oldestJID)
+	}
+}
+
+func (cli *Client) invalidateGroupInfo(jid types.JID) {
+	cli.groupCacheLock.Lock()
+	delete(cli.groupCache, jid)
+	cli.groupCacheLock.Unlock()
+}
+
+// getCachedGroupInfo returns the group info from the cache, or fetches it from the server if it's not cached.
+// Concurrent calls for the same uncached group will only make one request to the server.
+//
+// The returned info must not be modified.
+func (cli *Client) getCachedGroupInfo(jid types.JID) (*types.GroupInfo, error) {
+	cli.groupCacheLock.Lock()
+	cached, ok := cli.groupCache[jid]
+	if ok && (cli.GroupInfoCacheTTL <= 0 || time.Since(cached.fetchedAt) < cli.GroupInfoCacheTTL) {
+		cli.groupCacheLock.Unlock()
+		return cached.info, nil
+	}
+	fetch, ok := cli.groupCacheFetches[jid]
+	if ok {
+		cli.groupCacheLock.Unlock()
+		<-fetch.done
+		return fetch.info, fetch.err
+	}
+	fetch = &groupInfoFetch{done: make(chan struct{})}
+	cli.groupCacheFetches[jid] = fetch
+	cli.groupCacheLock.Unlock()
+
+	fetch.info, fetch.err = cli.GetGroupInfo(jid)
+
+	cli.groupCacheLock.Lock()
+	delete(cli.groupCacheFetches, jid)
+	cli.groupCacheLock.Unlock()
+	close(fetch.done)
+	return fetch.info, fetch.err
+}
+
+// GetGroupInfos returns info about multiple groups using as few requests as possible.
+//
+// Groups that are in the group info cache (see IsGroupAdmin) are returned without making any requests. If more
+// than one group isn't cached, all groups the user is participating in are fetched with a single GetJoinedGroups
+// request, which also fills the cache. Any remaining groups are fetched one by one with GetGroupInfo.
+//
+// Groups that don't exist or that the user isn't participating in are left out of the returned map.
+// An error is only returned if a request fails for another reason.
+func (cli *Client) GetGroupInfos(jids []types.JID) (map[types.JID]*types.GroupInfo, error) {
+	infos := make(map[types.JID]*types.GroupInfo, len(jids))
+	missing := cli.getCachedGroupInfos(jids, infos)
+	if len(missing) > 1 {
+		joined, err := cli.GetJoinedGroups()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get joined groups: %w", err)
+		}
+		// Use the response directly instead of the cache, as the cache may be too small to fit all joined groups
+		joinedMap := make(map[types.JID]*types.GroupInfo, len(joined))
+		for _, info := range joined {
+			joinedMap[info.JID] = info
+		}
+		stillMissing := missing[:0]
+		for _, jid := range missing {
+			if info, ok := joinedMap[jid]; ok {
+				infos[jid] = info
+			} else {
+				stillMissing = append(stillMissing, jid)
+			}
+		}
+		missing = stillMissing
+	}
+	for _, jid := range missing {
+		info, err := cli.getCachedGroupInfo(jid)
+		if errors.Is(err, ErrNotInGroup) || errors.Is(err, ErrGroupNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get info of %s: %w", jid, err)
+		}
+		infos[jid] = copyGroupInfo(info)
+	}
+	return infos, nil
+}
+
+// getCachedGroupInfos copies the cached info of the given groups into the output map and returns the groups
+// that aren't cached.
+func (cli *Client) getCachedGroupInfos(jids []types.JID, output map[types.JID]*types.GroupInfo) (missing []types.JID) {
+	cli.groupCacheLock.Lock()
+	defer cli.groupCacheLock.Unlock()
+	for _, jid := range jids {
+		if _, alreadyFound := output[jid]; alreadyFound {
+			continue
+		}
+		cached, ok := cli.groupCache[jid]
+		if ok && (cli.GroupInfoCacheTTL <= 0 || time.Since(cached.fetchedAt) < cli.GroupInfoCacheTTL) {
+			output[jid] = copyGroupInfo(cached.info)
+		} else {
+			missing = append(missing, jid)
+		}
+	}
+	return
+}
+
+func (cli *Client) getCachedGroupParticipant(group, user types.JID) (*types.GroupParticipant, error) {
+	info, err := cli.getCachedGroupInfo(group)
+	if err != nil {
+		return nil, err
+	}
+	user = user.ToNonAD()
+	for _, participant := range info.Participants {
+		if participant.JID.User == user.User {
+			return &participant, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsGroupAdmin checks if the given user is an admin (or the creator) of the given group.
+//
+// The group info is cached, so this is fast enough to be called for every incoming message.
+// The cache is kept up to date using group change notifications, and entries older than
+// Client.GroupInfoCacheTTL are refetched from the server.
+func (cli *Client) IsGroupAdmin(group, user types.JID) (bool, error) {
+	participant, err := cli.getCachedGroupParticipant(group, user)
+	if err != nil || participant == nil {
+		return false, err
+	}
+	return participant.IsAdmin || participant.IsSuperAdmin, nil
+}
+
+// IsGroupSuperAdmin checks if the given user is the super admin (creator) of the given group.
+//
+// This uses the same cache as IsGroupAdmin.
+func (cli *Client) IsGroupSuperAdmin(group, user types.JID) (bool, error) {
+	participant, err := cli.getCachedGroupParticipant(group, user)
+	if err != nil || participant == nil {
+		return false, err
+	}
+	return participant.IsSuperAdmin, nil
+}
+
+func hasUser(list []types.JID, user types.JID) bool {
+	for _, item := range list {
+		if item.User == user.User {
+			return true
+		}
+	}
+	return false
+}
+
+func hasParticipant(list []types.GroupParticipant, user types.JID) bool {
+	for _, item := range list {
+		if item.JID.User == user.User {
+			return true
+		}
+	}
+	return false
+}
+
+// updateGroupCache applies the changes in a group notification to the cached group info.
+func (cli *Client) updateGroupCache(evt interface{}) {
+	switch typedEvt := evt.(type) {
+	case *events.JoinedGroup:
+		cli.cacheGroupInfo(&typedEvt.GroupInfo)
+	case *events.GroupInfo:
+		cli.groupCacheLock.Lock()
+		defer cli.groupCacheLock.Unlock()
+		cached, ok := cli.groupCache[typedEvt.JID]
+		if !ok {
+			return
+		} else if len(typedEvt.PrevParticipantVersionID) > 0 && typedEvt.PrevParticipantVersionID != cached.info.ParticipantVersionID {
+			// We missed some changes, so just refetch the info next time it's needed
+			delete(cli.groupCache, typedEvt.JID)
+			return
+		} else if cli.Store.ID != nil && hasUser(typedEvt.Leave, *cli.Store.ID) {
+			delete(cli.groupCache, typedEvt.JID)
+			return
+		}
+		// Copy the info so that callers of getCachedGroupInfo don't see partial updates
+		info := copyGroupInfo(cached.info)
+		if typedEvt.Name != nil {
+			info.GroupName = *typedEvt.Name
+		}
+		if typedEvt.Topic != nil {
+			info.GroupTopic = *typedEvt.Topic
+		}
+		if typedEvt.Locked != nil {
+			info.GroupLocked = *typedEvt.Locked
+		}
+		if typedEvt.Announce != nil {
+			info.GroupAnnounce = *typedEvt.Announce
+		}
+		if typedEvt.Ephemeral != nil {
+			info.GroupEphemeral = *typedEvt.Ephemeral
+		}
+		if typedEvt.MembershipApprovalMode != nil {
+			info.GroupMembershipApprovalMode = *typedEvt.MembershipApprovalMode
+		}
+		if typedEvt.MemberAddMode != nil {
+			info.MemberAddMode = *typedEvt.MemberAddMode
+		}
+		if len(typedEvt.ParticipantVersionID) > 0 {
+			info.ParticipantVersionID = typedEvt.ParticipantVersionID
+		}
+		if len(typedEvt.Leave) > 0 {
+			participants := info.Participants[:0]
+			for _, participant := range info.Participants {
+				if !hasUser(typedEvt.Leave, participant.JID) {
+					participants = append(participants, participant)
+				}
+			}
+			info.Participants = participants
+		}
+		for _, joined := range typedEvt.Join {
+			if !hasUser(typedEvt.Leave, joined) && !hasParticipant(info.Participants, joined) {
+				info.Participants = append(info.Participants, types.GroupParticipant{JID: joined})
+			}
+		}
+		for i, participant := range info.Participants {
+			if hasUser(typedEvt.Promote, participant.JID) {
+				info.Participants[i].IsAdmin = true
+			} else if hasUser(typedEvt.Demote, participant.JID) {
+				info.Participants[i].IsAdmin = false
+				info.Participants[i].IsSuperAdmin = false
+			}
+		}
+		cached.info = info
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+var (
+	testGroupJID   = types.NewJID("123456789-123456", types.GroupServer)
+	testGroupAdmin = types.NewJID("2222222222", types.DefaultUserServer)
+	testGroupUser  = types.NewJID("3333333333", types.DefaultUserServer)
+)
+
+// fakeGroupInfoHandler responds to group info queries with a group where testGroupAdmin is the creator
+// and testGroupUser is a normal member, and counts the number of queries.
+func fakeGroupInfoHandler(fetches *int32, delay time.Duration) fakeServerHandler {
+	return func(node *waBinary.Node) []waBinary.Node {
+		if node.Tag != "iq" || node.AttrGetter().String("xmlns") != "w:g2" {
+			return nil
+		}
+		atomic.AddInt32(fetches, 1)
+		time.Sleep(delay)
+		return []waBinary.Node{fakeIQResult(node, waBinary.Node{
+			Tag: "group",
+			Attrs: waBinary.Attrs{
+				"id": testGroupJID.User, "subject": "Test", "s_t": "1600000000", "creation": "1600000000", "p_v_id": "1",
+			},
+			Content: []waBinary.Node{
+				{Tag: "participant", Attrs: waBinary.Attrs{"jid": testGroupAdmin, "type": "superadmin"}},
+				{Tag: "participant", Attrs: waBinary.Attrs{"jid": testGroupUser}},
+			},
+		})}
+	}
+}
+
+func newGroupCacheTestClient() *Client {
+	ownID := types.NewADJID("1111111111", 0, 1)
+	return NewClient(&store.Device{Log: waLog.Noop, ID: &ownID}, nil)
+}
+
+func TestGroupInfoCacheTTL(t *testing.T) {
+	cli := newGroupCacheTestClient()
+	var fetches int32
+	connectFakeServer(t, cli, fakeGroupInfoHandler(&fetches, 0))
+	expireCache := func() {
+		cli.groupCacheLock.Lock()
+		cli.groupCache[testGroupJID].fetchedAt = time.Now().Add(-time.Hour)
+		cli.groupCacheLock.Unlock()
+	}
+	checkAdmin := func(expectedFetches int32) {
+		t.Helper()
+		if isAdmin, err := cli.IsGroupAdmin(testGroupJID, testGroupAdmin); err != nil || !isAdmin {
+			t.Fatalf("Expected creator to be admin, got %t (error: %v)", isAdmin, err)
+		} else if fetched := atomic.LoadInt32(&fetches); fetched != expectedFetches {
+			t.Fatalf("Expected %d group info queries, got %d", expectedFetches, fetched)
+		}
+	}
+
+	checkAdmin(1)
+	checkAdmin(1)
+	expireCache()
+	checkAdmin(2)
+
+	for _, ttl := range []time.Duration{0, -time.Second} {
+		cli.GroupInfoCacheTTL = ttl
+		expireCache()
+		checkAdmin(2)
+	}
+}
+
+func TestGroupInfoCacheCoalescesFetches(t *testing.T) {
+	cli := newGroupCacheTestClient()
+	var fetches int32
+	connectFakeServer(t, cli, fakeGroupInfoHandler(&fetches, 100*time.Millisecond))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cli.IsGroupSuperAdmin(testGroupJID, testGroupAdmin); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Failed to check admin status: %v", err)
+	}
+	if fetched := atomic.LoadInt32(&fetches); fetched != 1 {
+		t.Errorf("Expected concurrent lookups to make one group info query, got %d", fetched)
+	}
+}
+
+func TestGroupInfoCacheNotifications(t *testing.T) {
+	// There's no connection, so any lookup that isn't served from the cache fails
+	cli := newGroupCacheTestClient()
+	cli.cacheGroupInfo(&types.GroupInfo{
+		JID:                  testGroupJID,
+		ParticipantVersionID: "1",
+		Participants: []types.GroupParticipant{
+			{JID: testGroupAdmin, IsAdmin: true, IsSuperAdmin: true},
+			{JID: testGroupUser},
+		},
+	})
+	checkAdmin := func(user types.JID, expectedAdmin, expectedSuperAdmin bool) {
+		t.Helper()
+		if isAdmin, err := cli.IsGroupAdmin(testGroupJID, user); err != nil || isAdmin != expectedAdmin {
+			t.Errorf("Expected admin status of %s to be %t, got %t (error: %v)", user, expectedAdmin, isAdmin, err)
+		}
+		if isSuperAdmin, err := cli.IsGroupSuperAdmin(testGroupJID, user); err != nil || isSuperAdmin != expectedSuperAdmin {
+			t.Errorf("Expected super admin status of %s to be %t, got %t (error: %v)", user, expectedSuperAdmin, isSuperAdmin, err)
+		}
+	}
+
+	cli.updateGroupCache(&events.GroupInfo{JID: testGroupJID, Promote: []types.JID{testGroupUser}, PrevParticipantVersionID: "1", ParticipantVersionID: "2"})
+	checkAdmin(testGroupUser, true, false)
+	cli.updateGroupCache(&events.GroupInfo{JID: testGroupJID, Demote: []types.JID{testGroupAdmin}, PrevParticipantVersionID: "2", ParticipantVersionID: "3"})
+	checkAdmin(testGroupAdmin, false, false)
+	cli.updateGroupCache(&events.GroupInfo{JID: testGroupJID, Leave: []types.JID{testGroupUser}, PrevParticipantVersionID: "3", ParticipantVersionID: "4"})
+	checkAdmin(testGroupUser, false, false)
+
+	// A notification that doesn't follow the cached version means some changes were missed
+	cli.updateGroupCache(&events.GroupInfo{JID: testGroupJID, Promote: []types.JID{testGroupAdmin}, PrevParticipantVersionID: "5", ParticipantVersionID: "6"})
+	cli.groupCacheLock.Lock()
+	_, stillCached := cli.groupCache[testGroupJID]
+	cli.groupCacheLock.Unlock()
+	if stillCached {
+		t.Errorf("Expected group info to be removed from the cache after missing changes")
+	}
+}
+
+func TestGroupInfoCacheSize(t *testing.T) {
+	cli := newGroupCacheTestClient()
+	cli.GroupInfoCacheSize = 2
+	first := types.NewJID("111111111-111111", types.GroupServer)
+	second := types.NewJID("222222222-222222", types.GroupServer)
+	third := types.NewJID("333333333-333333", types.GroupServer)
+	cli.cacheGroupInfo(&types.GroupInfo{JID: first})
+	cli.cacheGroupInfo(&types.GroupInfo{JID: second})
+	cli.groupCacheLock.Lock()
+	cli.groupCache[second].fetchedAt = time.Now().Add(-time.Minute)
+	cli.groupCacheLock.Unlock()
+	// Recaching an existing group must not evict anything
+	cli.cacheGroupInfo(&types.GroupInfo{JID: first})
+	cli.cacheGroupInfo(&types.GroupInfo{JID: third})
+
+	cli.groupCacheLock.Lock()
+	defer cli.groupCacheLock.Unlock()
+	if len(cli.groupCache) != 2 {
+		t.Fatalf("Expected 2 cached groups, got %d", len(cli.groupCache))
+	} else if _, ok := cli.groupCache[second]; ok {
+		t.Errorf("Expected the group fetched longest ago to be evicted")
+	} else if _, ok = cli.groupCache[first]; !ok {
+		t.Errorf("Expected recently fetched group to stay in the cache")
+	}
+}
+
+func TestGetGroupInfosLargerThanCache(t *testing.T) {
+	cli := newGroupCacheTestClient()
+	cli.GroupInfoCacheSize = 1
+	first := types.NewJID("111111111", types.GroupServer)
+	second := types.NewJID("222222222", types.GroupServer)
+	var fetches int32
+	connectFakeServer(t, cli, func(node *waBinary.Node) []waBinary.Node {
+		if node.Tag != "iq" || node.AttrGetter().String("xmlns") != "w:g2" {
+			return nil
+		}
+		atomic.AddInt32(&fetches, 1)
+		groups := make([]waBinary.Node, 0, 2)
+		for _, jid := range []types.JID{first, second} {
+			groups = append(groups, waBinary.Node{
+				Tag:   "group",
+				Attrs: waBinary.Attrs{"id": jid.User, "subject": "Test", "s_t": "1600000000", "creation": "1600000000"},
+			})
+		}
+		return []waBinary.Node{fakeIQResult(node, waBinary.Node{Tag: "groups", Content: groups})}
+	})
+
+	infos, err := cli.GetGroupInfos([]types.JID{first, second})
+	if err != nil {
+		t.Fatalf("Failed to get group infos: %v", err)
+	} else if len(infos) != 2 || infos[first] == nil || infos[second] == nil {
+		t.Fatalf("Unexpected group infos %v", infos)
+	} else if fetched := atomic.LoadInt32(&fetches); fetched != 1 {
+		t.Errorf("Expected a single joined groups query, got %d queries", fetched)
+	}
+}
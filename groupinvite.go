@@ -0,0 +1,60 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// BuildGroupInvite builds a group invite message, which can be used to invite users who can't be added to the group
+// directly because of their privacy settings. The code and expiration are returned by UpdateGroupParticipants in
+// ParticipantChangeResult when adding the user fails with ErrParticipantRequiresInvite.
+func BuildGroupInvite(group types.JID, groupName, code string, expiration time.Time, caption string) *waProto.Message {
+	return &waProto.Message{GroupInviteMessage: &waProto.GroupInviteMessage{
+		GroupJid:         proto.String(group.String()),
+		InviteCode:       proto.String(code),
+		InviteExpiration: proto.Int64(expiration.Unix()),
+		GroupName:        proto.String(groupName),
+		Caption:          proto.String(caption),
+	}}
+}
+
+// SendGroupInvite sends a group invite message to the user in the given participant change result.
+// This should be used after UpdateGroupParticipants returns ErrParticipantRequiresInvite for a user.
+//
+// The user can accept the invite with JoinGroupWithInvite (or AcceptGroupInvite).
+func (cli *Client) SendGroupInvite(ctx context.Context, group types.JID, groupName string, result ParticipantChangeResult, caption string) (SendResponse, error) {
+	if len(result.InviteCode) == 0 {
+		return SendResponse{}, ErrNoInviteCode
+	}
+	return cli.SendMessageContext(ctx, result.JID, "", BuildGroupInvite(group, groupName, result.InviteCode, result.InviteExpiration, caption))
+}
+
+// AcceptGroupInvite joins the group in a received group invite message using JoinGroupWithInvite and
+// returns the group JID. The inviter is the sender of the message.
+func (cli *Client) AcceptGroupInvite(inviter types.JID, invite *waProto.GroupInviteMessage) (types.JID, error) {
+	group, err := types.ParseJID(invite.GetGroupJid())
+	if err != nil {
+		return types.EmptyJID, fmt.Errorf("failed to parse group JID in invite: %w", err)
+	} else if group.Server != types.GroupServer {
+		return types.EmptyJID, fmt.Errorf("unexpected group JID %s in invite", group)
+	} else if expiration := invite.GetInviteExpiration(); expiration > 0 && time.Unix(expiration, 0).Before(time.Now()) {
+		return types.EmptyJID, ErrGroupInviteExpired
+	}
+	err = cli.JoinGroupWithInvite(group, inviter.ToNonAD(), invite.GetInviteCode(), invite.GetInviteExpiration())
+	if err != nil {
+		return types.EmptyJID, err
+	}
+	return group, nil
+}
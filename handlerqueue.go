@@ -0,0 +1,281 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// HandlerQueueOverflowPolicy specifies what to do with incoming nodes when the handler queue is full.
+type HandlerQueueOverflowPolicy string
+
+const (
+	// HandlerQueueOverflowSpawn starts a new goroutine for each node that doesn't fit in the queue.
+	// Nothing is lost, but message ordering is no longer guaranteed. This is the default.
+	HandlerQueueOverflowSpawn HandlerQueueOverflowPolicy = "spawn"
+	// HandlerQueueOverflowBlock stops reading from the websocket until there's space in the queue.
+	// Ordering is preserved, but a slow handler will delay everything else, including responses to requests.
+	HandlerQueueOverflowBlock HandlerQueueOverflowPolicy = "block"
+	// HandlerQueueOverflowDropOldest discards the oldest node in the queue to make space for the new one.
+	// Discarded nodes are never handled (or acknowledged), so the server will usually send them again later.
+	HandlerQueueOverflowDropOldest HandlerQueueOverflowPolicy = "drop-oldest"
+	// HandlerQueueOverflowSpillToDisk writes nodes that don't fit in the queue to a temporary file and
+	// feeds them back to the queue in order once there's space. Spilled nodes that haven't been moved back to
+	// the queue are discarded (and the file is deleted) when Client.Disconnect is called, in which case they
+	// weren't acknowledged, so the server will usually send them again after reconnecting.
+	HandlerQueueOverflowSpillToDisk HandlerQueueOverflowPolicy = "spill-to-disk"
+)
+
+// ClientOptions contains settings for NewClientWithOptions that can't be changed after the client is created.
+type ClientOptions struct {
+	// HandlerQueueSize is the size of the buffer for incoming nodes. Defaults to 2048.
+	HandlerQueueSize int
+	// HandlerQueueOverflow specifies what to do when the handler queue is full. Defaults to HandlerQueueOverflowSpawn.
+	HandlerQueueOverflow HandlerQueueOverflowPolicy
+	// HandlerQueueSpillDir is the directory for the temporary file used by HandlerQueueOverflowSpillToDisk.
+	// Defaults to os.TempDir().
+	HandlerQueueSpillDir string
+}
+
+// spillQueue is a first-in-first-out queue of serialized nodes stored in a temporary file.
+// The file is created when the first node is spilled and deleted when the queue is drained or cleared.
+type spillQueue struct {
+	dir  string
+	lock sync.Mutex
+
+	file        *os.File
+	readOffset  int64
+	writeOffset int64
+	pending     int
+	// drainStop is closed when the queue is reset to tell the drain goroutine to stop.
+	// It's nil if there's no drain goroutine running.
+	drainStop chan struct{}
+}
+
+// push appends the given node to the end of the queue. If a goroutine should be started to drain the queue,
+// the channel that the goroutine must pass to peek and pop is returned.
+func (sq *spillQueue) push(node *waBinary.Node) (startDrain chan struct{}, err error) {
+	data, err := waBinary.Marshal(*node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal node: %w", err)
+	}
+	sq.lock.Lock()
+	defer sq.lock.Unlock()
+	if sq.file == nil {
+		sq.file, err = os.CreateTemp(sq.dir, "whatsmeow-handler-queue-*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create spill file: %w", err)
+		}
+	}
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+	_, err = sq.file.WriteAt(record, sq.writeOffset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to spill file: %w", err)
+	}
+	sq.writeOffset += int64(len(record))
+	sq.pending++
+	if sq.drainStop == nil {
+		sq.drainStop = make(chan struct{})
+		startDrain = sq.drainStop
+	}
+	return
+}
+
+func (sq *spillQueue) isEmpty() bool {
+	sq.lock.Lock()
+	defer sq.lock.Unlock()
+	return sq.pending == 0
+}
+
+// resetLocked deletes the spill file and everything in it, and stops the drain goroutine if it's running.
+// The lock must be held when calling this.
+func (sq *spillQueue) resetLocked() {
+	if sq.file != nil {
+		_ = sq.file.Close()
+		_ = os.Remove(sq.file.Name())
+		sq.file = nil
+	}
+	sq.readOffset, sq.writeOffset = 0, 0
+	sq.pending = 0
+	if sq.drainStop != nil {
+		close(sq.drainStop)
+		sq.drainStop = nil
+	}
+}
+
+// clear discards all spilled nodes and returns the number of nodes that were discarded.
+func (sq *spillQueue) clear() int {
+	sq.lock.Lock()
+	defer sq.lock.Unlock()
+	discarded := sq.pending
+	sq.resetLocked()
+	return discarded
+}
+
+// peek reads the node at the start of the queue without removing it. The stop channel must be the one returned by
+// push when the drain goroutine was started: if the queue has been reset since then, nil is returned.
+//
+// If the queue is empty, the spill file is deleted and nil is returned. If the next record can't be read completely
+// (including truncated records, which mean the file is corrupted), the whole queue is discarded and a zero size is
+// returned with the error. If the record was read but doesn't contain a valid node, the size of the record is returned
+// with the error so that the caller can skip it.
+func (sq *spillQueue) peek(stop chan struct{}) (*waBinary.Node, int64, error) {
+	sq.lock.Lock()
+	defer sq.lock.Unlock()
+	if sq.drainStop != stop {
+		return nil, 0, nil
+	} else if sq.pending == 0 {
+		sq.resetLocked()
+		return nil, 0, nil
+	}
+	var lengthBytes [4]byte
+	_, err := sq.file.ReadAt(lengthBytes[:], sq.readOffset)
+	if err != nil {
+		return nil, 0, sq.discardLocked(err)
+	}
+	size := 4 + int64(binary.BigEndian.Uint32(lengthBytes[:]))
+	if sq.readOffset+size > sq.writeOffset {
+		return nil, 0, sq.discardLocked(io.ErrUnexpectedEOF)
+	}
+	data := make([]byte, size-4)
+	_, err = sq.file.ReadAt(data, sq.readOffset+4)
+	if err != nil {
+		return nil, 0, sq.discardLocked(err)
+	}
+	data, err = waBinary.Unpack(data)
+	if err != nil {
+		return nil, size, fmt.Errorf("failed to unpack spilled node: %w", err)
+	}
+	node, err := waBinary.Unmarshal(data)
+	if err != nil {
+		return nil, size, fmt.Errorf("failed to unmarshal spilled node: %w", err)
+	}
+	return node, size, nil
+}
+
+// discardLocked deletes the spill file after a read error and returns an error describing what was lost.
+// The lock must be held when calling this.
+func (sq *spillQueue) discardLocked(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	discarded := sq.pending
+	sq.resetLocked()
+	return fmt.Errorf("failed to read spill file (discarded %d nodes): %w", discarded, err)
+}
+
+// pop removes the node that was returned by peek, unless the queue has been reset since then.
+func (sq *spillQueue) pop(stop chan struct{}, size int64) {
+	sq.lock.Lock()
+	if sq.drainStop == stop {
+		sq.readOffset += size
+		sq.pending--
+	}
+	sq.lock.Unlock()
+}
+
+// enqueueNode adds an incoming node to the handler queue, handling overflows according to the configured policy.
+// This is only called from the websocket read loop, so it doesn't need to be safe for concurrent use.
+func (cli *Client) enqueueNode(node *waBinary.Node) {
+	if cli.handlerQueueSpill != nil && !cli.handlerQueueSpill.isEmpty() {
+		// There are already spilled nodes waiting, so this one has to wait too to keep the order.
+		cli.spillNode(node)
+		return
+	}
+	select {
+	case cli.handlerQueue <- node:
+		cli.handlerQueueOverflowing = false
+		return
+	default:
+	}
+	if !cli.handlerQueueOverflowing {
+		cli.handlerQueueOverflowing = true
+		go cli.dispatchEvent(&events.HandlerQueueOverflow{
+			QueueSize: cap(cli.handlerQueue),
+			Policy:    string(cli.handlerQueueOverflow),
+		})
+	}
+	switch cli.handlerQueueOverflow {
+	case HandlerQueueOverflowBlock:
+		cli.Log.Warnf("Handler queue is full, waiting for space before reading more data")
+		cli.handlerQueue <- node
+	case HandlerQueueOverflowDropOldest:
+		for {
+			select {
+			case dropped := <-cli.handlerQueue:
+				cli.Log.Warnf("Handler queue is full, dropped oldest %s node (ID: %s)", dropped.Tag, dropped.AttrGetter().OptionalString("id"))
+			default:
+			}
+			select {
+			case cli.handlerQueue <- node:
+				return
+			default:
+			}
+		}
+	case HandlerQueueOverflowSpillToDisk:
+		cli.Log.Warnf("Handler queue is full, spilling nodes to disk")
+		cli.spillNode(node)
+	default:
+		cli.Log.Warnf("Handler queue is full, message ordering is no longer guaranteed")
+		go func() {
+			cli.handlerQueue <- node
+		}()
+	}
+}
+
+func (cli *Client) spillNode(node *waBinary.Node) {
+	startDrain, err := cli.handlerQueueSpill.push(node)
+	if err != nil {
+		cli.Log.Errorf("Failed to spill %s node to disk, waiting for space in handler queue instead: %v", node.Tag, err)
+		cli.handlerQueue <- node
+	} else if startDrain != nil {
+		go cli.drainSpilledNodes(startDrain)
+	}
+}
+
+// drainSpilledNodes moves spilled nodes back to the handler queue in order until the spill queue is empty
+// or the stop channel is closed.
+func (cli *Client) drainSpilledNodes(stop chan struct{}) {
+	for {
+		node, size, err := cli.handlerQueueSpill.peek(stop)
+		if err != nil {
+			cli.Log.Errorf("Failed to read spilled node: %v", err)
+			if size == 0 {
+				// The record couldn't be read, so the queue was discarded
+				return
+			}
+		} else if node == nil {
+			return
+		} else {
+			select {
+			case cli.handlerQueue <- node:
+			case <-stop:
+				return
+			}
+		}
+		cli.handlerQueueSpill.pop(stop, size)
+	}
+}
+
+// clearSpilledNodes deletes the handler queue spill file, discarding any nodes that haven't been handled yet.
+func (cli *Client) clearSpilledNodes() {
+	if cli.handlerQueueSpill == nil {
+		return
+	}
+	if discarded := cli.handlerQueueSpill.clear(); discarded > 0 {
+		cli.Log.Warnf("Discarded %d spilled nodes from handler queue", discarded)
+	}
+}
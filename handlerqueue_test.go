@@ -0,0 +1,130 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestHandlerQueueSpillToDisk(t *testing.T) {
+	dir := t.TempDir()
+	cli := NewClientWithOptions(&store.Device{}, nil, ClientOptions{
+		HandlerQueueSize:     2,
+		HandlerQueueOverflow: HandlerQueueOverflowSpillToDisk,
+		HandlerQueueSpillDir: dir,
+	})
+	const count = 20
+	for i := 0; i < count; i++ {
+		cli.enqueueNode(&waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": strconv.Itoa(i)}})
+	}
+	for i := 0; i < count; i++ {
+		select {
+		case node := <-cli.handlerQueue:
+			if id := node.AttrGetter().String("id"); id != strconv.Itoa(i) {
+				t.Fatalf("Expected node #%d, got #%s", i, id)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for node #%d", i)
+		}
+	}
+	// The drain goroutine deletes the file once it notices the queue is empty
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("Failed to read spill dir: %v", err)
+		} else if len(files) == 0 {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("Spill file wasn't deleted after draining")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSpillQueueDiscardsOnReadError(t *testing.T) {
+	for _, name := range []string{"truncated", "closed"} {
+		t.Run(name, func(t *testing.T) {
+			sq := &spillQueue{dir: t.TempDir()}
+			var stop chan struct{}
+			for i := 0; i < 2; i++ {
+				startDrain, err := sq.push(&waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": strconv.Itoa(i)}})
+				if err != nil {
+					t.Fatalf("Failed to push node: %v", err)
+				} else if startDrain != nil {
+					stop = startDrain
+				}
+			}
+			fileName := sq.file.Name()
+			if name == "truncated" {
+				if err := sq.file.Truncate(sq.writeOffset - 1); err != nil {
+					t.Fatalf("Failed to truncate spill file: %v", err)
+				}
+				// Skip the first record so the truncated one is read next
+				_, size, err := sq.peek(stop)
+				if err != nil {
+					t.Fatalf("Failed to read first record: %v", err)
+				}
+				sq.pop(stop, size)
+			} else {
+				_ = sq.file.Close()
+			}
+			node, size, err := sq.peek(stop)
+			if err == nil || node != nil || size != 0 {
+				t.Fatalf("Expected read error with zero size, got node=%v size=%d err=%v", node, size, err)
+			}
+			if !sq.isEmpty() || sq.drainStop != nil || sq.file != nil {
+				t.Fatalf("Spill queue wasn't reset after read error")
+			}
+			if _, err = os.Stat(fileName); !os.IsNotExist(err) {
+				t.Fatalf("Spill file wasn't deleted after read error")
+			}
+		})
+	}
+}
+
+func TestSpillQueueClearedOnDisconnect(t *testing.T) {
+	dir := t.TempDir()
+	cli := NewClientWithOptions(&store.Device{}, nil, ClientOptions{
+		HandlerQueueSize:     1,
+		HandlerQueueOverflow: HandlerQueueOverflowSpillToDisk,
+		HandlerQueueSpillDir: dir,
+	})
+	for i := 0; i < 5; i++ {
+		cli.enqueueNode(&waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": strconv.Itoa(i)}})
+	}
+	if files, err := os.ReadDir(dir); err != nil || len(files) != 1 {
+		t.Fatalf("Expected one spill file before disconnecting, got %d (error: %v)", len(files), err)
+	}
+	cli.handlerQueueSpill.lock.Lock()
+	stop := cli.handlerQueueSpill.drainStop
+	cli.handlerQueueSpill.lock.Unlock()
+
+	cli.Disconnect()
+	if files, err := os.ReadDir(dir); err != nil || len(files) != 0 {
+		t.Fatalf("Expected spill file to be deleted after disconnecting, got %d files (error: %v)", len(files), err)
+	} else if !cli.handlerQueueSpill.isEmpty() {
+		t.Fatalf("Spill queue wasn't cleared after disconnecting")
+	}
+	select {
+	case <-stop:
+	default:
+		t.Fatalf("Drain goroutine wasn't told to stop")
+	}
+	// Only the node that fit in the queue before spilling started should be there, the drain goroutine
+	// must not move any more nodes after the queue was cleared.
+	time.Sleep(50 * time.Millisecond)
+	if len(cli.handlerQueue) != 1 {
+		t.Errorf("Expected 1 node in handler queue, got %d", len(cli.handlerQueue))
+	}
+}
@@ -8,6 +8,7 @@ package whatsmeow
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
@@ -18,10 +19,13 @@ import (
 	"go.mau.fi/whatsmeow/util/keys"
 )
 
+// NoiseHandshakeResponseTimeout is the default value for Client.HandshakeTimeout.
 const NoiseHandshakeResponseTimeout = 20 * time.Second
 
 // doHandshake implements the Noise_XX_25519_AESGCM_SHA256 handshake for the WhatsApp web API.
-func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair) error {
+//
+// The handshake is aborted if the given context is canceled before the server responds.
+func (cli *Client) doHandshake(ctx context.Context, fs *socket.FrameSocket, ephemeralKP keys.KeyPair) error {
 	nh := socket.NewNoiseHandshake()
 	nh.Start(socket.NoiseStartPattern, fs.Header)
 	nh.Authenticate(ephemeralKP.Pub[:])
@@ -40,8 +44,8 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	var resp []byte
 	select {
 	case resp = <-fs.Frames:
-	case <-time.After(NoiseHandshakeResponseTimeout):
-		return fmt.Errorf("timed out waiting for handshake response")
+	case <-ctx.Done():
+		return fmt.Errorf("timed out waiting for handshake response: %w", ctx.Err())
 	}
 	var handshakeResponse waProto.HandshakeMessage
 	err = proto.Unmarshal(resp, &handshakeResponse)
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ctxinfoutil contains a helper for finding the ContextInfo of message content, shared by the whatsmeow
+// package and the Message event.
+package ctxinfoutil
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+const contextInfoFieldName protoreflect.Name = "contextInfo"
+
+// Find finds the ContextInfo of the content in the given message.
+// If create is true, the ContextInfo is created if it doesn't exist, and plain text messages are converted into
+// extended text messages, as the Conversation field can't have a ContextInfo.
+func Find(msg *waProto.Message, create bool) *waProto.ContextInfo {
+	if msg == nil {
+		return nil
+	}
+	if create && msg.Conversation != nil {
+		msg.ExtendedTextMessage = &waProto.ExtendedTextMessage{Text: msg.Conversation}
+		msg.Conversation = nil
+	}
+	var ctxInfo *waProto.ContextInfo
+	msg.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		content := value.Message()
+		if wrapper, ok := content.Interface().(*waProto.FutureProofMessage); ok {
+			ctxInfo = Find(wrapper.GetMessage(), create)
+			return ctxInfo == nil
+		}
+		ctxField := content.Descriptor().Fields().ByName(contextInfoFieldName)
+		if ctxField == nil || ctxField.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		if create {
+			ctxInfo = content.Mutable(ctxField).Message().Interface().(*waProto.ContextInfo)
+		} else if content.Has(ctxField) {
+			ctxInfo = content.Get(ctxField).Message().Interface().(*waProto.ContextInfo)
+		}
+		return ctxInfo == nil
+	})
+	return ctxInfo
+}
@@ -9,12 +9,15 @@ package whatsmeow
 import (
 	"context"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
 	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
 )
 
+// These are the default values for the keepalive fields in Client. Changing them only affects clients created afterwards.
 var (
 	// KeepAliveResponseDeadline specifies the duration to wait for a response to websocket keepalive pings.
 	KeepAliveResponseDeadline = 10 * time.Second
@@ -22,15 +25,49 @@ var (
 	KeepAliveIntervalMin = 20 * time.Second
 	// KeepAliveIntervalMax specifies the maximum interval for websocket keepalive pings.
 	KeepAliveIntervalMax = 30 * time.Second
+	// KeepAliveMaxMissed specifies how many keepalive pings can time out in a row before the websocket is reconnected.
+	// Zero means the websocket is never reconnected because of keepalive timeouts.
+	KeepAliveMaxMissed = 0
 )
 
+func (cli *Client) keepAliveInterval() time.Duration {
+	min, max := cli.KeepAliveIntervalMin, cli.KeepAliveIntervalMax
+	if max <= min {
+		return min
+	}
+	return time.Duration(rand.Int63n(int64(max-min))) + min
+}
+
 func (cli *Client) keepAliveLoop(ctx context.Context) {
+	lastSuccess := time.Now()
+	var errorCount int
 	for {
-		interval := rand.Int63n(KeepAliveIntervalMax.Milliseconds()-KeepAliveIntervalMin.Milliseconds()) + KeepAliveIntervalMin.Milliseconds()
 		select {
-		case <-time.After(time.Duration(interval) * time.Millisecond):
-			if !cli.sendKeepAlive(ctx) {
+		case <-time.After(cli.keepAliveInterval()):
+			isSuccess, shouldContinue := cli.sendKeepAlive(ctx)
+			if !shouldContinue {
 				return
+			} else if !isSuccess {
+				errorCount++
+				go cli.dispatchEvent(&events.KeepAliveTimeout{
+					ErrorCount:  errorCount,
+					LastSuccess: lastSuccess,
+				})
+				if cli.KeepAliveMaxMissed > 0 && errorCount >= cli.KeepAliveMaxMissed {
+					cli.Log.Warnf("%d keepalive pings timed out in a row, reconnecting", errorCount)
+					atomic.StoreUint32(&cli.isAutoReconnecting, 1)
+					cli.Disconnect()
+					go cli.dispatchEvent(&events.Disconnected{})
+					go cli.autoReconnect()
+					return
+				}
+			} else {
+				if errorCount > 0 {
+					cli.Log.Infof("Keepalive restored after %d timeouts", errorCount)
+					go cli.dispatchEvent(&events.KeepAliveRestored{})
+				}
+				errorCount = 0
+				lastSuccess = time.Now()
 			}
 		case <-ctx.Done():
 			return
@@ -38,8 +75,11 @@ func (cli *Client) keepAliveLoop(ctx context.Context) {
 	}
 }
 
-func (cli *Client) sendKeepAlive(ctx context.Context) bool {
+func (cli *Client) sendKeepAlive(ctx context.Context) (isSuccess, shouldContinue bool) {
+	reqID := cli.generateRequestID()
+	start := time.Now()
 	respCh, err := cli.sendIQAsync(infoQuery{
+		ID:        reqID,
 		Namespace: "w:p",
 		Type:      "get",
 		To:        types.ServerJID,
@@ -47,16 +87,22 @@ func (cli *Client) sendKeepAlive(ctx context.Context) bool {
 	})
 	if err != nil {
 		cli.Log.Warnf("Failed to send keepalive: %v", err)
-		return true
+		return false, true
 	}
 	select {
-	case <-respCh:
+	case resp := <-respCh:
+		if resp == closedNode {
+			// The keepalive loop will be stopped and restarted with the connection
+			return false, false
+		}
 		// All good
-	case <-time.After(KeepAliveResponseDeadline):
-		// TODO disconnect websocket?
+		cli.recordKeepAliveRTT(time.Since(start))
+		return true, true
+	case <-time.After(cli.KeepAliveResponseDeadline):
 		cli.Log.Warnf("Keepalive timed out")
+		cli.cancelResponse(reqID)
+		return false, true
 	case <-ctx.Done():
-		return false
+		return false, false
 	}
-	return true
 }
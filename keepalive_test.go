@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// newKeepAliveTestClient creates a client connected to a fake server that answers keepalive pings
+// only while respond is non-zero. All events are sent to the returned channel.
+func newKeepAliveTestClient(t *testing.T, respond *int32) (*Client, <-chan interface{}) {
+	ownID := types.NewADJID("1111111111", 0, 1)
+	cli := NewClient(&store.Device{Log: waLog.Noop, ID: &ownID}, nil)
+	cli.EnableAutoReconnect = false
+	cli.KeepAliveIntervalMin = 10 * time.Millisecond
+	cli.KeepAliveIntervalMax = 20 * time.Millisecond
+	cli.KeepAliveResponseDeadline = 50 * time.Millisecond
+	evts := make(chan interface{}, 32)
+	cli.AddEventHandler(func(evt interface{}) {
+		switch evt.(type) {
+		case *events.KeepAliveTimeout, *events.KeepAliveRestored, *events.Disconnected:
+			evts <- evt
+		}
+	})
+	connectFakeServer(t, cli, func(node *waBinary.Node) []waBinary.Node {
+		if node.Tag != "iq" || node.AttrGetter().String("xmlns") != "w:p" || atomic.LoadInt32(respond) == 0 {
+			return nil
+		}
+		return []waBinary.Node{fakeIQResult(node)}
+	})
+	return cli, evts
+}
+
+func waitKeepAliveEvent(t *testing.T, evts <-chan interface{}) interface{} {
+	t.Helper()
+	select {
+	case evt := <-evts:
+		return evt
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for keepalive event")
+		return nil
+	}
+}
+
+func TestKeepAliveTimeoutAndRestore(t *testing.T) {
+	var respond int32
+	cli, evts := newKeepAliveTestClient(t, &respond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cli.keepAliveLoop(ctx)
+
+	for i := 1; i <= 2; i++ {
+		evt, ok := waitKeepAliveEvent(t, evts).(*events.KeepAliveTimeout)
+		if !ok {
+			t.Fatalf("Expected keepalive timeout event, got %T", evt)
+		} else if evt.ErrorCount != i {
+			t.Errorf("Expected error count %d, got %d", i, evt.ErrorCount)
+		}
+	}
+	atomic.StoreInt32(&respond, 1)
+	for {
+		evt := waitKeepAliveEvent(t, evts)
+		if _, ok := evt.(*events.KeepAliveRestored); ok {
+			break
+		} else if _, ok = evt.(*events.KeepAliveTimeout); !ok {
+			t.Fatalf("Expected keepalive restored event, got %T", evt)
+		}
+	}
+	if !cli.IsConnected() {
+		t.Errorf("Expected client to stay connected when KeepAliveMaxMissed is zero")
+	}
+}
+
+func TestKeepAliveMaxMissed(t *testing.T) {
+	var respond int32
+	cli, evts := newKeepAliveTestClient(t, &respond)
+	cli.KeepAliveMaxMissed = 2
+	done := make(chan struct{})
+	go func() {
+		cli.keepAliveLoop(context.Background())
+		close(done)
+	}()
+
+	var timeouts int
+	for {
+		evt := waitKeepAliveEvent(t, evts)
+		if _, ok := evt.(*events.KeepAliveTimeout); ok {
+			timeouts++
+		} else if _, ok = evt.(*events.Disconnected); ok {
+			break
+		} else {
+			t.Fatalf("Unexpected event %T", evt)
+		}
+	}
+	if timeouts != 2 {
+		t.Errorf("Expected 2 keepalive timeouts before disconnecting, got %d", timeouts)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Keepalive loop didn't stop after disconnecting")
+	}
+	if cli.IsConnected() {
+		t.Errorf("Expected client to be disconnected after too many keepalive timeouts")
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+// LinkPreviewUserAgent is the user agent used when fetching pages for link previews.
+// Many sites serve Open Graph tags specifically to the WhatsApp user agent.
+var LinkPreviewUserAgent = "WhatsApp/2"
+
+const (
+	linkPreviewMaxPageSize  = 512 * 1024
+	linkPreviewMaxImageSize = 5 * 1024 * 1024
+	// The size of the thumbnail that is included inline in the message.
+	linkPreviewInlineThumbnailSize = 140
+	// The size of the high-quality thumbnail that is uploaded to the media servers.
+	linkPreviewUploadedThumbnailSize = 600
+)
+
+var (
+	linkPreviewURLRegex = regexp.MustCompile(`https?://[^\s<>"]+`)
+	htmlMetaTagRegex    = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	htmlAttrRegex       = regexp.MustCompile(`(?is)([a-z:-]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	htmlTitleRegex      = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlHeadEndRegex    = regexp.MustCompile(`(?i)</head>`)
+	trailingPunctuation = ".,:;!?)]}'\""
+)
+
+// LinkPreview contains the metadata of a web page that is shown in link previews.
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+}
+
+// findPreviewURL finds the first link in the given text.
+func findPreviewURL(text string) string {
+	match := linkPreviewURLRegex.FindString(text)
+	return strings.TrimRight(match, trailingPunctuation)
+}
+
+// parseLinkPreview extracts the Open Graph metadata (or the plain title and description as a fallback) from a HTML page.
+func parseLinkPreview(pageURL *url.URL, page []byte) *LinkPreview {
+	if loc := htmlHeadEndRegex.FindIndex(page); loc != nil {
+		page = page[:loc[0]]
+	}
+	meta := make(map[string]string)
+	for _, tag := range htmlMetaTagRegex.FindAll(page, -1) {
+		attrs := make(map[string]string)
+		for _, attr := range htmlAttrRegex.FindAllSubmatch(tag, -1) {
+			value := attr[2]
+			if value == nil {
+				value = attr[3]
+			}
+			attrs[strings.ToLower(string(attr[1]))] = html.UnescapeString(string(value))
+		}
+		key := attrs["property"]
+		if len(key) == 0 {
+			key = attrs["name"]
+		}
+		key = strings.ToLower(key)
+		if _, alreadySet := meta[key]; len(key) > 0 && !alreadySet {
+			meta[key] = strings.TrimSpace(attrs["content"])
+		}
+	}
+	preview := &LinkPreview{
+		URL:         meta["og:url"],
+		Title:       meta["og:title"],
+		Description: meta["og:description"],
+		ImageURL:    meta["og:image"],
+	}
+	if len(preview.Title) == 0 {
+		if match := htmlTitleRegex.FindSubmatch(page); match != nil {
+			preview.Title = strings.TrimSpace(html.UnescapeString(string(match[1])))
+		}
+	}
+	if len(preview.Description) == 0 {
+		preview.Description = meta["description"]
+	}
+	if len(preview.URL) == 0 {
+		preview.URL = pageURL.String()
+	}
+	if len(preview.ImageURL) > 0 {
+		if imageURL, err := pageURL.Parse(preview.ImageURL); err == nil {
+			preview.ImageURL = imageURL.String()
+		}
+	}
+	return preview
+}
+
+func (cli *Client) fetchForLinkPreview(ctx context.Context, targetURL string, maxSize int64) ([]byte, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prepare request: %w", err)
+	}
+	req.Header.Set("User-Agent", LinkPreviewUserAgent)
+	resp, err := cli.http.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize))
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to read response: %w", err)
+	}
+	return data, resp, nil
+}
+
+// GetLinkPreview fetches the given web page and extracts the metadata used in link previews.
+//
+// The page is fetched using the same HTTP client as media uploads, so it goes through the proxy set with SetProxy.
+func (cli *Client) GetLinkPreview(ctx context.Context, pageURL string) (*LinkPreview, error) {
+	page, resp, err := cli.fetchForLinkPreview(ctx, pageURL, linkPreviewMaxPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch page: %w", err)
+	}
+	if mimeType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mimeType != "text/html" && mimeType != "application/xhtml+xml" {
+		return nil, fmt.Errorf("%w: page is %s, not HTML", ErrLinkPreviewNotAvailable, mimeType)
+	}
+	preview := parseLinkPreview(resp.Request.URL, page)
+	if len(preview.Title) == 0 {
+		return nil, fmt.Errorf("%w: page doesn't have a title", ErrLinkPreviewNotAvailable)
+	}
+	return preview, nil
+}
+
+// scaleImage scales the given image to fit in a square of the given size using nearest-neighbor sampling.
+// Images that already fit are returned as-is.
+func scaleImage(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return img
+	}
+	newWidth, newHeight := maxSize, maxSize
+	if width > height {
+		newHeight = height * maxSize / width
+	} else {
+		newWidth = width * maxSize / height
+	}
+	if newWidth < 1 {
+		newWidth = 1
+	}
+	if newHeight < 1 {
+		newHeight = 1
+	}
+	scaled := image.NewRGBA(image.Rect(0, 0, newWidth, newHeight))
+	for y := 0; y < newHeight; y++ {
+		for x := 0; x < newWidth; x++ {
+			scaled.Set(x, y, img.At(bounds.Min.X+x*width/newWidth, bounds.Min.Y+y*height/newHeight))
+		}
+	}
+	return scaled
+}
+
+func encodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80})
+	return buf.Bytes(), err
+}
+
+func (cli *Client) addLinkPreviewThumbnail(ctx context.Context, msg *waProto.ExtendedTextMessage, imageURL string) error {
+	data, _, err := cli.fetchForLinkPreview(ctx, imageURL, linkPreviewMaxImageSize)
+	if err != nil {
+		return fmt.Errorf("failed to fetch image: %w", err)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %w", err)
+	}
+	msg.JpegThumbnail, err = encodeJPEG(scaleImage(img, linkPreviewInlineThumbnailSize))
+	if err != nil {
+		return fmt.Errorf("failed to encode inline thumbnail: %w", err)
+	}
+	uploadImg := scaleImage(img, linkPreviewUploadedThumbnailSize)
+	uploadData, err := encodeJPEG(uploadImg)
+	if err != nil {
+		return fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	uploaded, err := cli.Upload(ctx, uploadData, MediaLinkThumbnail)
+	if err != nil {
+		return fmt.Errorf("failed to upload thumbnail: %w", err)
+	}
+	msg.ThumbnailDirectPath = proto.String(uploaded.DirectPath)
+	msg.ThumbnailSha256 = uploaded.FileSHA256
+	msg.ThumbnailEncSha256 = uploaded.FileEncSHA256
+	msg.MediaKey = uploaded.MediaKey
+	msg.MediaKeyTimestamp = proto.Int64(time.Now().Unix())
+	msg.ThumbnailWidth = proto.Uint32(uint32(uploadImg.Bounds().Dx()))
+	msg.ThumbnailHeight = proto.Uint32(uint32(uploadImg.Bounds().Dy()))
+	return nil
+}
+
+// AddLinkPreview finds the first link in the given message, fetches the page and fills the link preview fields
+// (matched text, title, description and thumbnails) like WhatsApp web does. If the text doesn't contain any links,
+// the message isn't modified and nil is returned.
+//
+// If the page has a preview image that can't be fetched, the preview is added without a thumbnail.
+func (cli *Client) AddLinkPreview(ctx context.Context, msg *waProto.ExtendedTextMessage) error {
+	matchedText := findPreviewURL(msg.GetText())
+	if len(matchedText) == 0 {
+		return nil
+	}
+	preview, err := cli.GetLinkPreview(ctx, matchedText)
+	if err != nil {
+		return err
+	}
+	msg.MatchedText = proto.String(matchedText)
+	msg.CanonicalUrl = proto.String(preview.URL)
+	msg.Title = proto.String(preview.Title)
+	if len(preview.Description) > 0 {
+		msg.Description = proto.String(preview.Description)
+	}
+	msg.PreviewType = waProto.ExtendedTextMessage_NONE.Enum()
+	if len(preview.ImageURL) > 0 {
+		err = cli.addLinkPreviewThumbnail(ctx, msg, preview.ImageURL)
+		if err != nil {
+			cli.Log.Warnf("Failed to add thumbnail to link preview of %s: %v", matchedText, err)
+		}
+	}
+	return nil
+}
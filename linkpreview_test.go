@@ -0,0 +1,68 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestFindPreviewURL(t *testing.T) {
+	tests := map[string]string{
+		"Check this out: https://example.com/page?a=1.": "https://example.com/page?a=1",
+		"(see http://example.com/)":                     "http://example.com/",
+		"no links here":                                 "",
+	}
+	for text, expected := range tests {
+		if found := findPreviewURL(text); found != expected {
+			t.Errorf("Expected %q in %q, got %q", expected, text, found)
+		}
+	}
+}
+
+func TestGetLinkPreview(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/article":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(`<html><head><title>Fallback title</title>
+<meta property="og:title" content="Tom &amp; Jerry">
+<meta name='description' content='A classic'>
+<meta content="/img/cover.jpg" property="og:image">
+</head><body><meta property="og:title" content="Not in head"></body></html>`))
+		case "/plain":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("hello"))
+		}
+	}))
+	defer server.Close()
+	cli := NewClient(&store.Device{}, nil)
+
+	preview, err := cli.GetLinkPreview(context.Background(), server.URL+"/article")
+	if err != nil {
+		t.Fatalf("Failed to get link preview: %v", err)
+	}
+	expected := LinkPreview{
+		URL:         server.URL + "/article",
+		Title:       "Tom & Jerry",
+		Description: "A classic",
+		ImageURL:    server.URL + "/img/cover.jpg",
+	}
+	if *preview != expected {
+		t.Errorf("Unexpected preview:\nexpected %+v\ngot      %+v", expected, *preview)
+	}
+
+	_, err = cli.GetLinkPreview(context.Background(), server.URL+"/plain")
+	if !errors.Is(err, ErrLinkPreviewNotAvailable) {
+		t.Errorf("Expected ErrLinkPreviewNotAvailable for plain text page, got %v", err)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// LiveLocationStopSequence is the sequence number of the final update of a live location share,
+// which tells recipients that the sender has stopped sharing their location.
+//
+// This is a whatsmeow-specific convention (see BuildLiveLocationUpdate), official WhatsApp clients don't know about it.
+const LiveLocationStopSequence = -1
+
+// liveLocationSessionTimeout is how long received live location shares are remembered without any updates.
+// WhatsApp clients only allow sharing live location for up to 8 hours.
+const liveLocationSessionTimeout = 8 * time.Hour
+
+// LiveLocationShare is a handle for an outgoing live location share started with Client.StartLiveLocation.
+//
+// Updates are sent with increasing sequence numbers. If an update can't be sent because the
+// connection is down, the latest location (or the stop message) is sent again automatically after reconnecting.
+//
+// Shares are only stored in memory, so they're not resumed after the process restarts.
+// Use Client.SendLiveLocationUpdate to continue a share manually in that case.
+type LiveLocationShare struct {
+	cli       *Client
+	chat      types.JID
+	id        types.MessageID
+	startedAt time.Time
+	duration  time.Duration
+	timer     *time.Timer
+	done      chan struct{}
+
+	lock     sync.Mutex
+	last     types.Coordinates
+	sequence int64
+	sent     int64
+	stopped  bool
+	stopSent bool
+}
+
+// StartLiveLocation starts sharing live location in the given chat.
+//
+// The initial location is sent before this returns. The share is stopped automatically when the duration lapses or
+// when the context is canceled, and it can be stopped early with the Stop method of the returned handle.
+func (cli *Client) StartLiveLocation(ctx context.Context, chat types.JID, initial types.Coordinates, duration time.Duration) (*LiveLocationShare, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid live location duration %s", duration)
+	}
+	share := &LiveLocationShare{
+		cli:       cli,
+		chat:      chat,
+		id:        cli.GenerateMessageID(),
+		startedAt: time.Now(),
+		duration:  duration,
+		done:      make(chan struct{}),
+		last:      initial,
+	}
+	_, err := cli.SendMessage(chat, share.id, share.buildMessage(initial, 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send initial live location: %w", err)
+	}
+	share.lock.Lock()
+	share.timer = time.AfterFunc(duration, share.stopInBackground)
+	share.lock.Unlock()
+	cli.liveLocationLock.Lock()
+	cli.liveLocationShares[share.id] = share
+	cli.liveLocationLock.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+			share.stopInBackground()
+		case <-share.done:
+		}
+	}()
+	return share, nil
+}
+
+// ID returns the message ID of the message that started the share.
+func (share *LiveLocationShare) ID() types.MessageID {
+	return share.id
+}
+
+// Update sends a new location to the chat.
+//
+// If sending fails, the error is returned, but the location is still remembered and sent after reconnecting,
+// unless a newer location is set before that.
+func (share *LiveLocationShare) Update(coords types.Coordinates) error {
+	share.lock.Lock()
+	if share.stopped || time.Since(share.startedAt) >= share.duration {
+		share.lock.Unlock()
+		return ErrLiveLocationStopped
+	}
+	share.last = coords
+	share.sequence++
+	share.lock.Unlock()
+	return share.flush()
+}
+
+// Stop stops sharing live location and sends the final update to the chat.
+//
+// If sending the final update fails, it will be retried after reconnecting. Calling Stop again is safe.
+func (share *LiveLocationShare) Stop() error {
+	share.lock.Lock()
+	share.stopLocked()
+	share.lock.Unlock()
+	return share.flush()
+}
+
+// stopLocked marks the share as stopped. The share lock must be held when calling this.
+func (share *LiveLocationShare) stopLocked() {
+	if !share.stopped {
+		share.stopped = true
+		share.timer.Stop()
+		close(share.done)
+	}
+}
+
+func (share *LiveLocationShare) stopInBackground() {
+	err := share.Stop()
+	if err != nil {
+		share.cli.Log.Warnf("Failed to stop live location share %s in %s (will retry after reconnecting): %v", share.id, share.chat, err)
+	}
+}
+
+// flush sends the latest state of the share to the chat if the server hasn't received it yet.
+//
+// The state is copied while holding the share lock, but the message is sent after unlocking, so concurrent calls
+// may send the same update twice. Recipients ignore updates that don't have a higher sequence number, so that's harmless.
+func (share *LiveLocationShare) flush() error {
+	share.lock.Lock()
+	stopSent, stopped, last, sequence, sent := share.stopSent, share.stopped, share.last, share.sequence, share.sent
+	share.lock.Unlock()
+	if stopSent {
+		return nil
+	} else if stopped {
+		_, err := share.cli.SendMessage(share.chat, "", share.buildMessage(last, LiveLocationStopSequence))
+		if err != nil {
+			return err
+		}
+		share.lock.Lock()
+		share.stopSent = true
+		share.lock.Unlock()
+		share.cli.liveLocationLock.Lock()
+		delete(share.cli.liveLocationShares, share.id)
+		share.cli.liveLocationLock.Unlock()
+	} else if sent < sequence {
+		_, err := share.cli.SendMessage(share.chat, "", share.buildMessage(last, sequence))
+		if err != nil {
+			return err
+		}
+		share.lock.Lock()
+		if sequence > share.sent {
+			share.sent = sequence
+		}
+		share.lock.Unlock()
+	}
+	return nil
+}
+
+func (share *LiveLocationShare) buildMessage(coords types.Coordinates, sequence int64) *waProto.Message {
+	return share.cli.BuildLiveLocationUpdate(share.id, coords, sequence, time.Since(share.startedAt))
+}
+
+// BuildLiveLocationUpdate builds a live location message. The built message can be sent normally using Client.SendMessage.
+//
+// The message with sequence number 0 starts the share, and its message ID is the share ID that all later updates
+// must reference. The last update of a share should use LiveLocationStopSequence. The time offset is how long after
+// the start of the share the update is sent.
+//
+// Referencing the share with the StanzaId of the context info and stopping it with LiveLocationStopSequence is a
+// whatsmeow-specific convention, which is only understood by other whatsmeow clients (see events.LiveLocation).
+// Official WhatsApp clients will display the start message as a live location, but show each update as a separate
+// live location message and won't notice the share stopping.
+//
+// Client.StartLiveLocation handles all of this automatically, so this is only needed when managing shares manually,
+// e.g. to continue a share after restarting.
+func (cli *Client) BuildLiveLocationUpdate(shareID types.MessageID, coords types.Coordinates, sequence int64, timeOffset time.Duration) *waProto.Message {
+	msg := &waProto.LiveLocationMessage{
+		DegreesLatitude:                   proto.Float64(coords.Latitude),
+		DegreesLongitude:                  proto.Float64(coords.Longitude),
+		AccuracyInMeters:                  proto.Uint32(coords.AccuracyInMeters),
+		SpeedInMps:                        proto.Float32(coords.SpeedInMps),
+		DegreesClockwiseFromMagneticNorth: proto.Uint32(coords.Heading),
+		SequenceNumber:                    proto.Int64(sequence),
+		TimeOffset:                        proto.Uint32(uint32(timeOffset / time.Second)),
+	}
+	if sequence != 0 {
+		msg.ContextInfo = &waProto.ContextInfo{StanzaId: proto.String(shareID)}
+		if ownID := cli.Store.ID; ownID != nil {
+			msg.ContextInfo.Participant = proto.String(ownID.ToNonAD().String())
+		}
+	}
+	return &waProto.Message{LiveLocationMessage: msg}
+}
+
+// SendLiveLocationUpdate sends an update to a live location share that isn't managed by a LiveLocationShare handle.
+// See BuildLiveLocationUpdate for the meaning of the parameters.
+//
+// Recipients drop updates with a sequence number that isn't higher than the previous update of the same share.
+func (cli *Client) SendLiveLocationUpdate(ctx context.Context, chat types.JID, shareID types.MessageID, coords types.Coordinates, sequence int64, timeOffset time.Duration) (SendResponse, error) {
+	var id types.MessageID
+	if sequence == 0 {
+		id = shareID
+	}
+	return cli.SendMessageContext(ctx, chat, id, cli.BuildLiveLocationUpdate(shareID, coords, sequence, timeOffset))
+}
+
+// resumeLiveLocations resends the pending updates of all active live location shares after reconnecting.
+func (cli *Client) resumeLiveLocations() {
+	cli.liveLocationLock.Lock()
+	shares := make([]*LiveLocationShare, 0, len(cli.liveLocationShares))
+	for _, share := range cli.liveLocationShares {
+		shares = append(shares, share)
+	}
+	cli.liveLocationLock.Unlock()
+	for _, share := range shares {
+		share.lock.Lock()
+		if time.Since(share.startedAt) >= share.duration {
+			// The timer fired while we were disconnected, but Stop may not have been called yet
+			share.stopLocked()
+		}
+		share.lock.Unlock()
+		err := share.flush()
+		if err != nil {
+			cli.Log.Warnf("Failed to resend live location share %s in %s: %v", share.id, share.chat, err)
+		}
+	}
+}
+
+type liveLocationKey struct {
+	Chat    types.JID
+	Sender  types.JID
+	ShareID types.MessageID
+}
+
+type receivedLiveLocation struct {
+	sequence   int64
+	lastUpdate time.Time
+}
+
+// parseLiveLocation converts an incoming live location message into a LiveLocation event.
+// Updates that are older than the latest already-received update of the same share are dropped and nil is returned.
+func (cli *Client) parseLiveLocation(info *types.MessageInfo, msg *waProto.LiveLocationMessage) *events.LiveLocation {
+	evt := &events.LiveLocation{
+		Info:    *info,
+		ShareID: msg.GetContextInfo().GetStanzaId(),
+		Coordinates: types.Coordinates{
+			Latitude:         msg.GetDegreesLatitude(),
+			Longitude:        msg.GetDegreesLongitude(),
+			AccuracyInMeters: msg.GetAccuracyInMeters(),
+			SpeedInMps:       msg.GetSpeedInMps(),
+			Heading:          msg.GetDegreesClockwiseFromMagneticNorth(),
+		},
+		Caption:        msg.GetCaption(),
+		SequenceNumber: msg.GetSequenceNumber(),
+		TimeOffset:     time.Duration(msg.GetTimeOffset()) * time.Second,
+	}
+	if len(evt.ShareID) == 0 {
+		evt.ShareID = info.ID
+		evt.IsStart = true
+	}
+	evt.IsStop = evt.SequenceNumber == LiveLocationStopSequence
+	key := liveLocationKey{Chat: info.Chat, Sender: info.Sender.ToNonAD(), ShareID: evt.ShareID}
+
+	cli.liveLocationLock.Lock()
+	defer cli.liveLocationLock.Unlock()
+	now := time.Now()
+	state, ok := cli.liveLocations[key]
+	if evt.IsStop {
+		delete(cli.liveLocations, key)
+	} else if !ok {
+		for otherKey, other := range cli.liveLocations {
+			if now.Sub(other.lastUpdate) > liveLocationSessionTimeout {
+				delete(cli.liveLocations, otherKey)
+			}
+		}
+		cli.liveLocations[key] = &receivedLiveLocation{sequence: evt.SequenceNumber, lastUpdate: now}
+	} else if evt.SequenceNumber <= state.sequence {
+		cli.Log.Debugf("Dropping outdated live location update %s (#%d) for share %s from %s, already got #%d", info.ID, evt.SequenceNumber, evt.ShareID, info.Sender, state.sequence)
+		return nil
+	} else {
+		state.sequence = evt.SequenceNumber
+		state.lastUpdate = now
+	}
+	return evt
+}
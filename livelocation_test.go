@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestParseLiveLocation(t *testing.T) {
+	sender := types.NewADJID("1111111111", 0, 2)
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	ownID := types.NewADJID("2222222222", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	senderCli := NewClient(&store.Device{ID: &sender}, nil)
+	share := &LiveLocationShare{cli: senderCli, chat: chat, id: "START", startedAt: time.Now()}
+	coords := types.Coordinates{Latitude: 60.17, Longitude: 24.94, AccuracyInMeters: 10}
+
+	tests := []struct {
+		name     string
+		id       types.MessageID
+		sequence int64
+		emitted  bool
+		start    bool
+		stop     bool
+	}{
+		{name: "start", id: "START", sequence: 0, emitted: true, start: true},
+		{name: "update", id: "UPDATE2", sequence: 2, emitted: true},
+		{name: "out of order update", id: "UPDATE1", sequence: 1, emitted: false},
+		{name: "duplicate update", id: "UPDATE2", sequence: 2, emitted: false},
+		{name: "newer update", id: "UPDATE3", sequence: 3, emitted: true},
+		{name: "stop", id: "STOP", sequence: LiveLocationStopSequence, emitted: true, stop: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info := &types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: sender}, ID: test.id}
+			evt := cli.parseLiveLocation(info, share.buildMessage(coords, test.sequence).GetLiveLocationMessage())
+			if (evt != nil) != test.emitted {
+				t.Fatalf("Expected emitted=%t, got %t", test.emitted, evt != nil)
+			} else if evt == nil {
+				return
+			}
+			if evt.ShareID != "START" {
+				t.Errorf("Expected share ID START, got %s", evt.ShareID)
+			}
+			if evt.IsStart != test.start || evt.IsStop != test.stop {
+				t.Errorf("Expected start=%t stop=%t, got start=%t stop=%t", test.start, test.stop, evt.IsStart, evt.IsStop)
+			}
+			if evt.Coordinates != coords {
+				t.Errorf("Expected coordinates %+v, got %+v", coords, evt.Coordinates)
+			}
+		})
+	}
+	if len(cli.liveLocations) != 0 {
+		t.Errorf("Expected stopped share to be forgotten, but %d shares are still tracked", len(cli.liveLocations))
+	}
+}
+
+func TestLiveLocationShareSendsWithoutLock(t *testing.T) {
+	chat := types.NewJID("2222222222", types.DefaultUserServer)
+	cli, _ := newSendTestClient(t, chat)
+	var share atomic.Value
+	var sent, lockedSends int32
+	connectFakeServer(t, cli, func(node *waBinary.Node) []waBinary.Node {
+		if node.Tag == "message" {
+			atomic.AddInt32(&sent, 1)
+			if current, ok := share.Load().(*LiveLocationShare); ok {
+				if !current.lock.TryLock() {
+					atomic.AddInt32(&lockedSends, 1)
+				} else {
+					current.lock.Unlock()
+				}
+			}
+		}
+		return fakeSendHandler(node)
+	})
+
+	started, err := cli.StartLiveLocation(context.Background(), chat, types.Coordinates{Latitude: 60.17, Longitude: 24.94}, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to start live location: %v", err)
+	}
+	share.Store(started)
+	if err = started.Update(types.Coordinates{Latitude: 60.18, Longitude: 24.95}); err != nil {
+		t.Fatalf("Failed to update live location: %v", err)
+	}
+	if err = started.Stop(); err != nil {
+		t.Fatalf("Failed to stop live location: %v", err)
+	}
+	if err = started.Update(types.Coordinates{}); err != ErrLiveLocationStopped {
+		t.Errorf("Expected ErrLiveLocationStopped after stopping, got %v", err)
+	}
+	if sent := atomic.LoadInt32(&sent); sent != 3 {
+		t.Errorf("Expected start, update and stop messages to be sent, got %d messages", sent)
+	}
+	if lockedSends := atomic.LoadInt32(&lockedSends); lockedSends != 0 {
+		t.Errorf("Expected share lock not to be held while sending, but it was held during %d sends", lockedSends)
+	}
+	if started.sent != 1 || !started.stopSent {
+		t.Errorf("Unexpected share state after stopping: sent=%d stopSent=%t", started.sent, started.stopSent)
+	}
+	if len(cli.liveLocationShares) != 0 {
+		t.Errorf("Expected stopped share to be removed from client")
+	}
+}
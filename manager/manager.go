@@ -0,0 +1,285 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package manager implements a helper for running many whatsmeow clients, one for each device in a database.
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// ErrUnknownDevice is returned by Manager methods when there's no client for the given JID.
+var ErrUnknownDevice = errors.New("no client found for device")
+
+// Event is an event from one of the clients in a Manager.
+type Event struct {
+	// The JID of the device that the event came from. This is empty for clients that haven't finished pairing yet.
+	JID types.JID
+	// The client that the event came from.
+	Client *whatsmeow.Client
+	// The event itself, one of the types in the events package.
+	Event interface{}
+}
+
+// EventHandler is a function that can handle events from all clients in a Manager.
+type EventHandler func(evt *Event)
+
+type wrappedEventHandler struct {
+	fn EventHandler
+	id uint32
+}
+
+var nextHandlerID uint32
+
+// Manager owns a sqlstore.Container and keeps one whatsmeow.Client for each device stored in it.
+//
+// Events from all clients are passed to the handlers added with AddEventHandler with the device JID attached.
+// Clients are added when they finish pairing and removed when they're logged out.
+type Manager struct {
+	Container *sqlstore.Container
+	Log       waLog.Logger
+
+	// OnClientCreated is called for every new client before it's connected.
+	// It can be used to set client options like proxies.
+	OnClientCreated func(cli *whatsmeow.Client)
+
+	clients     map[types.JID]*whatsmeow.Client
+	pairing     map[*whatsmeow.Client]struct{}
+	clientsLock sync.RWMutex
+
+	eventHandlers     []wrappedEventHandler
+	eventHandlersLock sync.RWMutex
+}
+
+// New creates a new Manager for the given container. Call Start to create clients for existing devices and connect them.
+func New(container *sqlstore.Container, log waLog.Logger) *Manager {
+	if log == nil {
+		log = waLog.Noop
+	}
+	return &Manager{
+		Container: container,
+		Log:       log,
+		clients:   make(map[types.JID]*whatsmeow.Client),
+		pairing:   make(map[*whatsmeow.Client]struct{}),
+	}
+}
+
+// AddEventHandler registers a new function to receive events from all clients. See Client.AddEventHandler for details.
+func (mgr *Manager) AddEventHandler(handler EventHandler) uint32 {
+	nextID := atomic.AddUint32(&nextHandlerID, 1)
+	mgr.eventHandlersLock.Lock()
+	mgr.eventHandlers = append(mgr.eventHandlers, wrappedEventHandler{handler, nextID})
+	mgr.eventHandlersLock.Unlock()
+	return nextID
+}
+
+// RemoveEventHandler removes a previously registered event handler function.
+// If the function with the given ID is found, this returns true.
+//
+// Like Client.RemoveEventHandler, this must not be called directly from an event handler.
+func (mgr *Manager) RemoveEventHandler(id uint32) bool {
+	mgr.eventHandlersLock.Lock()
+	defer mgr.eventHandlersLock.Unlock()
+	for index := range mgr.eventHandlers {
+		if mgr.eventHandlers[index].id == id {
+			mgr.eventHandlers = append(mgr.eventHandlers[:index], mgr.eventHandlers[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *Manager) dispatchEvent(evt *Event) {
+	mgr.eventHandlersLock.RLock()
+	defer mgr.eventHandlersLock.RUnlock()
+	for _, handler := range mgr.eventHandlers {
+		handler.fn(evt)
+	}
+}
+
+func (mgr *Manager) newClient(device *store.Device) *whatsmeow.Client {
+	name := "Pairing"
+	if device.ID != nil {
+		name = device.ID.String()
+	}
+	cli := whatsmeow.NewClient(device, mgr.Log.Sub(name))
+	cli.EnableAutoReconnect = true
+	cli.AddEventHandler(func(rawEvt interface{}) {
+		mgr.handleClientEvent(cli, rawEvt)
+	})
+	if mgr.OnClientCreated != nil {
+		mgr.OnClientCreated(cli)
+	}
+	return cli
+}
+
+func (mgr *Manager) handleClientEvent(cli *whatsmeow.Client, rawEvt interface{}) {
+	var jid types.JID
+	if cli.Store.ID != nil {
+		jid = *cli.Store.ID
+	}
+	switch evt := rawEvt.(type) {
+	case *events.PairSuccess:
+		mgr.clientsLock.Lock()
+		delete(mgr.pairing, cli)
+		mgr.clients[evt.ID] = cli
+		mgr.clientsLock.Unlock()
+		mgr.Log.Infof("Added client for newly paired device %s", evt.ID)
+		jid = evt.ID
+	case *events.LoggedOut:
+		// The store may have already been deleted, so find the JID from the client map instead
+		jid = mgr.jidOf(cli)
+		mgr.removeClient(cli, jid)
+		mgr.Log.Infof("Removed client for %s after it was logged out", jid)
+	}
+	mgr.dispatchEvent(&Event{JID: jid, Client: cli, Event: rawEvt})
+}
+
+func (mgr *Manager) jidOf(cli *whatsmeow.Client) types.JID {
+	mgr.clientsLock.RLock()
+	defer mgr.clientsLock.RUnlock()
+	for jid, existingCli := range mgr.clients {
+		if existingCli == cli {
+			return jid
+		}
+	}
+	return types.EmptyJID
+}
+
+func (mgr *Manager) removeClient(cli *whatsmeow.Client, jid types.JID) {
+	mgr.clientsLock.Lock()
+	if mgr.clients[jid] == cli {
+		delete(mgr.clients, jid)
+	}
+	delete(mgr.pairing, cli)
+	mgr.clientsLock.Unlock()
+}
+
+// Load creates clients for all devices in the container that don't have a client yet, without connecting them.
+func (mgr *Manager) Load() ([]*whatsmeow.Client, error) {
+	devices, err := mgr.Container.GetAllDevices()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get devices: %w", err)
+	}
+	mgr.clientsLock.Lock()
+	defer mgr.clientsLock.Unlock()
+	created := make([]*whatsmeow.Client, 0, len(devices))
+	for _, device := range devices {
+		if _, exists := mgr.clients[*device.ID]; exists {
+			continue
+		}
+		cli := mgr.newClient(device)
+		mgr.clients[*device.ID] = cli
+		created = append(created, cli)
+	}
+	return created, nil
+}
+
+// Start loads all devices from the container and connects the clients that were created.
+//
+// Connection errors don't stop other clients from being connected, they're all collected into the returned error.
+func (mgr *Manager) Start() error {
+	clients, err := mgr.Load()
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, cli := range clients {
+		err = cli.Connect()
+		if err != nil {
+			mgr.Log.Errorf("Failed to connect %s: %v", cli.Store.ID, err)
+			failed = append(failed, fmt.Sprintf("%s: %v", cli.Store.ID, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to connect %d/%d clients: %v", len(failed), len(clients), failed)
+	}
+	return nil
+}
+
+// Stop disconnects all clients, including ones that haven't finished pairing. The clients are kept in the manager,
+// so calling Start or Connect on them again will reconnect.
+func (mgr *Manager) Stop() {
+	for _, cli := range mgr.allClients() {
+		cli.Disconnect()
+	}
+}
+
+func (mgr *Manager) allClients() []*whatsmeow.Client {
+	mgr.clientsLock.RLock()
+	defer mgr.clientsLock.RUnlock()
+	clients := make([]*whatsmeow.Client, 0, len(mgr.clients)+len(mgr.pairing))
+	for _, cli := range mgr.clients {
+		clients = append(clients, cli)
+	}
+	for cli := range mgr.pairing {
+		clients = append(clients, cli)
+	}
+	return clients
+}
+
+// NewLogin creates a client for a new device. The client isn't connected, call GetQRChannel and/or Connect on it
+// to start pairing. It's added to the manager under its JID when events.PairSuccess is received.
+func (mgr *Manager) NewLogin() *whatsmeow.Client {
+	cli := mgr.newClient(mgr.Container.NewDevice())
+	mgr.clientsLock.Lock()
+	mgr.pairing[cli] = struct{}{}
+	mgr.clientsLock.Unlock()
+	return cli
+}
+
+// CancelLogin disconnects and forgets a client created with NewLogin that hasn't finished pairing.
+func (mgr *Manager) CancelLogin(cli *whatsmeow.Client) {
+	mgr.clientsLock.Lock()
+	_, ok := mgr.pairing[cli]
+	delete(mgr.pairing, cli)
+	mgr.clientsLock.Unlock()
+	if ok {
+		cli.Disconnect()
+	}
+}
+
+// Get returns the client for the given device JID, or nil if there's no such client.
+func (mgr *Manager) Get(jid types.JID) *whatsmeow.Client {
+	mgr.clientsLock.RLock()
+	defer mgr.clientsLock.RUnlock()
+	return mgr.clients[jid]
+}
+
+// Clients returns all logged-in clients in the manager.
+func (mgr *Manager) Clients() []*whatsmeow.Client {
+	mgr.clientsLock.RLock()
+	defer mgr.clientsLock.RUnlock()
+	clients := make([]*whatsmeow.Client, 0, len(mgr.clients))
+	for _, cli := range mgr.clients {
+		clients = append(clients, cli)
+	}
+	return clients
+}
+
+// Logout logs out the device with the given JID and removes its client from the manager.
+func (mgr *Manager) Logout(jid types.JID) error {
+	cli := mgr.Get(jid)
+	if cli == nil {
+		return ErrUnknownDevice
+	}
+	err := cli.Logout()
+	if err != nil {
+		return err
+	}
+	mgr.removeClient(cli, jid)
+	return nil
+}
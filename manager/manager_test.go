@@ -0,0 +1,70 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package manager
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestManagerLoad(t *testing.T) {
+	address := fmt.Sprintf("file:%s?_foreign_keys=on", filepath.Join(t.TempDir(), "whatsmeow.db"))
+	container, err := sqlstore.New("sqlite3", address, nil)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	jids := []types.JID{types.NewADJID("1111111111", 0, 1), types.NewADJID("2222222222", 0, 3)}
+	for i := range jids {
+		device := container.NewDevice()
+		device.ID = &jids[i]
+		device.Account = &waProto.ADVSignedDeviceIdentity{
+			Details:          []byte{},
+			AccountSignature: make([]byte, 64),
+			DeviceSignature:  make([]byte, 64),
+		}
+		if err = container.PutDevice(device); err != nil {
+			t.Fatalf("Failed to save device: %v", err)
+		}
+	}
+
+	mgr := New(container, nil)
+	created, err := mgr.Load()
+	if err != nil {
+		t.Fatalf("Failed to load clients: %v", err)
+	} else if len(created) != 2 {
+		t.Fatalf("Expected 2 clients to be created, got %d", len(created))
+	}
+	for _, jid := range jids {
+		if cli := mgr.Get(jid); cli == nil || *cli.Store.ID != jid {
+			t.Errorf("Client for %s not found", jid)
+		}
+	}
+	if created, err = mgr.Load(); err != nil {
+		t.Fatalf("Failed to reload clients: %v", err)
+	} else if len(created) != 0 {
+		t.Errorf("Expected no new clients on reload, got %d", len(created))
+	}
+
+	cli := mgr.NewLogin()
+	if len(mgr.Clients()) != 2 {
+		t.Errorf("Pairing client shouldn't be listed in Clients")
+	}
+	mgr.CancelLogin(cli)
+	if len(mgr.allClients()) != 2 {
+		t.Errorf("Canceled pairing client wasn't removed")
+	}
+	if err = mgr.Logout(types.NewADJID("3333333333", 0, 1)); err != ErrUnknownDevice {
+		t.Errorf("Expected ErrUnknownDevice when logging out unknown device, got %v", err)
+	}
+}
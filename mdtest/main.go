@@ -30,6 +30,7 @@ import (
 	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/socket"
 	"go.mau.fi/whatsmeow/store/sqlstore"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
@@ -43,6 +44,7 @@ var logLevel = "INFO"
 var debugLogs = flag.Bool("debug", false, "Enable debug logs?")
 var dbDialect = flag.String("db-dialect", "sqlite3", "Database dialect (sqlite3 or postgres)")
 var dbAddress = flag.String("db-address", "file:mdtest.db?_foreign_keys=on", "Database address")
+var wsURL = flag.String("ws-url", "", "Override the WhatsApp web websocket URL (e.g. for a local relay)")
 
 func main() {
 	waBinary.IndentXML = true
@@ -51,6 +53,7 @@ func main() {
 	if *debugLogs {
 		logLevel = "DEBUG"
 	}
+	socket.URLOverride = *wsURL
 	log = waLog.Stdout("Main", logLevel, true)
 
 	dbLog := waLog.Stdout("Database", logLevel, true)
@@ -282,6 +285,30 @@ func handleCmd(cmd string, args []string) {
 				log.Infof("%+v", group)
 			}
 		}
+	case "setlocked", "setannounce":
+		if len(args) < 2 {
+			log.Errorf("Usage: %s <jid> <true/false>", cmd)
+			return
+		}
+		group, ok := parseJID(args[0])
+		if !ok {
+			return
+		} else if group.Server != types.GroupServer {
+			log.Errorf("Input must be a group JID (@%s)", types.GroupServer)
+			return
+		}
+		value := strings.ToLower(args[1]) == "true"
+		var err error
+		if cmd == "setlocked" {
+			err = cli.SetGroupLocked(group, value)
+		} else {
+			err = cli.SetGroupAnnounce(group, value)
+		}
+		if err != nil {
+			log.Errorf("Failed to change group setting: %v", err)
+		} else {
+			log.Infof("Changed group setting")
+		}
 	case "getinvitelink":
 		if len(args) < 1 {
 			log.Errorf("Usage: getinvitelink <jid> [--reset]")
@@ -343,11 +370,11 @@ func handleCmd(cmd string, args []string) {
 			return
 		}
 		msg := &waProto.Message{Conversation: proto.String(strings.Join(args[1:], " "))}
-		ts, err := cli.SendMessage(recipient, "", msg)
+		resp, err := cli.SendMessage(recipient, "", msg)
 		if err != nil {
 			log.Errorf("Error sending message: %v", err)
 		} else {
-			log.Infof("Message sent (server timestamp: %s)", ts)
+			log.Infof("Message sent (server timestamp: %s)", resp.Timestamp)
 		}
 	case "sendimg":
 		if len(args) < 2 {
@@ -378,11 +405,11 @@ func handleCmd(cmd string, args []string) {
 			FileSha256:    uploaded.FileSHA256,
 			FileLength:    proto.Uint64(uint64(len(data))),
 		}}
-		ts, err := cli.SendMessage(recipient, "", msg)
+		resp, err := cli.SendMessage(recipient, "", msg)
 		if err != nil {
 			log.Errorf("Error sending image message: %v", err)
 		} else {
-			log.Infof("Image message sent (server timestamp: %s)", ts)
+			log.Infof("Image message sent (server timestamp: %s)", resp.Timestamp)
 		}
 	}
 }
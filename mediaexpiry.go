@@ -0,0 +1,136 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// MediaFallbackLifetime is how long media is assumed to be downloadable after the media key timestamp
+// when the URL or direct path doesn't contain an explicit expiry hint.
+//
+// This is intentionally conservative: media usually stays on the servers for longer.
+var MediaFallbackLifetime = 14 * 24 * time.Hour
+
+type downloadableMessageWithMediaKeyTimestamp interface {
+	DownloadableMessage
+	GetMediaKeyTimestamp() int64
+}
+
+// mediaExpiryHint is a heuristic for finding the expiry time of a media URL or direct path.
+type mediaExpiryHint struct {
+	// The host the heuristic applies to, including its subdomains. Empty means direct paths (which don't have a host).
+	Host string
+	// Parse finds the expiry time from the URL.
+	Parse func(u *url.URL) (time.Time, bool)
+}
+
+// parseHexUnixParam parses a hex-encoded unix timestamp from the given query parameter, as used by the Facebook CDN.
+func parseHexUnixParam(param string) func(u *url.URL) (time.Time, bool) {
+	return func(u *url.URL) (time.Time, bool) {
+		val := u.Query().Get(param)
+		if len(val) == 0 {
+			return time.Time{}, false
+		}
+		ts, err := strconv.ParseInt(val, 16, 64)
+		if err != nil || ts <= 0 {
+			return time.Time{}, false
+		}
+		return time.Unix(ts, 0), true
+	}
+}
+
+// mediaExpiryHints contains the known expiry heuristics for different media hosts.
+// The first matching entry that finds an expiry time wins.
+var mediaExpiryHints = []mediaExpiryHint{
+	// Direct paths (/v/t62.7118-24/...) have the same oe parameter as full URLs
+	{Host: "", Parse: parseHexUnixParam("oe")},
+	{Host: "mmg.whatsapp.net", Parse: parseHexUnixParam("oe")},
+	{Host: "fna.whatsapp.net", Parse: parseHexUnixParam("oe")},
+	{Host: "cdn.whatsapp.net", Parse: parseHexUnixParam("oe")},
+}
+
+// matchesMediaHost checks if the given hostname is the expected host or one of its subdomains.
+func matchesMediaHost(hostname, expected string) bool {
+	if len(expected) == 0 || len(hostname) == 0 {
+		return hostname == expected
+	}
+	return hostname == expected || strings.HasSuffix(hostname, "."+expected)
+}
+
+func findMediaExpiry(rawURL string) (time.Time, bool) {
+	if len(rawURL) == 0 {
+		return time.Time{}, false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return time.Time{}, false
+	}
+	host := u.Hostname()
+	for _, hint := range mediaExpiryHints {
+		if !matchesMediaHost(host, hint.Host) {
+			continue
+		} else if expiry, ok := hint.Parse(u); ok {
+			return expiry, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// MediaExpiresAt returns the time when the media in the given message is expected to stop being downloadable.
+//
+// The expiry is parsed from the URL or direct path when possible. If they don't contain expiry hints,
+// the media key timestamp plus MediaFallbackLifetime is used. If neither is available, the bool will be false.
+func MediaExpiresAt(msg DownloadableMessage) (time.Time, bool) {
+	if urlable, ok := msg.(downloadableMessageWithURL); ok {
+		if expiry, ok := findMediaExpiry(urlable.GetUrl()); ok {
+			return expiry, true
+		}
+	}
+	if expiry, ok := findMediaExpiry(msg.GetDirectPath()); ok {
+		return expiry, true
+	}
+	if timestamped, ok := msg.(downloadableMessageWithMediaKeyTimestamp); ok && timestamped.GetMediaKeyTimestamp() > 0 {
+		return time.Unix(timestamped.GetMediaKeyTimestamp(), 0).Add(MediaFallbackLifetime), true
+	}
+	return time.Time{}, false
+}
+
+// DownloadOrRetry downloads the attachment from the given message, and if the media has expired,
+// asks the sender to re-upload it using SendMediaRetryReceipt and downloads the re-uploaded media.
+//
+// The message info is needed for the retry request. The context can be used to limit how long
+// to wait for the sender to respond to the retry request.
+func (cli *Client) DownloadOrRetry(ctx context.Context, info *types.MessageInfo, msg DownloadableMessage) ([]byte, error) {
+	mediaType, ok := classToMediaType[msg.ProtoReflect().Descriptor().Name()]
+	if !ok {
+		return nil, fmt.Errorf("%w '%s'", ErrUnknownMediaType, string(msg.ProtoReflect().Descriptor().Name()))
+	}
+	expiry, ok := MediaExpiresAt(msg)
+	if !ok || time.Now().Before(expiry) {
+		data, err := cli.Download(msg)
+		if !errors.Is(err, ErrMediaDownloadFailedWith404) && !errors.Is(err, ErrMediaDownloadFailedWith410) {
+			return data, err
+		}
+		cli.Log.Debugf("Media in %s expired earlier than expected (%v), requesting re-upload", info.ID, err)
+	} else {
+		cli.Log.Debugf("Media in %s expired at %s, requesting re-upload", info.ID, expiry)
+	}
+	directPath, err := cli.requestMediaReupload(ctx, info, msg.GetMediaKey())
+	if err != nil {
+		return nil, err
+	}
+	return cli.downloadMediaWithPath(directPath, msg.GetFileEncSha256(), msg.GetFileSha256(), msg.GetMediaKey(), getSize(msg), mediaType, mediaTypeToMMSType[mediaType])
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+func TestMediaExpiresAt(t *testing.T) {
+	keyTimestamp := int64(1640000000)
+	fallback := time.Unix(keyTimestamp, 0).Add(MediaFallbackLifetime)
+	tests := []struct {
+		name     string
+		msg      DownloadableMessage
+		expected time.Time
+		ok       bool
+	}{{
+		name:     "direct path with oe",
+		msg:      &waProto.ImageMessage{DirectPath: proto.String("/v/t62.7118-24/123_456_n.enc?ccb=11-4&oh=01_AVw&oe=61E9D2A8")},
+		expected: time.Unix(0x61E9D2A8, 0),
+		ok:       true,
+	}, {
+		name: "mmg url with oe",
+		msg: &waProto.VideoMessage{
+			Url:               proto.String("https://mmg.whatsapp.net/d/f/AgQ.enc?oe=61E9D2A8"),
+			MediaKeyTimestamp: proto.Int64(keyTimestamp),
+		},
+		expected: time.Unix(0x61E9D2A8, 0),
+		ok:       true,
+	}, {
+		name: "fna url with oe",
+		msg: &waProto.AudioMessage{
+			Url: proto.String("https://media.fist1-3.fna.whatsapp.net/v/t62.7117-24/123.enc?oe=61E9D2A8"),
+		},
+		expected: time.Unix(0x61E9D2A8, 0),
+		ok:       true,
+	}, {
+		name: "url with port",
+		msg: &waProto.ImageMessage{
+			Url: proto.String("https://mmg.whatsapp.net:443/d/f/AgQ.enc?oe=61E9D2A8"),
+		},
+		expected: time.Unix(0x61E9D2A8, 0),
+		ok:       true,
+	}, {
+		name: "lookalike host falls back to media key timestamp",
+		msg: &waProto.ImageMessage{
+			Url:               proto.String("https://evilmmg.whatsapp.net/d/f/AgQ.enc?oe=61E9D2A8"),
+			MediaKeyTimestamp: proto.Int64(keyTimestamp),
+		},
+		expected: fallback,
+		ok:       true,
+	}, {
+		name: "host with known suffix in another domain falls back to media key timestamp",
+		msg: &waProto.ImageMessage{
+			Url:               proto.String("https://media.fna.whatsapp.net.example.com/v/123.enc?oe=61E9D2A8"),
+			MediaKeyTimestamp: proto.Int64(keyTimestamp),
+		},
+		expected: fallback,
+		ok:       true,
+	}, {
+		name: "unknown host falls back to media key timestamp",
+		msg: &waProto.DocumentMessage{
+			Url:               proto.String("https://example.com/file.enc?oe=61E9D2A8"),
+			MediaKeyTimestamp: proto.Int64(keyTimestamp),
+		},
+		expected: fallback,
+		ok:       true,
+	}, {
+		name: "invalid oe falls back to media key timestamp",
+		msg: &waProto.StickerMessage{
+			DirectPath:        proto.String("/v/t62.15575-24/123.enc?oe=notahexvalue"),
+			MediaKeyTimestamp: proto.Int64(keyTimestamp),
+		},
+		expected: fallback,
+		ok:       true,
+	}, {
+		name: "no hints",
+		msg:  &waProto.ImageMessage{DirectPath: proto.String("/v/t62.7118-24/123_456_n.enc")},
+		ok:   false,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expiry, ok := MediaExpiresAt(test.msg)
+			if ok != test.ok {
+				t.Fatalf("Expected ok=%t, got %t", test.ok, ok)
+			} else if !expiry.Equal(test.expected) {
+				t.Errorf("Expected expiry %s, got %s", test.expected, expiry)
+			}
+		})
+	}
+}
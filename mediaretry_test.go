@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// encryptMediaRetryNotification encrypts a notification the way the phone does when responding to a retry receipt.
+func encryptMediaRetryNotification(t *testing.T, messageID types.MessageID, mediaKey []byte, notif *waProto.MediaRetryNotification) (ciphertext, iv []byte) {
+	t.Helper()
+	plaintext, err := proto.Marshal(notif)
+	if err != nil {
+		t.Fatalf("Failed to marshal notification: %v", err)
+	}
+	gcm, err := prepareMediaRetryGCM(mediaKey)
+	if err != nil {
+		t.Fatalf("Failed to prepare GCM: %v", err)
+	}
+	iv = bytes.Repeat([]byte{7}, 12)
+	return gcm.Seal(nil, iv, plaintext, []byte(messageID)), iv
+}
+
+func TestMediaRetryRoundTrip(t *testing.T) {
+	const messageID = "3EB0C0FFEE"
+	mediaKey := bytes.Repeat([]byte{1}, 32)
+	chat := types.NewJID("123456789-123456", types.GroupServer)
+	sender := types.NewJID("1234567890", types.DefaultUserServer)
+
+	// The receipt we send must decrypt to a server error receipt for the message
+	receiptCiphertext, receiptIV, err := encryptMediaRetryReceipt(messageID, mediaKey)
+	if err != nil {
+		t.Fatalf("Failed to encrypt retry receipt: %v", err)
+	}
+	gcm, _ := prepareMediaRetryGCM(mediaKey)
+	receiptPlaintext, err := gcm.Open(nil, receiptIV, receiptCiphertext, []byte(messageID))
+	if err != nil {
+		t.Fatalf("Failed to decrypt retry receipt: %v", err)
+	}
+	var receipt waProto.ServerErrorReceipt
+	if err = proto.Unmarshal(receiptPlaintext, &receipt); err != nil {
+		t.Fatalf("Failed to unmarshal retry receipt: %v", err)
+	} else if receipt.GetStanzaId() != messageID {
+		t.Errorf("Expected stanza ID %s in retry receipt, got %s", messageID, receipt.GetStanzaId())
+	}
+
+	// The response notification must survive the binary encoding and decrypt with the same media key
+	ciphertext, iv := encryptMediaRetryNotification(t, messageID, mediaKey, &waProto.MediaRetryNotification{
+		StanzaId:   proto.String(messageID),
+		DirectPath: proto.String("/v/t62.7118-24/123_456_n.enc?oe=61E9D2A8"),
+		Result:     waProto.MediaRetryNotification_SUCCESS.Enum(),
+	})
+	payload, err := waBinary.Marshal(waBinary.Node{
+		Tag:   "notification",
+		Attrs: waBinary.Attrs{"id": messageID, "type": "mediaretry", "t": "1640000000", "from": types.NewJID("1111111111", types.DefaultUserServer)},
+		Content: []waBinary.Node{
+			{Tag: "encrypt", Content: []waBinary.Node{
+				{Tag: "enc_p", Content: ciphertext},
+				{Tag: "enc_iv", Content: iv},
+			}},
+			{Tag: "rmr", Attrs: waBinary.Attrs{"jid": chat, "from_me": "false", "participant": sender}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal notification node: %v", err)
+	}
+	payload, err = waBinary.Unpack(payload)
+	if err != nil {
+		t.Fatalf("Failed to unpack notification node: %v", err)
+	}
+	node, err := waBinary.Unmarshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal notification node: %v", err)
+	}
+	evt, err := parseMediaRetryNotification(node)
+	if err != nil {
+		t.Fatalf("Failed to parse notification: %v", err)
+	} else if evt.MessageID != messageID || evt.ChatID != chat || evt.SenderID != sender || evt.FromMe || evt.Timestamp.Unix() != 1640000000 {
+		t.Errorf("Unexpected parsed notification %+v", evt)
+	}
+	notif, err := DecryptMediaRetryNotification(evt, mediaKey)
+	if err != nil {
+		t.Fatalf("Failed to decrypt notification: %v", err)
+	} else if notif.GetResult() != waProto.MediaRetryNotification_SUCCESS || notif.GetDirectPath() != "/v/t62.7118-24/123_456_n.enc?oe=61E9D2A8" {
+		t.Errorf("Unexpected decrypted notification %v", notif)
+	}
+	if _, err = DecryptMediaRetryNotification(evt, bytes.Repeat([]byte{2}, 32)); err == nil {
+		t.Errorf("Expected decrypting with the wrong media key to fail")
+	}
+
+	// Waiters registered by DownloadOrRetry receive the parsed notification
+	cli := NewClient(&store.Device{}, nil)
+	waiter := make(chan *events.MediaRetry, 1)
+	cli.mediaRetryWaiters[messageID] = waiter
+	cli.handleMediaRetryNotification(node)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	select {
+	case received := <-waiter:
+		if !bytes.Equal(received.Ciphertext, ciphertext) || !bytes.Equal(received.IV, iv) {
+			t.Errorf("Waiter received unexpected notification %+v", received)
+		}
+	case <-ctx.Done():
+		t.Fatalf("Waiter didn't receive the notification")
+	}
+	if _, ok := cli.mediaRetryWaiters[messageID]; ok {
+		t.Errorf("Expected waiter to be removed after receiving the notification")
+	}
+}
@@ -41,11 +41,41 @@ func (cli *Client) handleEncryptedMessage(node *waBinary.Node) {
 		if len(info.PushName) > 0 && info.PushName != "-" {
 			go cli.updatePushName(info.Sender, info, info.PushName)
 		}
-		cli.decryptMessages(info, node)
+		if cli.PreDecryptHook != nil {
+			decision := cli.PreDecryptHook(info, node)
+			if decision.Drop && hasSenderKeyDistribution(info, node) {
+				cli.Log.Debugf("Not dropping message %s from %s as it may contain a sender key distribution message", info.ID, info.SourceString())
+			} else if decision.Drop {
+				cli.Log.Debugf("Dropping message %s from %s as requested by pre-decrypt hook", info.ID, info.SourceString())
+				go cli.sendAck(node)
+				return
+			} else if decision.Delay > 0 {
+				go cli.delayDecryption(info, node, decision.Delay)
+				return
+			}
+		}
+		cli.queueDecryption(info, node)
 	}
 }
 
-func (cli *Client) parseMessageSource(node *waBinary.Node) (source types.MessageSource, err error) {
+// hasSenderKeyDistribution returns true if the given group message stanza contains a pairwise encrypted part,
+// which is where sender key distribution messages are sent. Such messages can't be dropped without decrypting
+// them, because later messages from the same sender couldn't be decrypted without the sender key.
+func hasSenderKeyDistribution(info *types.MessageInfo, node *waBinary.Node) bool {
+	if !info.IsGroup {
+		return false
+	}
+	for _, child := range node.GetChildrenByTag("enc") {
+		if encType, _ := child.Attrs["type"].(string); encType == "pkmsg" || encType == "msg" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMessageSource parses the chat and sender of a message, receipt or chat state node. If requireParticipant
+// is false, the sender of group nodes is left empty when the node has no participant attribute.
+func (cli *Client) parseMessageSource(node *waBinary.Node, requireParticipant bool) (source types.MessageSource, err error) {
 	from, ok := node.Attrs["from"].(types.JID)
 	if !ok {
 		err = fmt.Errorf("didn't find valid `from` attribute in message")
@@ -53,7 +83,9 @@ func (cli *Client) parseMessageSource(node *waBinary.Node) (source types.Message
 		source.IsGroup = true
 		source.Chat = from
 		sender, ok := node.Attrs["participant"].(types.JID)
-		if !ok {
+		if !ok && !requireParticipant {
+			return
+		} else if !ok {
 			err = fmt.Errorf("didn't find valid `participant` attribute in group message")
 		} else {
 			source.Sender = sender
@@ -81,7 +113,7 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 	var info types.MessageInfo
 	var err error
 	var ok bool
-	info.MessageSource, err = cli.parseMessageSource(node)
+	info.MessageSource, err = cli.parseMessageSource(node, true)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +133,8 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 
 	info.PushName, _ = node.Attrs["notify"].(string)
 	info.Category, _ = node.Attrs["category"].(string)
+	edit, _ := node.Attrs["edit"].(string)
+	info.Edit = types.EditAttribute(edit)
 
 	return &info, nil
 }
@@ -109,8 +143,14 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 	go cli.sendAck(node)
 	if len(node.GetChildrenByTag("unavailable")) == len(node.GetChildren()) {
 		cli.Log.Warnf("Unavailable message %s from %s", info.ID, info.SourceString())
-		go cli.sendRetryReceipt(node, true)
-		cli.dispatchEvent(&events.UndecryptableMessage{Info: *info, IsUnavailable: true})
+		cli.handleUndecryptableMessage(node, &events.UndecryptableMessage{Info: *info, IsUnavailable: true}, true)
+		if cli.AutomaticMessageRerequestFromPhone {
+			go cli.delayedRequestMessageFromPhone(info)
+		}
+		return
+	} else if cli.isDuplicateMessage(info) {
+		cli.Log.Debugf("Ignoring duplicate message %s from %s", info.ID, info.SourceString())
+		go cli.sendMessageReceipt(info)
 		return
 	}
 	children := node.GetChildren()
@@ -127,7 +167,9 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		var decrypted []byte
 		var err error
 		if encType == "pkmsg" || encType == "msg" {
+			unlock := cli.sessionLocks.Lock(info.Sender.SignalAddress().String())
 			decrypted, err = cli.decryptDM(&child, info.Sender, encType == "pkmsg")
+			unlock()
 		} else if info.IsGroup && encType == "skmsg" {
 			decrypted, err = cli.decryptGroupMsg(&child, info.Sender, info.Chat)
 		} else {
@@ -136,8 +178,14 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		}
 		if err != nil {
 			cli.Log.Warnf("Error decrypting message from %s: %v", info.SourceString(), err)
-			go cli.sendRetryReceipt(node, false)
-			cli.dispatchEvent(&events.UndecryptableMessage{Info: *info, IsUnavailable: false})
+			failMode := classifyDecryptError(err)
+			cli.captureDecryptionFailure(info, node, &child, encType, err, failMode)
+			cli.handleUndecryptableMessage(node, &events.UndecryptableMessage{
+				Info:           *info,
+				FailMode:       failMode,
+				Error:          err,
+				CiphertextType: encType,
+			}, false)
 			return
 		}
 
@@ -152,10 +200,38 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		handled = true
 	}
 	if handled {
+		cli.markMessageHandled(info)
+		cli.cancelDelayedRequestFromPhone(info.ID)
 		go cli.sendMessageReceipt(info)
 	}
 }
 
+// classifyDecryptError finds the reason for a decryption failure from the error returned by libsignal.
+func classifyDecryptError(err error) events.DecryptFailMode {
+	switch {
+	case errors.Is(err, signalerror.ErrNoSessionForUser),
+		errors.Is(err, signalerror.ErrUninitializedSession),
+		errors.Is(err, signalerror.ErrNoSenderKeyForUser),
+		errors.Is(err, signalerror.ErrNoSenderKeyStatesInRecord),
+		errors.Is(err, signalerror.ErrNoSenderKeyStateForID):
+		return events.DecryptFailNoSession
+	case errors.Is(err, signalerror.ErrOldCounter):
+		return events.DecryptFailDuplicate
+	// libsignal returns ErrNoValidSessions when decrypting fails with all stored session states,
+	// which is what happens when the MAC doesn't match.
+	case errors.Is(err, signalerror.ErrBadMAC),
+		errors.Is(err, signalerror.ErrNoValidSessions),
+		errors.Is(err, signalerror.ErrSenderKeyStateVerificationFailed):
+		return events.DecryptFailBadMAC
+	case errors.Is(err, signalerror.ErrWrongMessageVersion),
+		errors.Is(err, signalerror.ErrOldMessageVersion),
+		errors.Is(err, signalerror.ErrUnknownMessageVersion):
+		return events.DecryptFailUnsupportedVersion
+	default:
+		return events.DecryptFailUnknown
+	}
+}
+
 func (cli *Client) decryptDM(child *waBinary.Node, from types.JID, isPreKey bool) ([]byte, error) {
 	content, _ := child.Content.([]byte)
 
@@ -273,6 +349,7 @@ func (cli *Client) handleHistorySyncNotification(notif *waProto.HistorySyncNotif
 		cli.dispatchEvent(&events.HistorySync{
 			Data: &historySync,
 		})
+		cli.dispatchHistorySyncSystemMessages(&historySync)
 	}
 }
 
@@ -315,13 +392,23 @@ func (cli *Client) handleProtocolMessage(info *types.MessageInfo, msg *waProto.M
 		cli.handleAppStateSyncKeyShare(protoMsg.AppStateSyncKeyShare)
 	}
 
+	if protoMsg.GetType() == waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_RESPONSE_MESSAGE && info.IsFromMe {
+		cli.handlePlaceholderResendResponse(protoMsg)
+	}
+
 	if info.Category == "peer" {
 		cli.sendProtocolMessageReceipt(info.ID, "peer_msg")
 	}
 }
 
 func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.Message) {
-	evt := &events.Message{Info: *info, RawMessage: msg}
+	cli.handlePlaintextMessage(info, msg, "")
+}
+
+// handlePlaintextMessage handles a decrypted message. If the message was resent by the primary device in response
+// to an unavailable message request, unavailableRequestID is the ID of the request.
+func (cli *Client) handlePlaintextMessage(info *types.MessageInfo, msg *waProto.Message, unavailableRequestID types.MessageID) {
+	evt := &events.Message{Info: *info, RawMessage: msg, UnavailableRequestID: unavailableRequestID}
 
 	// First unwrap device sent messages
 	if msg.GetDeviceSentMessage().GetMessage() != nil {
@@ -332,6 +419,8 @@ func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.
 		}
 	}
 
+	cli.storeMessageSecret(info.Chat, info.Sender, info.ID, msg)
+
 	if msg.GetSenderKeyDistributionMessage() != nil {
 		if !info.IsGroup {
 			cli.Log.Warnf("Got sender key distribution message in non-group chat from", info.Sender)
@@ -343,7 +432,7 @@ func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.
 		go cli.handleProtocolMessage(info, msg)
 	}
 
-	// Unwrap ephemeral and view-once messages
+	// Unwrap ephemeral, view-once and document-with-caption messages
 	// Hopefully sender key distribution messages and protocol messages can't be inside ephemeral messages
 	if msg.GetEphemeralMessage().GetMessage() != nil {
 		msg = msg.GetEphemeralMessage().GetMessage()
@@ -352,10 +441,62 @@ func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.
 	if msg.GetViewOnceMessage().GetMessage() != nil {
 		msg = msg.GetViewOnceMessage().GetMessage()
 		evt.IsViewOnce = true
+		cli.addViewOnceMessage(info, msg)
+	}
+	if msg.GetAudioMessage() != nil && !info.IsFromMe {
+		cli.addAudioMessage(info)
+	}
+	if msg.GetDocumentWithCaptionMessage().GetMessage() != nil {
+		msg = msg.GetDocumentWithCaptionMessage().GetMessage()
+		evt.IsDocumentWithCaption = true
 	}
 	evt.Message = msg
+	cli.trackEphemeralExpiration(evt)
 
-	cli.dispatchEvent(evt)
+	var liveLocationEvt *events.LiveLocation
+	if msg.GetLiveLocationMessage() != nil {
+		liveLocationEvt = cli.parseLiveLocation(&evt.Info, msg.GetLiveLocationMessage())
+	}
+	var reactionEvt *events.Reaction
+	if reaction := msg.GetReactionMessage(); reaction.GetKey() != nil {
+		reactionEvt = &events.Reaction{
+			Info:         evt.Info,
+			TargetID:     reaction.GetKey().GetId(),
+			TargetSender: cli.getKeySender(&evt.Info, reaction.GetKey()),
+			Emoji:        reaction.GetText(),
+			Remove:       len(reaction.GetText()) == 0,
+		}
+	}
+	pollEvt := cli.trackPoll(evt)
+	responseEvt := parseInteractiveResponse(evt)
+	var revokeEvt *events.MessageRevoke
+	if target, ok := cli.GetRevokeTarget(evt); ok {
+		revokeEvt = &events.MessageRevoke{Info: evt.Info, Target: *target}
+	}
+
+	grouped, albumEvt := cli.trackAlbum(evt)
+
+	cli.trackUnread(evt)
+	if !grouped {
+		cli.dispatchEvent(evt)
+	} else if albumEvt != nil {
+		cli.dispatchEvent(albumEvt)
+	}
+	if liveLocationEvt != nil {
+		cli.dispatchEvent(liveLocationEvt)
+	}
+	if revokeEvt != nil {
+		cli.dispatchEvent(revokeEvt)
+	}
+	if reactionEvt != nil {
+		cli.dispatchEvent(reactionEvt)
+	}
+	if pollEvt != nil {
+		cli.dispatchEvent(pollEvt)
+	}
+	if responseEvt != nil {
+		cli.dispatchEvent(responseEvt)
+	}
 }
 
 func (cli *Client) sendProtocolMessageReceipt(id, msgType string) {
@@ -375,3 +516,45 @@ func (cli *Client) sendProtocolMessageReceipt(id, msgType string) {
 		cli.Log.Warnf("Failed to send acknowledgement for protocol message %s: %v", id, err)
 	}
 }
+
+// getKeySender finds the sender of the message that the given key points at. The key is from the point of view
+// of the sender of the message containing it, so FromMe means the key points at their own message.
+func (cli *Client) getKeySender(info *types.MessageInfo, key *waProto.MessageKey) types.JID {
+	if key.GetFromMe() {
+		return info.Sender.ToNonAD()
+	} else if participant, err := types.ParseJID(key.GetParticipant()); err == nil && !participant.IsEmpty() {
+		return participant.ToNonAD()
+	} else if !info.IsGroup {
+		if info.IsFromMe {
+			return info.Chat
+		} else if cli.Store.ID != nil {
+			return cli.Store.ID.ToNonAD()
+		}
+	}
+	return types.EmptyJID
+}
+
+// GetRevokeTarget returns information about the message that the given revoke (delete for everyone) message deletes,
+// including who deleted it. The bool is false if the message isn't a revoke.
+//
+// In groups, admins can delete messages from other members, in which case the Actor field will be the admin
+// and the Sender field will be the original sender of the deleted message.
+func (cli *Client) GetRevokeTarget(evt *events.Message) (*types.RevokeTarget, bool) {
+	protoMsg := evt.Message.GetProtocolMessage()
+	if protoMsg.GetType() != waProto.ProtocolMessage_REVOKE || protoMsg.GetKey() == nil {
+		return nil, false
+	}
+	key := protoMsg.GetKey()
+	target := &types.RevokeTarget{
+		MessageSource: types.MessageSource{
+			Chat:    evt.Info.Chat,
+			IsGroup: evt.Info.IsGroup,
+		},
+		ID:    key.GetId(),
+		Actor: evt.Info.Sender.ToNonAD(),
+	}
+	target.Sender = cli.getKeySender(&evt.Info, key)
+	target.ByAdmin = evt.Info.Edit == types.EditAttributeAdminRevoke
+	target.IsFromMe = cli.Store.ID != nil && target.Sender.User == cli.Store.ID.User
+	return target, true
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// EventMiddleware is a function that processes events before they're passed to event handlers.
+//
+// The returned value is passed to the next middleware and finally to the event handlers. Middleware can return
+// the event as-is, modify it, or return a completely different event. Returning nil swallows the event,
+// which means that the rest of the middleware and all event handlers are skipped.
+type EventMiddleware func(evt interface{}) interface{}
+
+type wrappedEventMiddleware struct {
+	fn EventMiddleware
+	id uint32
+}
+
+// AddEventMiddleware registers a new middleware that processes all events before event handlers.
+// The returned ID can be passed to RemoveEventMiddleware.
+//
+// Middleware is always called synchronously, in the order it was added, before any event handler receives
+// the event, even if an EventDispatcher is set. Each middleware receives the output of the previous one.
+// This makes it possible to do common pre-processing in one place, like filtering out unwanted events:
+//
+//	cli.AddEventMiddleware(func(evt interface{}) interface{} {
+//		if msg, ok := evt.(*events.Message); ok && msg.Info.IsFromMe {
+//			return nil
+//		}
+//		return evt
+//	})
+//
+// Middleware that wants to attach data to messages without changing them can use types.MessageInfo.Annotations.
+// Like RemoveEventHandler, this must not be called from inside an event middleware.
+func (cli *Client) AddEventMiddleware(middleware EventMiddleware) uint32 {
+	nextID := atomic.AddUint32(&nextHandlerID, 1)
+	cli.eventMiddlewaresLock.Lock()
+	cli.eventMiddlewares = append(cli.eventMiddlewares, wrappedEventMiddleware{middleware, nextID})
+	cli.eventMiddlewaresLock.Unlock()
+	return nextID
+}
+
+// RemoveEventMiddleware removes a previously registered event middleware.
+// If the middleware with the given ID is found, this returns true.
+func (cli *Client) RemoveEventMiddleware(id uint32) bool {
+	cli.eventMiddlewaresLock.Lock()
+	defer cli.eventMiddlewaresLock.Unlock()
+	for index, middleware := range cli.eventMiddlewares {
+		if middleware.id == id {
+			cli.eventMiddlewares = append(cli.eventMiddlewares[:index], cli.eventMiddlewares[index+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// applyEventMiddlewares runs the given event through all registered middleware and returns the result,
+// or nil if a middleware swallowed the event.
+func (cli *Client) applyEventMiddlewares(evt interface{}) interface{} {
+	cli.eventMiddlewaresLock.RLock()
+	defer cli.eventMiddlewaresLock.RUnlock()
+	for _, middleware := range cli.eventMiddlewares {
+		evt = cli.runEventMiddleware(middleware, evt)
+		if evt == nil {
+			return nil
+		}
+	}
+	return evt
+}
+
+// runEventMiddleware runs a single middleware. If the middleware panics, the event is passed on as it was
+// before the middleware, so that a broken middleware doesn't cause events to be lost.
+func (cli *Client) runEventMiddleware(middleware wrappedEventMiddleware, evt interface{}) (output interface{}) {
+	defer func() {
+		if err := recover(); err != nil {
+			cli.Log.Errorf("Event middleware #%d panicked while processing a %T: %v\n%s", middleware.id, evt, err, debug.Stack())
+			output = evt
+		}
+	}()
+	return middleware.fn(evt)
+}
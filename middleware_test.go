@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestEventMiddleware(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	var order []string
+	var received []interface{}
+	cli.AddEventHandler(func(evt interface{}) {
+		received = append(received, evt)
+	})
+	cli.AddEventMiddleware(func(evt interface{}) interface{} {
+		order = append(order, "first")
+		if msg, ok := evt.(*events.Message); ok {
+			msg.Info.Annotations = map[string]interface{}{"seen": true}
+		}
+		return evt
+	})
+	swallowID := cli.AddEventMiddleware(func(evt interface{}) interface{} {
+		order = append(order, "second")
+		if _, ok := evt.(*events.Receipt); ok {
+			return nil
+		}
+		return evt
+	})
+	cli.AddEventMiddleware(func(evt interface{}) interface{} {
+		order = append(order, "third")
+		panic("broken middleware")
+	})
+
+	cli.dispatchEvent(&events.Receipt{})
+	if len(received) != 0 {
+		t.Errorf("Expected swallowed receipt to not reach handlers, got %v", received)
+	}
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("Unexpected middleware order %v", order)
+	}
+
+	order = nil
+	cli.dispatchEvent(&events.Message{Info: types.MessageInfo{ID: "test"}})
+	if len(order) != 3 || order[2] != "third" {
+		t.Errorf("Unexpected middleware order %v", order)
+	}
+	if len(received) != 1 {
+		t.Fatalf("Expected message to reach handler, got %v", received)
+	} else if msg := received[0].(*events.Message); msg.Info.ID != "test" || msg.Info.Annotations["seen"] != true {
+		t.Errorf("Expected annotated message, got %+v", msg.Info)
+	}
+
+	if !cli.RemoveEventMiddleware(swallowID) {
+		t.Errorf("Failed to remove middleware")
+	}
+	cli.dispatchEvent(&events.Receipt{})
+	if len(received) != 2 {
+		t.Errorf("Expected receipt to reach handler after removing middleware")
+	}
+}
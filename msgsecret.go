@@ -0,0 +1,215 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"go.mau.fi/whatsmeow/util/hkdfutil"
+)
+
+type msgSecretType string
+
+const (
+	encSecretPollVote msgSecretType = "Poll Vote"
+)
+
+// DefaultMessageSecretTTL is the default value for Client.MessageSecretTTL.
+const DefaultMessageSecretTTL = 90 * 24 * time.Hour
+
+// generateMsgSecretKey derives the key for encrypting a modification (like a poll vote) of a message
+// from the secret that was included in the original message.
+func generateMsgSecretKey(modificationType msgSecretType, modificationSender types.JID, origMsgID types.MessageID, origMsgSender types.JID, origMsgSecret []byte) ([]byte, []byte) {
+	origMsgSenderStr := origMsgSender.ToNonAD().String()
+	modificationSenderStr := modificationSender.ToNonAD().String()
+
+	useCaseSecret := make([]byte, 0, len(origMsgID)+len(origMsgSenderStr)+len(modificationSenderStr)+len(modificationType))
+	useCaseSecret = append(useCaseSecret, origMsgID...)
+	useCaseSecret = append(useCaseSecret, origMsgSenderStr...)
+	useCaseSecret = append(useCaseSecret, modificationSenderStr...)
+	useCaseSecret = append(useCaseSecret, modificationType...)
+
+	secretKey := hkdfutil.SHA256(origMsgSecret, nil, useCaseSecret, 32)
+	additionalData := []byte(fmt.Sprintf("%s\x00%s", origMsgID, modificationSenderStr))
+	return secretKey, additionalData
+}
+
+func (cli *Client) getOrigMsgSecret(chat, origSender types.JID, origMsgID types.MessageID) ([]byte, error) {
+	if cli.Store.MsgSecrets == nil {
+		return nil, ErrOriginalMessageSecretNotFound
+	}
+	origMsgSecret, err := cli.Store.MsgSecrets.GetMessageSecret(chat, origSender, origMsgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original message secret key: %w", err)
+	} else if origMsgSecret == nil {
+		return nil, ErrOriginalMessageSecretNotFound
+	}
+	return origMsgSecret, nil
+}
+
+func gcmCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func (cli *Client) encryptMsgSecret(chat, origSender types.JID, origMsgID types.MessageID, modificationType msgSecretType, plaintext []byte) (ciphertext, iv []byte, err error) {
+	ownID := cli.Store.ID
+	if ownID == nil {
+		return nil, nil, ErrNotLoggedIn
+	}
+	origMsgSecret, err := cli.getOrigMsgSecret(chat, origSender, origMsgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	secretKey, additionalData := generateMsgSecretKey(modificationType, *ownID, origMsgID, origSender, origMsgSecret)
+	gcm, err := gcmCipher(secretKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv = randomBytes(12)
+	ciphertext = gcm.Seal(nil, iv, plaintext, additionalData)
+	return ciphertext, iv, nil
+}
+
+func (cli *Client) decryptMsgSecret(msg *events.Message, origMsgKey *waProto.MessageKey, modificationType msgSecretType, ciphertext, iv []byte) ([]byte, error) {
+	origSender := cli.getKeySender(&msg.Info, origMsgKey)
+	origMsgSecret, err := cli.getOrigMsgSecret(msg.Info.Chat, origSender, origMsgKey.GetId())
+	if err != nil {
+		return nil, err
+	}
+	secretKey, additionalData := generateMsgSecretKey(modificationType, msg.Info.Sender, origMsgKey.GetId(), origSender, origMsgSecret)
+	gcm, err := gcmCipher(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, iv, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret message: %w", err)
+	}
+	return plaintext, nil
+}
+
+// storeMessageSecret saves the message secret of the given message (if it has one),
+// so that modifications like poll votes can be encrypted and decrypted later.
+func (cli *Client) storeMessageSecret(chat, sender types.JID, id types.MessageID, msg *waProto.Message) {
+	secret := msg.GetMessageContextInfo().GetMessageSecret()
+	if len(secret) == 0 || cli.Store.MsgSecrets == nil {
+		return
+	}
+	err := cli.Store.MsgSecrets.PutMessageSecret(chat, sender, id, secret)
+	if err != nil {
+		cli.Log.Warnf("Failed to store message secret of %s: %v", id, err)
+	}
+}
+
+// pruneMessageSecrets removes message secrets older than Client.MessageSecretTTL from the store.
+func (cli *Client) pruneMessageSecrets() {
+	pruner, ok := cli.Store.MsgSecrets.(store.MsgSecretPruneStore)
+	if !ok || cli.MessageSecretTTL <= 0 {
+		return
+	}
+	err := pruner.DeleteOldMessageSecrets(time.Now().Add(-cli.MessageSecretTTL))
+	if err != nil {
+		cli.Log.Warnf("Failed to prune old message secrets: %v", err)
+	}
+}
+
+// HashPollOptions hashes poll option names using SHA-256 for use as the SelectedOptions field in PollVoteMessage.
+func HashPollOptions(optionNames []string) [][]byte {
+	optionHashes := make([][]byte, len(optionNames))
+	for i, option := range optionNames {
+		optionHash := sha256.Sum256([]byte(option))
+		optionHashes[i] = optionHash[:]
+	}
+	return optionHashes
+}
+
+// BuildPollCreation builds a poll creation message with the given poll name, options and maximum number of selections.
+// The built message can be sent normally using Client.SendMessage. Pass 0 as selectableOptionCount to allow any number
+// of selections.
+//
+// The message includes a random secret that is required for voting and decrypting votes. The secret is saved in the
+// store when the message is sent, so votes to the poll can be decrypted with DecryptPollVote.
+func (cli *Client) BuildPollCreation(name string, optionNames []string, selectableOptionCount int) *waProto.Message {
+	options := make([]*waProto.PollCreationMessage_Option, len(optionNames))
+	for i, option := range optionNames {
+		options[i] = &waProto.PollCreationMessage_Option{OptionName: proto.String(option)}
+	}
+	if selectableOptionCount < 0 || selectableOptionCount > len(optionNames) {
+		selectableOptionCount = 0
+	}
+	return &waProto.Message{
+		PollCreationMessage: &waProto.PollCreationMessage{
+			Name:                   proto.String(name),
+			Options:                options,
+			SelectableOptionsCount: proto.Uint32(uint32(selectableOptionCount)),
+		},
+		MessageContextInfo: &waProto.MessageContextInfo{
+			MessageSecret: randomBytes(32),
+		},
+	}
+}
+
+// BuildPollVote builds a poll vote message using the given poll message info and option names.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The poll creation message must have been received or sent by this client, so that its secret is in the store.
+// Pass an empty list of options to remove the previous vote.
+func (cli *Client) BuildPollVote(pollInfo *types.MessageInfo, optionNames []string) (*waProto.Message, error) {
+	plaintext, err := proto.Marshal(&waProto.PollVoteMessage{SelectedOptions: HashPollOptions(optionNames)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal poll vote protobuf: %w", err)
+	}
+	ciphertext, iv, err := cli.encryptMsgSecret(pollInfo.Chat, pollInfo.Sender, pollInfo.ID, encSecretPollVote, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt poll vote: %w", err)
+	}
+	return &waProto.Message{
+		PollUpdateMessage: &waProto.PollUpdateMessage{
+			PollCreationMessageKey: cli.buildMessageKey(pollInfo.Chat, pollInfo.Sender, pollInfo.ID),
+			Vote: &waProto.PollEncValue{
+				EncPayload: ciphertext,
+				EncIv:      iv,
+			},
+			SenderTimestampMs: proto.Int64(time.Now().UnixMilli()),
+		},
+	}, nil
+}
+
+// DecryptPollVote decrypts a poll update message. The vote itself includes SHA-256 hashes of the selected options,
+// which can be compared to the output of HashPollOptions with the option names of the poll.
+//
+// The poll creation message must have been received or sent by this client, so that its secret is in the store.
+func (cli *Client) DecryptPollVote(vote *events.Message) (*waProto.PollVoteMessage, error) {
+	pollUpdate := vote.Message.GetPollUpdateMessage()
+	if pollUpdate == nil || pollUpdate.GetPollCreationMessageKey() == nil {
+		return nil, ErrNotPollUpdateMessage
+	}
+	plaintext, err := cli.decryptMsgSecret(vote, pollUpdate.GetPollCreationMessageKey(), encSecretPollVote, pollUpdate.GetVote().GetEncPayload(), pollUpdate.GetVote().GetEncIv())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt poll vote: %w", err)
+	}
+	var parsed waProto.PollVoteMessage
+	err = proto.Unmarshal(plaintext, &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse poll vote protobuf: %w", err)
+	}
+	return &parsed, nil
+}
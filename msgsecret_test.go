@@ -0,0 +1,96 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+type memoryMsgSecretStore map[string][]byte
+
+func (s memoryMsgSecretStore) PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error {
+	s[chat.ToNonAD().String()+sender.ToNonAD().String()+id] = secret
+	return nil
+}
+
+func (s memoryMsgSecretStore) GetMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error) {
+	return s[chat.ToNonAD().String()+sender.ToNonAD().String()+id], nil
+}
+
+func TestPollVoteRoundTrip(t *testing.T) {
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	aliceID := types.NewADJID("1111111111", 0, 1)
+	bobID := types.NewADJID("2222222222", 0, 3)
+	alice := NewClient(&store.Device{ID: &aliceID, MsgSecrets: memoryMsgSecretStore{}}, nil)
+	bob := NewClient(&store.Device{ID: &bobID, MsgSecrets: memoryMsgSecretStore{}}, nil)
+
+	poll := alice.BuildPollCreation("Lunch?", []string{"Pizza", "Sushi", "Salad"}, 1)
+	pollInfo := types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: group, Sender: aliceID, IsGroup: true},
+		ID:            "POLL",
+	}
+	alice.storeMessageSecret(group, aliceID, pollInfo.ID, poll)
+
+	_, err := bob.BuildPollVote(&pollInfo, []string{"Sushi"})
+	if !errors.Is(err, ErrOriginalMessageSecretNotFound) {
+		t.Fatalf("Expected ErrOriginalMessageSecretNotFound before receiving poll, got %v", err)
+	}
+	bob.storeMessageSecret(group, aliceID, pollInfo.ID, poll)
+	vote, err := bob.BuildPollVote(&pollInfo, []string{"Sushi"})
+	if err != nil {
+		t.Fatalf("Failed to build poll vote: %v", err)
+	}
+
+	decrypted, err := alice.DecryptPollVote(&events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{Chat: group, Sender: bobID, IsGroup: true},
+			ID:            "VOTE",
+		},
+		Message: vote,
+	})
+	if err != nil {
+		t.Fatalf("Failed to decrypt poll vote: %v", err)
+	}
+	expected := HashPollOptions([]string{"Sushi"})[0]
+	if len(decrypted.GetSelectedOptions()) != 1 || !bytes.Equal(decrypted.GetSelectedOptions()[0], expected) {
+		t.Errorf("Unexpected selected options %x", decrypted.GetSelectedOptions())
+	}
+}
+
+type pruningMsgSecretStore struct {
+	memoryMsgSecretStore
+	prunedBefore time.Time
+}
+
+func (s *pruningMsgSecretStore) DeleteOldMessageSecrets(before time.Time) error {
+	s.prunedBefore = before
+	return nil
+}
+
+func TestPruneMessageSecrets(t *testing.T) {
+	secrets := &pruningMsgSecretStore{memoryMsgSecretStore: memoryMsgSecretStore{}}
+	cli := NewClient(&store.Device{MsgSecrets: secrets}, nil)
+	cli.pruneMessageSecrets()
+	expected := time.Now().Add(-DefaultMessageSecretTTL)
+	if diff := secrets.prunedBefore.Sub(expected); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("Expected secrets before %s to be pruned, got %s", expected, secrets.prunedBefore)
+	}
+
+	secrets.prunedBefore = time.Time{}
+	cli.MessageSecretTTL = 0
+	cli.pruneMessageSecrets()
+	if !secrets.prunedBefore.IsZero() {
+		t.Errorf("Secrets shouldn't be pruned when MessageSecretTTL is zero")
+	}
+}
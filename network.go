@@ -0,0 +1,102 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// NotifyNetworkChanged tells the client that the network connection of the host has changed (e.g. switched from
+// wifi to mobile data), which usually means the current websocket is dead even if it hasn't noticed yet.
+//
+// If the client is connected or trying to connect, the websocket is closed and reconnected immediately instead
+// of waiting for keepalives to time out. If the immediate reconnection fails, the normal auto-reconnect
+// logic takes over. If the client is already reconnecting, the running reconnection loop is told to skip its
+// current delay instead of starting another reconnection in parallel. Nothing happens if the client is disconnected.
+func (cli *Client) NotifyNetworkChanged() {
+	if cli.GetConnectionState() == types.ConnectionStateDisconnected || cli.Store.ID == nil {
+		return
+	}
+	if !atomic.CompareAndSwapUint32(&cli.isAutoReconnecting, 0, 1) {
+		cli.Log.Debugf("Network changed while already reconnecting, retrying immediately")
+		cli.wakeAutoReconnect()
+		return
+	}
+	cli.Log.Infof("Network changed, reconnecting immediately")
+	cli.Disconnect()
+	go cli.dispatchEvent(&events.Disconnected{})
+	err := cli.Connect()
+	if err != nil {
+		cli.Log.Warnf("Failed to reconnect after network change: %v", err)
+		// autoReconnect takes over the isAutoReconnecting flag, so the state stays as reconnecting in between
+		go cli.autoReconnect()
+	} else {
+		atomic.StoreUint32(&cli.isAutoReconnecting, 0)
+	}
+}
+
+// networkFingerprint returns a string describing the addresses of all non-loopback network interfaces that are up.
+func networkFingerprint() (string, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	var parts []string
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			parts = append(parts, iface.Name+"="+addr.String())
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ","), nil
+}
+
+// StartNetworkMonitor polls the addresses of the host's network interfaces at the given interval and calls
+// NotifyNetworkChanged when they change. The monitor stops when the context is canceled.
+//
+// Applications that get network change notifications from the OS should call NotifyNetworkChanged directly instead.
+func (cli *Client) StartNetworkMonitor(ctx context.Context, interval time.Duration) {
+	go func() {
+		prev, err := networkFingerprint()
+		if err != nil {
+			cli.Log.Warnf("Failed to read network interfaces, network monitor won't work: %v", err)
+			return
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				current, err := networkFingerprint()
+				if err != nil {
+					cli.Log.Debugf("Failed to read network interfaces: %v", err)
+				} else if current != prev {
+					cli.Log.Debugf("Network interfaces changed from [%s] to [%s]", prev, current)
+					prev = current
+					cli.NotifyNetworkChanged()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// newUnreachableClient creates a logged in client whose connection attempts are counted and always fail.
+func newUnreachableClient(t *testing.T) (*Client, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	ownID := types.NewADJID("1111111111", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	cli.WebsocketURLs = []string{"ws" + strings.TrimPrefix(server.URL, "http")}
+	return cli, &attempts
+}
+
+func TestNotifyNetworkChangedWhileReconnecting(t *testing.T) {
+	cli, attempts := newUnreachableClient(t)
+	cli.setConnectionState(types.ConnectionStateReconnecting)
+	atomic.StoreUint32(&cli.isAutoReconnecting, 1)
+
+	cli.NotifyNetworkChanged()
+	if n := atomic.LoadInt32(attempts); n != 0 {
+		t.Errorf("Expected no parallel reconnection attempt, got %d", n)
+	}
+	select {
+	case <-cli.autoReconnectWake:
+	default:
+		t.Errorf("Expected the running reconnection loop to be woken up")
+	}
+	if atomic.LoadUint32(&cli.isAutoReconnecting) != 1 {
+		t.Errorf("Expected the reconnecting flag of the running loop to be kept")
+	}
+}
+
+func TestNetworkChangeWakesAutoReconnect(t *testing.T) {
+	cli, attempts := newUnreachableClient(t)
+	cli.AutoReconnectBaseDelay = time.Hour
+	cli.AutoReconnectMaxDelay = time.Hour
+	hookCalls := make(chan int, 2)
+	cli.PreReconnectHook = func(attempt int, delay time.Duration) bool {
+		hookCalls <- attempt
+		return attempt < 2
+	}
+	cli.setConnectionState(types.ConnectionStateReconnecting)
+	done := make(chan struct{})
+	go func() {
+		cli.autoReconnect()
+		close(done)
+	}()
+
+	select {
+	case <-hookCalls:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Reconnection loop didn't start")
+	}
+	cli.NotifyNetworkChanged()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Network change didn't interrupt the reconnection delay")
+	}
+	if n := atomic.LoadInt32(attempts); n != 1 {
+		t.Errorf("Expected exactly one connection attempt from the reconnection loop, got %d", n)
+	}
+	if atomic.LoadUint32(&cli.isAutoReconnecting) != 0 {
+		t.Errorf("Expected reconnecting flag to be cleared after the loop stopped")
+	} else if state := cli.GetConnectionState(); state != types.ConnectionStateDisconnected {
+		t.Errorf("Expected disconnected state after the loop stopped, got %s", state)
+	}
+}
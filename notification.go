@@ -116,9 +116,14 @@ func (cli *Client) handleNotification(node *waBinary.Node) {
 		if err != nil {
 			cli.Log.Errorf("Failed to parse group notification: %v", err)
 		} else {
+			cli.updateGroupCache(evt)
 			go cli.dispatchEvent(evt)
 		}
 	case "picture":
 		go cli.handlePictureNotification(node)
+	case "mediaretry":
+		go cli.handleMediaRetryNotification(node)
+	case "link_code_companion_reg":
+		go cli.tryHandleCodePairNotification(node)
 	}
 }
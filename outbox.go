@@ -0,0 +1,235 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// DefaultOutboxMaxAttempts is the default value for Client.OutboxMaxAttempts.
+const DefaultOutboxMaxAttempts = 10
+
+const (
+	outboxRetryBaseDelay = 5 * time.Second
+	outboxRetryMaxDelay  = 10 * time.Minute
+)
+
+// outboxRetryDelay returns how long to wait before retrying a message that has failed the given number of times.
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := 1; i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxRetryMaxDelay {
+		delay = outboxRetryMaxDelay
+	}
+	return delay
+}
+
+// isPermanentSendError returns true if retrying the send can't fix the given error.
+func isPermanentSendError(err error) bool {
+	return errors.Is(err, ErrRecipientADJID) || errors.Is(err, ErrUnknownServer) ||
+		errors.Is(err, ErrBroadcastListUnsupported) || errors.Is(err, ErrFeatureNotAvailable) ||
+		errors.Is(err, ErrNoStatusRecipients)
+}
+
+// QueueMessage saves the given message in the outbox and returns the ID it will be sent with.
+//
+// The message is sent in the background as soon as the client is connected, and retried with exponential backoff
+// if sending fails, including across restarts. Once the server acknowledges the message, events.OutboxDelivered
+// is emitted. If sending fails permanently or Client.OutboxMaxAttempts is reached, events.OutboxFailed is emitted.
+//
+// The outbox must be enabled with Client.EnableOutbox.
+func (cli *Client) QueueMessage(to types.JID, message *waProto.Message) (types.MessageID, error) {
+	return cli.queueOutboxMessage(to, message, time.Time{})
+}
+
+func (cli *Client) outboxEnabled() bool {
+	return cli.EnableOutbox && cli.Store.Outbox != nil
+}
+
+func (cli *Client) wakeOutbox() {
+	select {
+	case cli.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// queueOutboxMessage saves a message in the outbox. If sendAt is not zero, the first attempt is made at that time.
+func (cli *Client) queueOutboxMessage(to types.JID, message *waProto.Message, sendAt time.Time) (types.MessageID, error) {
+	if !cli.outboxEnabled() {
+		return "", ErrOutboxDisabled
+	} else if to.AD {
+		return "", ErrRecipientADJID
+	}
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message: %w", err)
+	}
+	now := time.Now()
+	msg := &store.OutboxMessage{
+		ID:          cli.GenerateMessageID(),
+		Chat:        to,
+		Message:     plaintext,
+		QueuedAt:    now,
+		NextAttempt: now,
+		SendAt:      sendAt,
+	}
+	if !sendAt.IsZero() {
+		msg.NextAttempt = sendAt
+	}
+	err = cli.Store.Outbox.PutOutboxMessage(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to save message to outbox: %w", err)
+	}
+	cli.wakeOutbox()
+	return msg.ID, nil
+}
+
+// startOutbox starts sending the messages in the outbox for the current connection.
+func (cli *Client) startOutbox() {
+	if !cli.outboxEnabled() {
+		return
+	}
+	cli.socketLock.RLock()
+	sock := cli.socket
+	cli.socketLock.RUnlock()
+	if sock != nil {
+		go cli.outboxLoop(sock.Context())
+	}
+}
+
+func (cli *Client) outboxLoop(ctx context.Context) {
+	for {
+		nextAttempt := cli.processOutbox(ctx)
+		var timer *time.Timer
+		var timerChan <-chan time.Time
+		if !nextAttempt.IsZero() {
+			timer = time.NewTimer(time.Until(nextAttempt))
+			timerChan = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-cli.outboxWake:
+		case <-timerChan:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// processOutbox sends all outbox messages that are due and returns the time when the next message is due.
+func (cli *Client) processOutbox(ctx context.Context) (nextAttempt time.Time) {
+	cli.outboxLock.Lock()
+	cli.outboxStale = make(map[types.MessageID]struct{})
+	cli.outboxLock.Unlock()
+	msgs, err := cli.Store.Outbox.GetOutboxMessages()
+	if err != nil {
+		cli.Log.Errorf("Failed to get outbox messages: %v", err)
+		return time.Now().Add(outboxRetryBaseDelay)
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return
+		}
+		if !msg.NextAttempt.After(time.Now()) {
+			if !cli.claimOutboxMessage(msg.ID) {
+				// The message was canceled or rescheduled after the outbox was fetched.
+				// Rescheduling wakes up the outbox loop, so the new send time is handled in the next round.
+				continue
+			}
+			sent := cli.sendOutboxMessage(ctx, msg)
+			cli.releaseOutboxMessage(msg.ID)
+			if sent {
+				continue
+			}
+		}
+		if nextAttempt.IsZero() || msg.NextAttempt.Before(nextAttempt) {
+			nextAttempt = msg.NextAttempt
+		}
+	}
+	return
+}
+
+// claimOutboxMessage marks the given outbox message as being sent, so that it can't be canceled or rescheduled anymore.
+// It returns false if the message was changed after processOutbox fetched the outbox, in which case it must not be sent.
+func (cli *Client) claimOutboxMessage(id types.MessageID) bool {
+	cli.outboxLock.Lock()
+	defer cli.outboxLock.Unlock()
+	if _, stale := cli.outboxStale[id]; stale {
+		return false
+	}
+	cli.outboxSending[id] = struct{}{}
+	return true
+}
+
+func (cli *Client) releaseOutboxMessage(id types.MessageID) {
+	cli.outboxLock.Lock()
+	delete(cli.outboxSending, id)
+	cli.outboxLock.Unlock()
+}
+
+// sendOutboxMessage tries to send a single outbox message. It returns false if the message is still in the outbox.
+func (cli *Client) sendOutboxMessage(ctx context.Context, msg *store.OutboxMessage) bool {
+	var parsed waProto.Message
+	var resp SendResponse
+	err := proto.Unmarshal(msg.Message, &parsed)
+	permanent := err != nil
+	if err != nil {
+		err = fmt.Errorf("failed to unmarshal message: %w", err)
+	} else if late := time.Since(msg.SendAt); !msg.SendAt.IsZero() && msg.Attempts == 0 &&
+		cli.ScheduledMessageMaxDelay > 0 && late > cli.ScheduledMessageMaxDelay {
+		err = fmt.Errorf("%w (%s late)", ErrScheduledMessageTooLate, late.Truncate(time.Second))
+		permanent = true
+	} else {
+		resp, err = cli.SendMessageContext(ctx, msg.Chat, msg.ID, &parsed)
+		permanent = isPermanentSendError(err)
+	}
+	msg.Attempts++
+	if err == nil {
+		cli.Log.Debugf("Sent outbox message %s to %s after %d attempts", msg.ID, msg.Chat, msg.Attempts)
+		cli.deleteOutboxMessage(msg.ID)
+		cli.dispatchEvent(&events.OutboxDelivered{ID: msg.ID, Chat: msg.Chat, Timestamp: resp.Timestamp, Attempts: msg.Attempts, SendAt: msg.SendAt})
+		return true
+	} else if ctx.Err() != nil {
+		// Disconnected while sending, try again without counting the attempt after reconnecting
+		msg.Attempts--
+		return false
+	} else if permanent || msg.Attempts >= cli.OutboxMaxAttempts {
+		cli.Log.Warnf("Giving up on outbox message %s to %s after %d attempts: %v", msg.ID, msg.Chat, msg.Attempts, err)
+		cli.deleteOutboxMessage(msg.ID)
+		cli.dispatchEvent(&events.OutboxFailed{ID: msg.ID, Chat: msg.Chat, Error: err, Attempts: msg.Attempts, SendAt: msg.SendAt})
+		return true
+	}
+	msg.NextAttempt = time.Now().Add(outboxRetryDelay(msg.Attempts))
+	cli.Log.Warnf("Failed to send outbox message %s to %s (attempt #%d), retrying at %s: %v", msg.ID, msg.Chat, msg.Attempts, msg.NextAttempt, err)
+	if putErr := cli.Store.Outbox.PutOutboxMessage(msg); putErr != nil {
+		cli.Log.Errorf("Failed to update attempt info of outbox message %s: %v", msg.ID, putErr)
+	}
+	return false
+}
+
+func (cli *Client) deleteOutboxMessage(id types.MessageID) {
+	err := cli.Store.Outbox.DeleteOutboxMessage(id)
+	if err != nil {
+		cli.Log.Errorf("Failed to delete message %s from outbox: %v", id, err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+type memoryOutboxStore struct {
+	msgs []*store.OutboxMessage
+}
+
+func (s *memoryOutboxStore) PutOutboxMessage(msg *store.OutboxMessage) error {
+	for i, existing := range s.msgs {
+		if existing.ID == msg.ID {
+			s.msgs[i] = msg
+			return nil
+		}
+	}
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
+
+func (s *memoryOutboxStore) GetOutboxMessages() ([]*store.OutboxMessage, error) {
+	return s.msgs, nil
+}
+
+func (s *memoryOutboxStore) DeleteOutboxMessage(id types.MessageID) error {
+	for i, existing := range s.msgs {
+		if existing.ID == id {
+			s.msgs = append(s.msgs[:i], s.msgs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func TestQueueMessage(t *testing.T) {
+	outbox := &memoryOutboxStore{}
+	cli := NewClient(&store.Device{Outbox: outbox}, nil)
+	to := types.NewJID("1234567890", types.DefaultUserServer)
+	if _, err := cli.QueueMessage(to, cli.BuildText("hi")); !errors.Is(err, ErrOutboxDisabled) {
+		t.Fatalf("Expected ErrOutboxDisabled, got %v", err)
+	}
+	cli.EnableOutbox = true
+	id, err := cli.QueueMessage(to, cli.BuildText("hi"))
+	if err != nil {
+		t.Fatalf("Failed to queue message: %v", err)
+	} else if len(outbox.msgs) != 1 || outbox.msgs[0].ID != id || outbox.msgs[0].Chat != to {
+		t.Errorf("Queued message wasn't stored correctly: %+v", outbox.msgs)
+	}
+	select {
+	case <-cli.outboxWake:
+	default:
+		t.Errorf("Queueing didn't wake up the outbox")
+	}
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	if delay := outboxRetryDelay(1); delay != outboxRetryBaseDelay {
+		t.Errorf("Expected first retry after %s, got %s", outboxRetryBaseDelay, delay)
+	}
+	if delay := outboxRetryDelay(3); delay != 4*outboxRetryBaseDelay {
+		t.Errorf("Expected third retry after %s, got %s", 4*outboxRetryBaseDelay, delay)
+	}
+	if delay := outboxRetryDelay(100); delay != outboxRetryMaxDelay {
+		t.Errorf("Expected delay to be capped at %s, got %s", outboxRetryMaxDelay, delay)
+	}
+}
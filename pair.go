@@ -7,7 +7,6 @@
 package whatsmeow
 
 import (
-	"bytes"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -96,11 +95,54 @@ func (cli *Client) handlePairSuccess(node *waBinary.Node) {
 			cli.dispatchEvent(&events.PairError{ID: jid, BusinessName: businessName, Platform: platform, Error: err})
 		} else {
 			cli.Log.Infof("Successfully paired %s", cli.Store.ID)
+			cli.setPendingPairComplete(&events.PairComplete{ID: jid, BusinessName: businessName, Platform: platform})
 			cli.dispatchEvent(&events.PairSuccess{ID: jid, BusinessName: businessName, Platform: platform})
 		}
 	}()
 }
 
+func (cli *Client) setPendingPairComplete(evt *events.PairComplete) {
+	cli.pendingPairCompleteLock.Lock()
+	cli.pendingPairComplete = evt
+	cli.pendingPairCompleteLock.Unlock()
+}
+
+func (cli *Client) hasPendingPairComplete() bool {
+	cli.pendingPairCompleteLock.Lock()
+	defer cli.pendingPairCompleteLock.Unlock()
+	return cli.pendingPairComplete != nil
+}
+
+func (cli *Client) takePendingPairComplete() *events.PairComplete {
+	cli.pendingPairCompleteLock.Lock()
+	evt := cli.pendingPairComplete
+	cli.pendingPairComplete = nil
+	cli.pendingPairCompleteLock.Unlock()
+	return evt
+}
+
+// fetchPairedVerifiedName fetches the verified name certificate of a newly paired business account
+// and updates the business name in the store if necessary.
+func (cli *Client) fetchPairedVerifiedName(evt *events.PairComplete) {
+	ownJID := evt.ID.ToNonAD()
+	info, err := cli.GetUserInfo([]types.JID{ownJID})
+	if err != nil {
+		cli.Log.Warnf("Failed to get verified name of paired business account: %v", err)
+		return
+	}
+	evt.VerifiedName = info[ownJID].VerifiedName
+	verifiedName := evt.VerifiedName.GetName()
+	if len(verifiedName) > 0 && verifiedName != cli.Store.BusinessName {
+		cli.Log.Debugf("Updating business name from %q to verified name %q", cli.Store.BusinessName, verifiedName)
+		cli.Store.BusinessName = verifiedName
+		evt.BusinessName = verifiedName
+		err = cli.Store.Save()
+		if err != nil {
+			cli.Log.Warnf("Failed to save device store after updating business name: %v", err)
+		}
+	}
+}
+
 func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, platform string, jid types.JID) error {
 	var deviceIdentityContainer waProto.ADVSignedDeviceIdentityHMAC
 	err := proto.Unmarshal(deviceIdentityBytes, &deviceIdentityContainer)
@@ -109,9 +151,8 @@ func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, p
 		return fmt.Errorf("failed to parse device identity container in pair success message: %w", err)
 	}
 
-	h := hmac.New(sha256.New, cli.Store.AdvSecretKey)
-	h.Write(deviceIdentityContainer.Details)
-	if !bytes.Equal(h.Sum(nil), deviceIdentityContainer.Hmac) {
+	isHosted := deviceIdentityContainer.GetAccountType() == waProto.ADVEncryptionType_HOSTED
+	if !verifyDeviceIdentityHMAC(&deviceIdentityContainer, cli.Store.AdvSecretKey, isHosted) {
 		cli.Log.Warnf("Invalid HMAC from pair success message")
 		cli.sendIQError(reqID, 401, "not-authorized")
 		return fmt.Errorf("invalid device identity HMAC in pair success message")
@@ -124,12 +165,12 @@ func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, p
 		return fmt.Errorf("failed to parse signed device identity in pair success message: %w", err)
 	}
 
-	if !verifyDeviceIdentityAccountSignature(&deviceIdentity, cli.Store.IdentityKey) {
+	if !verifyDeviceIdentityAccountSignature(&deviceIdentity, cli.Store.IdentityKey, isHosted) {
 		cli.sendIQError(reqID, 401, "not-authorized")
 		return fmt.Errorf("invalid device signature in pair success message")
 	}
 
-	deviceIdentity.DeviceSignature = generateDeviceSignature(&deviceIdentity, cli.Store.IdentityKey)[:]
+	deviceIdentity.DeviceSignature = generateDeviceSignature(&deviceIdentity, cli.Store.IdentityKey, isHosted)[:]
 
 	var deviceIdentityDetails waProto.ADVDeviceIdentity
 	err = proto.Unmarshal(deviceIdentity.Details, &deviceIdentityDetails)
@@ -138,6 +179,14 @@ func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, p
 		return fmt.Errorf("failed to parse device identity details in pair success message: %w", err)
 	}
 
+	if cli.PairBusinessOnly && len(businessName) == 0 && !isHosted {
+		cli.sendIQError(reqID, 500, "internal-error")
+		return ErrPairNotBusinessAccount
+	} else if cli.PrePairCallback != nil && !cli.PrePairCallback(jid, platform, businessName) {
+		cli.sendIQError(reqID, 500, "internal-error")
+		return ErrPairRejectedLocally
+	}
+
 	mainDeviceJID := jid
 	mainDeviceJID.Device = 0
 	mainDeviceIdentity := *(*[32]byte)(deviceIdentity.AccountSignatureKey)
@@ -207,7 +256,25 @@ func concatBytes(data ...[]byte) []byte {
 	return output
 }
 
-func verifyDeviceIdentityAccountSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, ikp *keys.KeyPair) bool {
+// Prefixes of the messages that are signed in the ADV device identity. Hosted (business API) accounts use different
+// prefixes than normal end-to-end encrypted accounts.
+var (
+	advAccountSignaturePrefix       = []byte{6, 0}
+	advDeviceSignaturePrefix        = []byte{6, 1}
+	advHostedAccountSignaturePrefix = []byte{6, 5}
+	advHostedDeviceSignaturePrefix  = []byte{6, 6}
+)
+
+func verifyDeviceIdentityHMAC(container *waProto.ADVSignedDeviceIdentityHMAC, advSecretKey []byte, isHosted bool) bool {
+	h := hmac.New(sha256.New, advSecretKey)
+	if isHosted {
+		h.Write(advHostedAccountSignaturePrefix)
+	}
+	h.Write(container.Details)
+	return hmac.Equal(h.Sum(nil), container.Hmac)
+}
+
+func verifyDeviceIdentityAccountSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, ikp *keys.KeyPair, isHosted bool) bool {
 	if len(deviceIdentity.AccountSignatureKey) != 32 || len(deviceIdentity.AccountSignature) != 64 {
 		return false
 	}
@@ -215,12 +282,20 @@ func verifyDeviceIdentityAccountSignature(deviceIdentity *waProto.ADVSignedDevic
 	signatureKey := ecc.NewDjbECPublicKey(*(*[32]byte)(deviceIdentity.AccountSignatureKey))
 	signature := *(*[64]byte)(deviceIdentity.AccountSignature)
 
-	message := concatBytes([]byte{6, 0}, deviceIdentity.Details, ikp.Pub[:])
+	prefix := advAccountSignaturePrefix
+	if isHosted {
+		prefix = advHostedAccountSignaturePrefix
+	}
+	message := concatBytes(prefix, deviceIdentity.Details, ikp.Pub[:])
 	return ecc.VerifySignature(signatureKey, message, signature)
 }
 
-func generateDeviceSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, ikp *keys.KeyPair) *[64]byte {
-	message := concatBytes([]byte{6, 1}, deviceIdentity.Details, ikp.Pub[:], deviceIdentity.AccountSignatureKey)
+func generateDeviceSignature(deviceIdentity *waProto.ADVSignedDeviceIdentity, ikp *keys.KeyPair, isHosted bool) *[64]byte {
+	prefix := advDeviceSignaturePrefix
+	if isHosted {
+		prefix = advHostedDeviceSignaturePrefix
+	}
+	message := concatBytes(prefix, deviceIdentity.Details, ikp.Pub[:], deviceIdentity.AccountSignatureKey)
 	sig := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*ikp.Priv), message)
 	return &sig
 }
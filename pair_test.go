@@ -0,0 +1,64 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"testing"
+
+	"go.mau.fi/libsignal/ecc"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+func TestVerifyDeviceIdentityAccountType(t *testing.T) {
+	accountKey := keys.NewKeyPair()
+	deviceKey := keys.NewKeyPair()
+	for _, isHosted := range []bool{false, true} {
+		prefix := advAccountSignaturePrefix
+		if isHosted {
+			prefix = advHostedAccountSignaturePrefix
+		}
+		details := []byte("device identity details")
+		sig := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*accountKey.Priv), concatBytes(prefix, details, deviceKey.Pub[:]))
+		identity := &waProto.ADVSignedDeviceIdentity{
+			Details:             details,
+			AccountSignatureKey: accountKey.Pub[:],
+			AccountSignature:    sig[:],
+		}
+		if !verifyDeviceIdentityAccountSignature(identity, deviceKey, isHosted) {
+			t.Errorf("Valid account signature (hosted: %t) didn't verify", isHosted)
+		}
+		if verifyDeviceIdentityAccountSignature(identity, deviceKey, !isHosted) {
+			t.Errorf("Account signature (hosted: %t) verified with wrong account type", isHosted)
+		}
+	}
+}
+
+func TestVerifyDeviceIdentityHMAC(t *testing.T) {
+	secret := []byte("adv secret key")
+	details := []byte("signed device identity")
+	for _, isHosted := range []bool{false, true} {
+		h := hmac.New(sha256.New, secret)
+		if isHosted {
+			h.Write(advHostedAccountSignaturePrefix)
+		}
+		h.Write(details)
+		container := &waProto.ADVSignedDeviceIdentityHMAC{Details: details, Hmac: h.Sum(nil)}
+		if isHosted {
+			container.AccountType = waProto.ADVEncryptionType_HOSTED.Enum()
+		}
+		if !verifyDeviceIdentityHMAC(container, secret, isHosted) {
+			t.Errorf("Valid HMAC (hosted: %t) didn't verify", isHosted)
+		}
+		if verifyDeviceIdentityHMAC(container, secret, !isHosted) {
+			t.Errorf("HMAC (hosted: %t) verified with wrong account type", isHosted)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/pbkdf2"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/hkdfutil"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// PairClientType is the type of client to show on the phone when pairing with a phone number.
+type PairClientType int
+
+const (
+	PairClientUnknown PairClientType = iota
+	PairClientChrome
+	PairClientEdge
+	PairClientFirefox
+	PairClientIE
+	PairClientOpera
+	PairClientSafari
+	PairClientElectron
+	PairClientUWP
+	PairClientOtherWebClient
+)
+
+// pairClientTypeForPlatform maps companion platform types to the client types used in phone number pairing.
+var pairClientTypeForPlatform = map[waProto.CompanionProps_CompanionPropsPlatformType]PairClientType{
+	waProto.CompanionProps_CHROME:  PairClientChrome,
+	waProto.CompanionProps_EDGE:    PairClientEdge,
+	waProto.CompanionProps_FIREFOX: PairClientFirefox,
+	waProto.CompanionProps_IE:      PairClientIE,
+	waProto.CompanionProps_OPERA:   PairClientOpera,
+	waProto.CompanionProps_SAFARI:  PairClientSafari,
+	waProto.CompanionProps_DESKTOP: PairClientElectron,
+}
+
+var notNumbers = regexp.MustCompile("[^0-9]")
+var linkingBase32 = base32.NewEncoding("123456789ABCDEFGHJKLMNPQRSTVWXYZ")
+
+// Number of PBKDF2 iterations used to derive the key that wraps the ephemeral keys with the linking code.
+const linkCodeKeyIterations = 2 << 16
+
+type phoneLinkingCache struct {
+	jid         types.JID
+	keyPair     *keys.KeyPair
+	linkingCode string
+	pairingRef  string
+}
+
+func randomBytes(n int) []byte {
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	if err != nil {
+		panic(fmt.Errorf("failed to read random bytes: %w", err))
+	}
+	return data
+}
+
+// linkCodeCipher returns the AES-CTR stream used to wrap ephemeral public keys with the linking code.
+func linkCodeCipher(linkingCode string, salt, iv []byte) cipher.Stream {
+	linkCodeKey := pbkdf2.Key([]byte(linkingCode), salt, linkCodeKeyIterations, 32, sha256.New)
+	// The key is always 32 bytes, so creating the cipher can't fail
+	linkCipherBlock, _ := aes.NewCipher(linkCodeKey)
+	return cipher.NewCTR(linkCipherBlock, iv)
+}
+
+func generateCompanionEphemeralKey() (ephemeralKeyPair *keys.KeyPair, ephemeralKey []byte, encodedLinkingCode string) {
+	ephemeralKeyPair = keys.NewKeyPair()
+	salt := randomBytes(32)
+	iv := randomBytes(16)
+	encodedLinkingCode = linkingBase32.EncodeToString(randomBytes(5))
+	encryptedPubkey := make([]byte, 32)
+	linkCodeCipher(encodedLinkingCode, salt, iv).XORKeyStream(encryptedPubkey, ephemeralKeyPair.Pub[:])
+	ephemeralKey = concatBytes(salt, iv, encryptedPubkey)
+	return
+}
+
+// PairPhone generates a pairing code that can be used to link to a phone without scanning a QR code.
+// See PairPhoneWithClient for details.
+//
+// The client type and display name are derived from the device's companion props (see store.CompanionProps).
+// If the platform type isn't set, the client is shown as Chrome on Linux on the phone.
+func (cli *Client) PairPhone(phone string, showPushNotification bool) (string, error) {
+	props := cli.Store.GetCompanionProps()
+	clientType, ok := pairClientTypeForPlatform[props.GetPlatformType()]
+	if !ok {
+		return cli.PairPhoneWithClient(phone, showPushNotification, PairClientChrome, "Chrome (Linux)")
+	}
+	platformName := props.GetPlatformType().String()
+	displayName := fmt.Sprintf("%s%s (%s)", platformName[:1], strings.ToLower(platformName[1:]), props.GetOs())
+	return cli.PairPhoneWithClient(phone, showPushNotification, clientType, displayName)
+}
+
+// PairPhoneWithClient generates a pairing code that can be used to link to a phone without scanning a QR code.
+//
+// The client must be connected to the websocket (i.e. Connect() must have been called and the first QR event
+// must have been received) and the store must not contain a user ID. The phone number must be in international
+// format, non-digit characters are ignored.
+//
+// The returned code is in the format "ABCD-EFGH" and must be entered on the phone in the "Link with phone number"
+// dialog. If showPushNotification is true, the phone will also get a notification asking the user to enter the code.
+// Once the code is entered, pairing continues normally and events.PairSuccess is emitted.
+func (cli *Client) PairPhoneWithClient(phone string, showPushNotification bool, clientType PairClientType, clientDisplayName string) (string, error) {
+	if cli.Store.ID != nil {
+		return "", ErrPairPhoneStoreContainsID
+	}
+	phone = notNumbers.ReplaceAllString(phone, "")
+	if len(phone) <= 6 {
+		return "", ErrPhoneNumberTooShort
+	} else if phone[0] == '0' {
+		return "", ErrPhoneNumberIsNotInternational
+	}
+	jid := types.NewJID(phone, types.DefaultUserServer)
+	ephemeralKeyPair, ephemeralKey, encodedLinkingCode := generateCompanionEphemeralKey()
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "md",
+		Type:      iqSet,
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "link_code_companion_reg",
+			Attrs: waBinary.Attrs{
+				"jid":                           jid,
+				"stage":                         "companion_hello",
+				"should_show_push_notification": strconv.FormatBool(showPushNotification),
+			},
+			Content: []waBinary.Node{
+				{Tag: "link_code_pairing_wrapped_companion_ephemeral_pub", Content: ephemeralKey},
+				{Tag: "companion_server_auth_key_pub", Content: cli.Store.NoiseKey.Pub[:]},
+				{Tag: "companion_platform_id", Content: strconv.Itoa(int(clientType))},
+				{Tag: "companion_platform_display", Content: clientDisplayName},
+				{Tag: "link_code_pairing_nonce", Content: []byte{0}},
+			},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	regNode, ok := resp.GetOptionalChildByTag("link_code_companion_reg")
+	if !ok {
+		return "", &ElementMissingError{Tag: "link_code_companion_reg", In: "code link registration response"}
+	}
+	pairingRefNode, ok := regNode.GetOptionalChildByTag("link_code_pairing_ref")
+	if !ok {
+		return "", &ElementMissingError{Tag: "link_code_pairing_ref", In: "code link registration response"}
+	}
+	pairingRef, ok := pairingRefNode.Content.([]byte)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T in content of link_code_pairing_ref tag", pairingRefNode.Content)
+	}
+	cli.phoneLinkingCacheLock.Lock()
+	cli.phoneLinkingCache = &phoneLinkingCache{
+		jid:         jid,
+		keyPair:     ephemeralKeyPair,
+		linkingCode: encodedLinkingCode,
+		pairingRef:  string(pairingRef),
+	}
+	cli.phoneLinkingCacheLock.Unlock()
+	return encodedLinkingCode[0:4] + "-" + encodedLinkingCode[4:], nil
+}
+
+func (cli *Client) tryHandleCodePairNotification(parentNode *waBinary.Node) {
+	err := cli.handleCodePairNotification(parentNode)
+	if err != nil {
+		cli.Log.Errorf("Failed to handle code pair notification: %v", err)
+	}
+}
+
+func (cli *Client) handleCodePairNotification(parentNode *waBinary.Node) error {
+	node, ok := parentNode.GetOptionalChildByTag("link_code_companion_reg")
+	if !ok {
+		return &ElementMissingError{Tag: "link_code_companion_reg", In: "notification"}
+	}
+	cli.phoneLinkingCacheLock.Lock()
+	linkCache := cli.phoneLinkingCache
+	cli.phoneLinkingCacheLock.Unlock()
+	if linkCache == nil {
+		return fmt.Errorf("received code pair notification without a pending pairing")
+	}
+	linkCodePairingRef, _ := node.GetChildByTag("link_code_pairing_ref").Content.([]byte)
+	if string(linkCodePairingRef) != linkCache.pairingRef {
+		return fmt.Errorf("pairing ref mismatch in code pair notification")
+	}
+	wrappedPrimaryEphemeralPub, ok := node.GetChildByTag("link_code_pairing_wrapped_primary_ephemeral_pub").Content.([]byte)
+	if !ok {
+		return &ElementMissingError{Tag: "link_code_pairing_wrapped_primary_ephemeral_pub", In: "code pair notification"}
+	} else if len(wrappedPrimaryEphemeralPub) != 80 {
+		return fmt.Errorf("unexpected length %d of wrapped primary ephemeral key (expected 80)", len(wrappedPrimaryEphemeralPub))
+	}
+	primaryIdentityPub, ok := node.GetChildByTag("primary_identity_pub").Content.([]byte)
+	if !ok {
+		return &ElementMissingError{Tag: "primary_identity_pub", In: "code pair notification"}
+	}
+
+	advSecretRandom := randomBytes(32)
+	keyBundleSalt := randomBytes(32)
+	keyBundleNonce := randomBytes(12)
+
+	// Decrypt the primary device's ephemeral public key, which was encrypted with the linking code,
+	// then compute the shared secret using the companion ephemeral key generated in PairPhone.
+	primarySalt := wrappedPrimaryEphemeralPub[0:32]
+	primaryIV := wrappedPrimaryEphemeralPub[32:48]
+	primaryEncryptedPubkey := wrappedPrimaryEphemeralPub[48:80]
+	primaryDecryptedPubkey := make([]byte, 32)
+	linkCodeCipher(linkCache.linkingCode, primarySalt, primaryIV).XORKeyStream(primaryDecryptedPubkey, primaryEncryptedPubkey)
+	ephemeralSharedSecret, err := curve25519.X25519(linkCache.keyPair.Priv[:], primaryDecryptedPubkey)
+	if err != nil {
+		return fmt.Errorf("failed to compute ephemeral shared secret: %w", err)
+	}
+
+	// Encrypt the key bundle containing our identity key, the primary device's identity key and the randomness for the adv key
+	keyBundleEncryptionKey := hkdfutil.SHA256(ephemeralSharedSecret, keyBundleSalt, []byte("link_code_pairing_key_bundle_encryption_key"), 32)
+	keyBundleCipherBlock, err := aes.NewCipher(keyBundleEncryptionKey)
+	if err != nil {
+		return fmt.Errorf("failed to create key bundle cipher: %w", err)
+	}
+	keyBundleGCM, err := cipher.NewGCM(keyBundleCipherBlock)
+	if err != nil {
+		return fmt.Errorf("failed to create key bundle GCM: %w", err)
+	}
+	plaintextKeyBundle := concatBytes(cli.Store.IdentityKey.Pub[:], primaryIdentityPub, advSecretRandom)
+	encryptedKeyBundle := keyBundleGCM.Seal(nil, keyBundleNonce, plaintextKeyBundle, nil)
+	wrappedKeyBundle := concatBytes(keyBundleSalt, keyBundleNonce, encryptedKeyBundle)
+
+	// Compute the adv secret key, which is used to authenticate the pair-success message later
+	identitySharedKey, err := curve25519.X25519(cli.Store.IdentityKey.Priv[:], primaryIdentityPub)
+	if err != nil {
+		return fmt.Errorf("failed to compute identity shared key: %w", err)
+	}
+	advSecretInput := concatBytes(ephemeralSharedSecret, identitySharedKey, advSecretRandom)
+	cli.Store.AdvSecretKey = hkdfutil.SHA256(advSecretInput, nil, []byte("adv_secret"), 32)
+
+	_, err = cli.sendIQ(infoQuery{
+		Namespace: "md",
+		Type:      iqSet,
+		To:        types.ServerJID,
+		Content: []waBinary.Node{{
+			Tag: "link_code_companion_reg",
+			Attrs: waBinary.Attrs{
+				"jid":   linkCache.jid,
+				"stage": "companion_finish",
+			},
+			Content: []waBinary.Node{
+				{Tag: "link_code_pairing_wrapped_key_bundle", Content: wrappedKeyBundle},
+				{Tag: "companion_identity_public", Content: cli.Store.IdentityKey.Pub[:]},
+				{Tag: "link_code_pairing_ref", Content: linkCodePairingRef},
+			},
+		}},
+	})
+	return err
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCompanionEphemeralKey(t *testing.T) {
+	keyPair, wrapped, code := generateCompanionEphemeralKey()
+	if len(code) != 8 {
+		t.Fatalf("Expected 8-character linking code, got %q", code)
+	} else if len(wrapped) != 80 {
+		t.Fatalf("Expected 80-byte wrapped key, got %d bytes", len(wrapped))
+	}
+	decrypted := make([]byte, 32)
+	linkCodeCipher(code, wrapped[0:32], wrapped[32:48]).XORKeyStream(decrypted, wrapped[48:80])
+	if !bytes.Equal(decrypted, keyPair.Pub[:]) {
+		t.Errorf("Unwrapping the ephemeral key with the linking code didn't return the public key")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// RequestFromPhoneDelay specifies how long to wait for the sender to resend an unavailable message before requesting
+// it from the primary device (if Client.AutomaticMessageRerequestFromPhone is enabled).
+var RequestFromPhoneDelay = 5 * time.Second
+
+// BuildUnavailableMessageRequest builds a message to request the user's primary device to send a copy of a message
+// that this device received as unavailable (i.e. the sender didn't encrypt it for this device).
+//
+// The built message should be sent to the user's own JID as a peer message:
+//
+//	cli.SendMessage(cli.Store.ID.ToNonAD(), "", cli.BuildUnavailableMessageRequest(chat, sender, id), whatsmeow.SendRequestExtra{Peer: true})
+//
+// The phone's response is emitted as a normal events.Message with the UnavailableRequestID field set.
+// Requests can also be sent automatically by enabling Client.AutomaticMessageRerequestFromPhone.
+func (cli *Client) BuildUnavailableMessageRequest(chat, sender types.JID, id types.MessageID) *waProto.Message {
+	return &waProto.Message{
+		ProtocolMessage: &waProto.ProtocolMessage{
+			Type: waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_MESSAGE.Enum(),
+			PeerDataOperationRequestMessage: &waProto.PeerDataOperationRequestMessage{
+				PeerDataOperationRequestType: waProto.PeerDataOperationRequestType_PLACEHOLDER_MESSAGE_RESEND.Enum(),
+				PlaceholderMessageResendRequest: []*waProto.PeerDataOperationRequestMessage_PlaceholderMessageResendRequest{{
+					MessageKey: cli.buildMessageKey(chat, sender, id),
+				}},
+			},
+		},
+	}
+}
+
+// delayedRequestMessageFromPhone waits for RequestFromPhoneDelay and then requests the given unavailable message
+// from the primary device, unless the message was received normally while waiting.
+func (cli *Client) delayedRequestMessageFromPhone(info *types.MessageInfo) {
+	cli.pendingPhoneRerequestsLock.Lock()
+	_, alreadyRequesting := cli.pendingPhoneRerequests[info.ID]
+	if alreadyRequesting {
+		cli.pendingPhoneRerequestsLock.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cli.pendingPhoneRerequests[info.ID] = cancel
+	cli.pendingPhoneRerequestsLock.Unlock()
+	defer func() {
+		cli.pendingPhoneRerequestsLock.Lock()
+		delete(cli.pendingPhoneRerequests, info.ID)
+		cli.pendingPhoneRerequestsLock.Unlock()
+	}()
+
+	timer := time.NewTimer(RequestFromPhoneDelay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		cli.Log.Debugf("Cancelled delayed request for message %s from phone", info.ID)
+		return
+	}
+	ownID := cli.Store.ID
+	if ownID == nil {
+		return
+	}
+	_, err := cli.SendMessageContext(ctx, ownID.ToNonAD(), "", cli.BuildUnavailableMessageRequest(info.Chat, info.Sender, info.ID), SendRequestExtra{Peer: true})
+	if err != nil {
+		cli.Log.Warnf("Failed to send request for unavailable message %s to phone: %v", info.ID, err)
+	} else {
+		cli.Log.Debugf("Requested message %s from phone", info.ID)
+	}
+}
+
+// cancelDelayedRequestFromPhone cancels the pending request for an unavailable message after it was received normally.
+func (cli *Client) cancelDelayedRequestFromPhone(id types.MessageID) {
+	cli.pendingPhoneRerequestsLock.Lock()
+	cancel, ok := cli.pendingPhoneRerequests[id]
+	cli.pendingPhoneRerequestsLock.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+// handlePlaceholderResendResponse handles the primary device's response to a BuildUnavailableMessageRequest
+// by dispatching the messages in it as normal message events.
+func (cli *Client) handlePlaceholderResendResponse(protoMsg *waProto.ProtocolMessage) {
+	webMsgs, requestID, err := parsePlaceholderResendResponse(protoMsg)
+	if err != nil {
+		cli.Log.Warnf("Failed to parse placeholder resend response: %v", err)
+		return
+	}
+	for _, webMsg := range webMsgs {
+		info, err := cli.parseWebMessageInfo(webMsg)
+		if err != nil {
+			cli.Log.Warnf("Failed to parse message in placeholder resend response to %s: %v", requestID, err)
+			continue
+		}
+		cli.Log.Debugf("Got message %s from %s in response to unavailable message request %s", info.ID, info.SourceString(), requestID)
+		cli.handlePlaintextMessage(info, webMsg.GetMessage(), requestID)
+	}
+}
+
+func parsePlaceholderResendResponse(protoMsg *waProto.ProtocolMessage) (webMsgs []*waProto.WebMessageInfo, requestID types.MessageID, err error) {
+	response := protoMsg.GetPeerDataOperationRequestResponseMessage()
+	requestID = response.GetStanzaId()
+	for _, result := range response.GetPeerDataOperationResult() {
+		data := result.GetPlaceholderMessageResendResponse().GetWebMessageInfoBytes()
+		if len(data) == 0 {
+			continue
+		}
+		var webMsg waProto.WebMessageInfo
+		if err = proto.Unmarshal(data, &webMsg); err != nil {
+			err = fmt.Errorf("failed to unmarshal web message info: %w", err)
+			return
+		}
+		webMsgs = append(webMsgs, &webMsg)
+	}
+	return
+}
+
+// parseWebMessageInfo parses the message metadata in a WebMessageInfo, like parseMessageInfo does for message nodes.
+func (cli *Client) parseWebMessageInfo(webMsg *waProto.WebMessageInfo) (*types.MessageInfo, error) {
+	key := webMsg.GetKey()
+	chat, err := types.ParseJID(key.GetRemoteJid())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chat JID: %w", err)
+	}
+	info := &types.MessageInfo{
+		MessageSource: types.MessageSource{
+			Chat:     chat,
+			IsFromMe: key.GetFromMe(),
+			IsGroup:  chat.Server == types.GroupServer || chat.Server == types.BroadcastServer,
+		},
+		ID:        key.GetId(),
+		PushName:  webMsg.GetPushName(),
+		Timestamp: time.Unix(int64(webMsg.GetMessageTimestamp()), 0),
+	}
+	if info.IsFromMe {
+		if cli.Store.ID == nil {
+			return nil, ErrNotLoggedIn
+		}
+		info.Sender = cli.Store.ID.ToNonAD()
+	} else if participant := webMsg.GetParticipant(); len(participant) > 0 {
+		info.Sender, err = types.ParseJID(participant)
+	} else if participant = key.GetParticipant(); len(participant) > 0 {
+		info.Sender, err = types.ParseJID(participant)
+	} else {
+		info.Sender = chat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sender JID: %w", err)
+	}
+	return info, nil
+}
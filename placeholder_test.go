@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestBuildUnavailableMessageRequest(t *testing.T) {
+	ownID := types.NewADJID("1111", 0, 2)
+	cli := &Client{Store: &store.Device{ID: &ownID}}
+	chat := types.NewJID("12345-67890", types.GroupServer)
+	sender := types.NewJID("2222", types.DefaultUserServer)
+	msg := cli.BuildUnavailableMessageRequest(chat, sender, "ABCD")
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %v", err)
+	}
+	var parsed waProto.Message
+	if err = proto.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("Failed to unmarshal request: %v", err)
+	}
+	protoMsg := parsed.GetProtocolMessage()
+	if protoMsg.GetType() != waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_MESSAGE {
+		t.Errorf("Unexpected protocol message type %v", protoMsg.GetType())
+	}
+	request := protoMsg.GetPeerDataOperationRequestMessage()
+	if request.GetPeerDataOperationRequestType() != waProto.PeerDataOperationRequestType_PLACEHOLDER_MESSAGE_RESEND {
+		t.Errorf("Unexpected peer data operation request type %v", request.GetPeerDataOperationRequestType())
+	}
+	if len(request.GetPlaceholderMessageResendRequest()) != 1 {
+		t.Fatalf("Expected one placeholder resend request, got %d", len(request.GetPlaceholderMessageResendRequest()))
+	}
+	key := request.GetPlaceholderMessageResendRequest()[0].GetMessageKey()
+	if key.GetId() != "ABCD" || key.GetRemoteJid() != chat.String() || key.GetParticipant() != sender.String() || key.GetFromMe() {
+		t.Errorf("Unexpected message key %v", key)
+	}
+}
+
+func TestParsePlaceholderResendResponse(t *testing.T) {
+	webMsgBytes, _ := proto.Marshal(&waProto.WebMessageInfo{
+		Key: &waProto.MessageKey{
+			RemoteJid: proto.String("2222@s.whatsapp.net"),
+			FromMe:    proto.Bool(false),
+			Id:        proto.String("ABCD"),
+		},
+		Message:          &waProto.Message{Conversation: proto.String("hello")},
+		MessageTimestamp: proto.Uint64(1600000000),
+	})
+	protoMsg := &waProto.ProtocolMessage{
+		Type: waProto.ProtocolMessage_PEER_DATA_OPERATION_REQUEST_RESPONSE_MESSAGE.Enum(),
+		PeerDataOperationRequestResponseMessage: &waProto.PeerDataOperationRequestResponseMessage{
+			PeerDataOperationRequestType: waProto.PeerDataOperationRequestType_PLACEHOLDER_MESSAGE_RESEND.Enum(),
+			StanzaId:                     proto.String("REQUEST"),
+			PeerDataOperationResult: []*waProto.PeerDataOperationRequestResponseMessage_PeerDataOperationResult{{
+				PlaceholderMessageResendResponse: &waProto.PeerDataOperationRequestResponseMessage_PeerDataOperationResult_PlaceholderMessageResendResponse{
+					WebMessageInfoBytes: webMsgBytes,
+				},
+			}},
+		},
+	}
+
+	webMsgs, requestID, err := parsePlaceholderResendResponse(protoMsg)
+	if err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	} else if requestID != "REQUEST" {
+		t.Errorf("Expected request ID REQUEST, got %q", requestID)
+	} else if len(webMsgs) != 1 || webMsgs[0].GetMessage().GetConversation() != "hello" {
+		t.Fatalf("Unexpected messages in response: %v", webMsgs)
+	}
+
+	ownID := types.NewADJID("1111", 0, 2)
+	cli := &Client{Store: &store.Device{ID: &ownID}}
+	info, err := cli.parseWebMessageInfo(webMsgs[0])
+	if err != nil {
+		t.Fatalf("Failed to parse message info: %v", err)
+	}
+	sender := types.NewJID("2222", types.DefaultUserServer)
+	if info.ID != "ABCD" || info.Chat != sender || info.Sender != sender || info.IsFromMe || info.IsGroup || info.Timestamp.Unix() != 1600000000 {
+		t.Errorf("Unexpected message info %+v", info)
+	}
+}
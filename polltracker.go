@@ -0,0 +1,170 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"crypto/sha256"
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+type pollKey struct {
+	Chat   types.JID
+	Sender types.JID
+	ID     types.MessageID
+}
+
+type pollOptionHash [sha256.Size]byte
+
+type trackedPoll struct {
+	// The option names in the order they were in the poll creation message.
+	// If the creation message wasn't seen by this client, the names are empty and options are added as votes come in.
+	options []string
+	hashes  []pollOptionHash
+
+	votes     map[types.JID][]pollOptionHash
+	voteTimes map[types.JID]int64
+}
+
+func newTrackedPoll() *trackedPoll {
+	return &trackedPoll{
+		votes:     make(map[types.JID][]pollOptionHash),
+		voteTimes: make(map[types.JID]int64),
+	}
+}
+
+func (poll *trackedPoll) optionIndex(hash pollOptionHash) int {
+	for i, existing := range poll.hashes {
+		if existing == hash {
+			return i
+		}
+	}
+	poll.hashes = append(poll.hashes, hash)
+	poll.options = append(poll.options, "")
+	return len(poll.hashes) - 1
+}
+
+func (poll *trackedPoll) results() []events.PollOptionResult {
+	results := make([]events.PollOptionResult, len(poll.hashes))
+	for i, hash := range poll.hashes {
+		results[i] = events.PollOptionResult{Name: poll.options[i], Hash: hash[:]}
+	}
+	for voter, selected := range poll.votes {
+		for _, hash := range selected {
+			result := &results[poll.optionIndex(hash)]
+			result.Count++
+			result.Voters = append(result.Voters, voter)
+		}
+	}
+	return results
+}
+
+func (cli *Client) getTrackedPoll(key pollKey) *trackedPoll {
+	poll, ok := cli.polls[key]
+	if !ok {
+		poll = newTrackedPoll()
+		cli.polls[key] = poll
+	}
+	return poll
+}
+
+// registerPoll saves the option names of the given poll so that they can be included in poll results.
+func (cli *Client) registerPoll(chat, sender types.JID, id types.MessageID, pollMsg *waProto.PollCreationMessage) {
+	cli.pollLock.Lock()
+	defer cli.pollLock.Unlock()
+	poll := cli.getTrackedPoll(pollKey{Chat: chat, Sender: sender.ToNonAD(), ID: id})
+	for _, option := range pollMsg.GetOptions() {
+		hash := pollOptionHash(sha256.Sum256([]byte(option.GetOptionName())))
+		poll.options[poll.optionIndex(hash)] = option.GetOptionName()
+	}
+}
+
+// trackPoll updates the poll results if poll tracking is enabled and the given message is a poll creation or vote.
+// If the results of a poll changed, the returned event should be dispatched.
+func (cli *Client) trackPoll(evt *events.Message) *events.PollResultsUpdated {
+	if !cli.EnablePollTracking {
+		return nil
+	} else if pollMsg := evt.Message.GetPollCreationMessage(); pollMsg != nil {
+		cli.registerPoll(evt.Info.Chat, evt.Info.Sender, evt.Info.ID, pollMsg)
+		return nil
+	}
+	update := evt.Message.GetPollUpdateMessage()
+	if update == nil || update.GetPollCreationMessageKey() == nil {
+		return nil
+	}
+	vote, err := cli.DecryptPollVote(evt)
+	if err != nil {
+		cli.Log.Warnf("Failed to decrypt poll vote %s from %s for tracking: %v", evt.Info.ID, evt.Info.SourceString(), err)
+		return nil
+	}
+	key := pollKey{
+		Chat:   evt.Info.Chat,
+		Sender: cli.getKeySender(&evt.Info, update.GetPollCreationMessageKey()),
+		ID:     update.GetPollCreationMessageKey().GetId(),
+	}
+	voter := evt.Info.Sender.ToNonAD()
+
+	cli.pollLock.Lock()
+	defer cli.pollLock.Unlock()
+	poll := cli.getTrackedPoll(key)
+	if update.GetSenderTimestampMs() < poll.voteTimes[voter] {
+		// Votes can arrive out of order (e.g. from offline sync), only the newest one counts.
+		return nil
+	}
+	poll.voteTimes[voter] = update.GetSenderTimestampMs()
+	selected := make([]pollOptionHash, 0, len(vote.GetSelectedOptions()))
+	for _, rawHash := range vote.GetSelectedOptions() {
+		var hash pollOptionHash
+		if len(rawHash) != len(hash) {
+			continue
+		}
+		copy(hash[:], rawHash)
+		poll.optionIndex(hash)
+		selected = append(selected, hash)
+	}
+	if len(selected) == 0 {
+		delete(poll.votes, voter)
+	} else {
+		poll.votes[voter] = selected
+	}
+	return &events.PollResultsUpdated{
+		Chat:       key.Chat,
+		PollSender: key.Sender,
+		PollID:     key.ID,
+		Voter:      voter,
+		Options:    poll.results(),
+		VoterCount: len(poll.votes),
+	}
+}
+
+// trackOwnPoll updates poll results for polls and votes sent by this client.
+// The server doesn't echo messages back to the device that sent them, so they don't go through handleDecryptedMessage.
+func (cli *Client) trackOwnPoll(to types.JID, id types.MessageID, ts time.Time, message *waProto.Message) {
+	ownID := cli.Store.ID
+	if !cli.EnablePollTracking || ownID == nil {
+		return
+	}
+	ownEvt := &events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:     to,
+				Sender:   ownID.ToNonAD(),
+				IsFromMe: true,
+				IsGroup:  to.Server == types.GroupServer,
+			},
+			ID:        id,
+			Timestamp: ts,
+		},
+		Message: message,
+	}
+	if pollEvt := cli.trackPoll(ownEvt); pollEvt != nil {
+		cli.dispatchEvent(pollEvt)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestPollTracking(t *testing.T) {
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	aliceID := types.NewADJID("1111111111", 0, 1)
+	bobID := types.NewADJID("2222222222", 0, 3)
+	alice := NewClient(&store.Device{ID: &aliceID, MsgSecrets: memoryMsgSecretStore{}}, nil)
+	bob := NewClient(&store.Device{ID: &bobID, MsgSecrets: memoryMsgSecretStore{}}, nil)
+	alice.EnablePollTracking = true
+
+	poll := alice.BuildPollCreation("Lunch?", []string{"Pizza", "Sushi", "Salad"}, 1)
+	pollInfo := types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: group, Sender: aliceID.ToNonAD(), IsFromMe: true, IsGroup: true},
+		ID:            "POLL",
+	}
+	alice.storeMessageSecret(group, aliceID, pollInfo.ID, poll)
+	bob.storeMessageSecret(group, aliceID, pollInfo.ID, poll)
+	if alice.trackPoll(&events.Message{Info: pollInfo, Message: poll}) != nil {
+		t.Fatalf("Poll creation shouldn't emit results")
+	}
+
+	vote := func(ts int64, options ...string) *events.PollResultsUpdated {
+		t.Helper()
+		msg, err := bob.BuildPollVote(&pollInfo, options)
+		if err != nil {
+			t.Fatalf("Failed to build poll vote: %v", err)
+		}
+		msg.PollUpdateMessage.SenderTimestampMs = proto.Int64(ts)
+		return alice.trackPoll(&events.Message{
+			Info: types.MessageInfo{
+				MessageSource: types.MessageSource{Chat: group, Sender: bobID, IsGroup: true},
+				ID:            "VOTE",
+			},
+			Message: msg,
+		})
+	}
+	counts := func(evt *events.PollResultsUpdated) (out []int) {
+		for _, opt := range evt.Options {
+			out = append(out, opt.Count)
+		}
+		return
+	}
+
+	evt := vote(1000, "Sushi")
+	if evt == nil || len(evt.Options) != 3 || evt.Options[1].Name != "Sushi" || evt.VoterCount != 1 {
+		t.Fatalf("Unexpected results after first vote: %+v", evt)
+	} else if c := counts(evt); c[0] != 0 || c[1] != 1 || c[2] != 0 {
+		t.Errorf("Unexpected counts after first vote: %v", c)
+	} else if evt.Voter != bobID.ToNonAD() || evt.Options[1].Voters[0] != bobID.ToNonAD() {
+		t.Errorf("Unexpected voter in results: %+v", evt)
+	}
+	evt = vote(2000, "Pizza")
+	if c := counts(evt); c[0] != 1 || c[1] != 0 {
+		t.Errorf("Vote change wasn't applied: %v", c)
+	}
+	if vote(1500, "Salad") != nil {
+		t.Errorf("Outdated vote changed the results")
+	}
+	evt = vote(3000)
+	if c := counts(evt); c[0] != 0 || c[1] != 0 || c[2] != 0 || evt.VoterCount != 0 {
+		t.Errorf("Vote removal wasn't applied: %v", c)
+	}
+}
@@ -7,6 +7,7 @@
 package whatsmeow
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"time"
@@ -93,7 +94,7 @@ type preKeyResp struct {
 	err    error
 }
 
-func (cli *Client) fetchPreKeys(users []types.JID) (map[types.JID]preKeyResp, error) {
+func (cli *Client) fetchPreKeys(ctx context.Context, users []types.JID) (map[types.JID]preKeyResp, error) {
 	requests := make([]waBinary.Node, len(users))
 	for i, user := range users {
 		requests[i].Tag = "user"
@@ -110,6 +111,7 @@ func (cli *Client) fetchPreKeys(users []types.JID) (map[types.JID]preKeyResp, er
 			Tag:     "key",
 			Content: requests,
 		}},
+		Context: ctx,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send prekey request: %w", err)
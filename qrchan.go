@@ -8,16 +8,21 @@ package whatsmeow
 
 import (
 	"context"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"go.mau.fi/whatsmeow/types/events"
 	waLog "go.mau.fi/whatsmeow/util/log"
 )
 
+const (
+	// QRChannelEventCode is the Event value of items that contain a new QR code.
+	QRChannelEventCode = "code"
+	// QRChannelEventError is the Event value of items that contain a pairing error.
+	QRChannelEventError = "error"
+)
+
 type QRChannelItem struct {
-	// The type of event, "code" for new QR codes.
+	// The type of event, QRChannelEventCode for new QR codes and QRChannelEventError for pair errors.
 	// For non-code/error events, you can just compare the whole item to the event variables (like QRChannelSuccess).
 	Event string
 	// If the item is a pair error, then this field contains the error message.
@@ -31,124 +36,139 @@ type QRChannelItem struct {
 var (
 	// QRChannelSuccess is emitted from GetQRChannel when the pairing is successful.
 	QRChannelSuccess = QRChannelItem{Event: "success"}
-	// QRChannelTimeout is emitted from GetQRChannel if the socket gets disconnected by the server before the pairing is successful.
+	// QRChannelTimeout is emitted from GetQRChannel if the socket gets disconnected by the server before the pairing
+	// is successful, if all QR codes expire, or if the context passed to GetQRChannel is canceled.
 	QRChannelTimeout = QRChannelItem{Event: "timeout"}
 	// QRChannelErrUnexpectedEvent is emitted from GetQRChannel if an unexpected connection event is received,
 	// as that likely means that the pairing has already happened before the channel was set up.
 	QRChannelErrUnexpectedEvent = QRChannelItem{Event: "err-unexpected-state"}
+	// QRChannelClientOutdated is emitted from GetQRChannel if events.ClientOutdated is received.
+	QRChannelClientOutdated = QRChannelItem{Event: "err-client-outdated"}
 	// QRChannelScannedWithoutMultidevice is emitted from GetQRChannel if events.QRScannedWithoutMultidevice is received.
 	QRChannelScannedWithoutMultidevice = QRChannelItem{Event: "err-scanned-without-multidevice"}
 )
 
+// qrChannel converts client events into QR channel items. A single goroutine (run) owns the output channel:
+// it's the only place where items are sent and where the channel is closed, so sends can't race with the close.
 type qrChannel struct {
-	sync.Mutex
 	cli       *Client
 	log       waLog.Logger
 	ctx       context.Context
 	handlerID uint32
-	closed    uint32
 	output    chan<- QRChannelItem
-	stopQRs   chan struct{}
+	events    chan interface{}
+	done      chan struct{}
+}
+
+// handleEvent is the event handler registered in the client. It only forwards relevant events to run.
+func (qrc *qrChannel) handleEvent(rawEvt interface{}) {
+	switch rawEvt.(type) {
+	case *events.QR, *events.QRScannedWithoutMultidevice, *events.PairSuccess, *events.PairError,
+		*events.Disconnected, *events.ClientOutdated,
+		*events.Connected, *events.ConnectFailure, *events.LoggedOut, *events.TemporaryBan:
+	default:
+		return
+	}
+	select {
+	case qrc.events <- rawEvt:
+	case <-qrc.done:
+		qrc.log.Debugf("Dropping event of type %T, channel is closed", rawEvt)
+	}
 }
 
-func (qrc *qrChannel) emitQRs(evt *events.QR) {
-	var nextCode string
+// closeWith sends the given final item to the output channel and closes it. This must only be called from run.
+func (qrc *qrChannel) closeWith(item QRChannelItem, disconnect bool) {
+	qrc.log.Debugf("Closing channel with status %+v", item)
+	select {
+	case qrc.output <- item:
+	default:
+		qrc.log.Warnf("Output channel didn't accept final status %+v", item)
+	}
+	close(qrc.output)
+	close(qrc.done)
+	// Has to be done in background because otherwise there's a deadlock with eventHandlersLock
+	go qrc.cli.RemoveEventHandler(qrc.handlerID)
+	if disconnect {
+		qrc.cli.Disconnect()
+	}
+}
+
+// run emits QR codes and final status items until the pairing finishes, the codes run out or the context is canceled.
+func (qrc *qrChannel) run() {
+	var codes []string
+	var timer <-chan time.Time
 	for {
-		if len(evt.Codes) == 0 {
-			if atomic.CompareAndSwapUint32(&qrc.closed, 0, 1) {
-				qrc.log.Debugf("Ran out of QR codes, closing channel with status %s and disconnecting client", QRChannelTimeout)
-				qrc.output <- QRChannelTimeout
-				close(qrc.output)
-				go qrc.cli.RemoveEventHandler(qrc.handlerID)
-				qrc.cli.Disconnect()
-			} else {
-				qrc.log.Debugf("Ran out of QR codes, but channel is already closed")
-			}
+		select {
+		case <-qrc.ctx.Done():
+			qrc.log.Debugf("Context is done, closing channel and disconnecting client")
+			qrc.closeWith(QRChannelTimeout, true)
 			return
-		} else if atomic.LoadUint32(&qrc.closed) == 1 {
-			qrc.log.Debugf("QR code channel is closed, exiting QR emitter")
+		case <-timer:
+		case rawEvt := <-qrc.events:
+			var finalItem QRChannelItem
+			switch evt := rawEvt.(type) {
+			case *events.QR:
+				qrc.log.Debugf("Received QR code event, starting to emit codes to channel")
+				codes = evt.Codes
+			case *events.QRScannedWithoutMultidevice:
+				qrc.log.Debugf("QR code scanned without multidevice enabled")
+				select {
+				case qrc.output <- QRChannelScannedWithoutMultidevice:
+				default:
+					qrc.log.Warnf("Output channel didn't accept scanned without multidevice status")
+				}
+				continue
+			case *events.PairSuccess:
+				finalItem = QRChannelSuccess
+			case *events.PairError:
+				finalItem = QRChannelItem{
+					Event: QRChannelEventError,
+					Error: evt.Error,
+				}
+			case *events.Disconnected:
+				finalItem = QRChannelTimeout
+			case *events.ClientOutdated:
+				finalItem = QRChannelClientOutdated
+			default:
+				finalItem = QRChannelErrUnexpectedEvent
+			}
+			if finalItem.Event != "" {
+				qrc.closeWith(finalItem, false)
+				return
+			}
+		}
+		if len(codes) == 0 {
+			qrc.log.Debugf("Ran out of QR codes, closing channel and disconnecting client")
+			qrc.closeWith(QRChannelTimeout, true)
 			return
 		}
 		timeout := 20 * time.Second
-		if len(evt.Codes) == 6 {
+		if len(codes) == 6 {
 			timeout = 60 * time.Second
 		}
-		nextCode, evt.Codes = evt.Codes[0], evt.Codes[1:]
+		var nextCode string
+		nextCode, codes = codes[0], codes[1:]
 		qrc.log.Debugf("Emitting QR code %s", nextCode)
 		select {
-		case qrc.output <- QRChannelItem{Code: nextCode, Timeout: timeout, Event: "code"}:
+		case qrc.output <- QRChannelItem{Code: nextCode, Timeout: timeout, Event: QRChannelEventCode}:
 		default:
-			qrc.log.Debugf("Output channel didn't accept code, exiting QR emitter")
-			if atomic.CompareAndSwapUint32(&qrc.closed, 0, 1) {
-				close(qrc.output)
-				go qrc.cli.RemoveEventHandler(qrc.handlerID)
-				qrc.cli.Disconnect()
-			}
-			return
-		}
-		select {
-		case <-time.After(timeout):
-		case <-qrc.stopQRs:
-			qrc.log.Debugf("Got signal to stop QR emitter")
+			qrc.log.Debugf("Output channel didn't accept code, closing channel")
+			qrc.closeWith(QRChannelTimeout, true)
 			return
-		case <-qrc.ctx.Done():
-			qrc.log.Debugf("Context is done, stopping QR emitter")
-			if atomic.CompareAndSwapUint32(&qrc.closed, 0, 1) {
-				close(qrc.output)
-				go qrc.cli.RemoveEventHandler(qrc.handlerID)
-				qrc.cli.Disconnect()
-			}
-		}
-	}
-}
-
-func (qrc *qrChannel) handleEvent(rawEvt interface{}) {
-	if atomic.LoadUint32(&qrc.closed) == 1 {
-		qrc.log.Debugf("Dropping event of type %T, channel is closed", rawEvt)
-		return
-	}
-	var outputType QRChannelItem
-	switch evt := rawEvt.(type) {
-	case *events.QR:
-		qrc.log.Debugf("Received QR code event, starting to emit codes to channel")
-		go qrc.emitQRs(evt)
-		return
-	case *events.QRScannedWithoutMultidevice:
-		qrc.log.Debugf("QR code scanned without multidevice enabled")
-		qrc.output <- QRChannelScannedWithoutMultidevice
-		return
-	case *events.PairSuccess:
-		outputType = QRChannelSuccess
-	case *events.PairError:
-		outputType = QRChannelItem{
-			Event: "error",
-			Error: evt.Error,
 		}
-	case *events.Disconnected:
-		outputType = QRChannelTimeout
-	case *events.Connected, *events.ConnectFailure, *events.LoggedOut:
-		outputType = QRChannelErrUnexpectedEvent
-	default:
-		return
-	}
-	close(qrc.stopQRs)
-	if atomic.CompareAndSwapUint32(&qrc.closed, 0, 1) {
-		qrc.log.Debugf("Closing channel with status %+v", outputType)
-		qrc.output <- outputType
-		close(qrc.output)
-	} else {
-		qrc.log.Debugf("Got status %+v, but channel is already closed", outputType)
+		timer = time.After(timeout)
 	}
-	// Has to be done in background because otherwise there's a deadlock with eventHandlersLock
-	go qrc.cli.RemoveEventHandler(qrc.handlerID)
 }
 
 // GetQRChannel returns a channel that automatically outputs a new QR code when the previous one expires.
 //
 // This must be called *before* Connect(). It will then listen to all the relevant events from the client.
 //
-// The last value to be emitted will be a special string, either "success", "timeout" or "err-already-have-id",
+// Each code item has a Timeout after which the next code will be sent. The last value to be emitted will be one of
+// the special items, like QRChannelSuccess, QRChannelTimeout or an item with the QRChannelEventError event,
 // depending on the result of the pairing. The channel will be closed immediately after one of those.
+//
+// If the context is canceled before pairing finishes, QRChannelTimeout is emitted and the client is disconnected.
 func (cli *Client) GetQRChannel(ctx context.Context) (<-chan QRChannelItem, error) {
 	if cli.IsConnected() {
 		return nil, ErrQRAlreadyConnected
@@ -156,13 +176,15 @@ func (cli *Client) GetQRChannel(ctx context.Context) (<-chan QRChannelItem, erro
 		return nil, ErrQRStoreContainsID
 	}
 	ch := make(chan QRChannelItem, 8)
-	qrc := qrChannel{
-		output:  ch,
-		stopQRs: make(chan struct{}),
-		cli:     cli,
-		log:     cli.Log.Sub("QRChannel"),
-		ctx:     ctx,
+	qrc := &qrChannel{
+		output: ch,
+		events: make(chan interface{}, 8),
+		done:   make(chan struct{}),
+		cli:    cli,
+		log:    cli.Log.Sub("QRChannel"),
+		ctx:    ctx,
 	}
 	qrc.handlerID = cli.AddEventHandler(qrc.handleEvent)
+	go qrc.run()
 	return ch, nil
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func readQRItem(t *testing.T, ch <-chan QRChannelItem) (QRChannelItem, bool) {
+	select {
+	case item, ok := <-ch:
+		return item, ok
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for QR channel item")
+		return QRChannelItem{}, false
+	}
+}
+
+func TestQRChannelCancelWhileEmitting(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := cli.GetQRChannel(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get QR channel: %v", err)
+	}
+	cli.dispatchEvent(&events.QR{Codes: []string{"1", "2", "3", "4", "5", "6"}})
+	if item, _ := readQRItem(t, ch); item.Event != QRChannelEventCode || item.Code != "1" {
+		t.Fatalf("Expected first code, got %+v", item)
+	}
+	// Send more events concurrently with the cancellation to make sure nothing is sent after the close
+	go func() {
+		for i := 0; i < 10; i++ {
+			cli.dispatchEvent(&events.QR{Codes: []string{"a", "b"}})
+		}
+		cli.dispatchEvent(&events.PairSuccess{})
+	}()
+	cancel()
+	for {
+		item, ok := readQRItem(t, ch)
+		if !ok {
+			break
+		} else if item.Event != QRChannelEventCode && item != QRChannelTimeout && item != QRChannelSuccess {
+			t.Fatalf("Unexpected item %+v", item)
+		}
+	}
+}
+
+func TestQRChannelSuccess(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	ch, err := cli.GetQRChannel(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get QR channel: %v", err)
+	}
+	cli.dispatchEvent(&events.QR{Codes: []string{"1", "2"}})
+	if item, _ := readQRItem(t, ch); item.Code != "1" {
+		t.Fatalf("Expected first code, got %+v", item)
+	}
+	cli.dispatchEvent(&events.PairSuccess{})
+	if item, _ := readQRItem(t, ch); item != QRChannelSuccess {
+		t.Fatalf("Expected success item, got %+v", item)
+	}
+	if _, ok := readQRItem(t, ch); ok {
+		t.Fatalf("Channel wasn't closed after success item")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// RateLimit is a token bucket rate limit: up to Burst messages can be sent at once,
+// after which messages are sent at PerSecond messages per second. A zero PerSecond means no limit.
+type RateLimit struct {
+	PerSecond float64
+	Burst     int
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (limit RateLimit) burst() float64 {
+	if limit.Burst < 1 {
+		return 1
+	}
+	return float64(limit.Burst)
+}
+
+// refill adds the tokens generated since the last update and returns true if the bucket is full.
+func (bucket *tokenBucket) refill(limit RateLimit, now time.Time) bool {
+	bucket.tokens += now.Sub(bucket.last).Seconds() * limit.PerSecond
+	bucket.last = now
+	if bucket.tokens >= limit.burst() {
+		bucket.tokens = limit.burst()
+		return true
+	}
+	return false
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+// The token count can go negative, which makes later reservations wait for their turn.
+func (bucket *tokenBucket) reserve(limit RateLimit, now time.Time) time.Duration {
+	if limit.PerSecond <= 0 {
+		return 0
+	}
+	bucket.refill(limit, now)
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / limit.PerSecond * float64(time.Second))
+}
+
+// Per-chat buckets are cleaned up when there are more than this many of them.
+const rateLimitChatCleanupThreshold = 1024
+
+// SendRateLimiter limits how fast messages are sent, both globally and per chat. It can be set in Client.SendRateLimiter
+// to avoid tripping WhatsApp's spam detection when sending lots of messages.
+//
+// Messages that would exceed the limit are queued (i.e. the send method blocks) until it's their turn,
+// and an events.SendThrottled event is emitted.
+type SendRateLimiter struct {
+	Global  RateLimit
+	PerChat RateLimit
+
+	lock    sync.Mutex
+	global  tokenBucket
+	chats   map[types.JID]*tokenBucket
+	waiting int
+}
+
+// NewSendRateLimiter creates a new rate limiter with the given global and per-chat limits.
+func NewSendRateLimiter(global, perChat RateLimit) *SendRateLimiter {
+	now := time.Now()
+	return &SendRateLimiter{
+		Global:  global,
+		PerChat: perChat,
+		global:  tokenBucket{tokens: global.burst(), last: now},
+		chats:   make(map[types.JID]*tokenBucket),
+	}
+}
+
+// QueueDepth returns the number of messages that are currently waiting to be sent due to the rate limit.
+func (rl *SendRateLimiter) QueueDepth() int {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	return rl.waiting
+}
+
+func (rl *SendRateLimiter) reserve(chat types.JID, now time.Time) time.Duration {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	wait := rl.global.reserve(rl.Global, now)
+	if rl.PerChat.PerSecond > 0 {
+		if len(rl.chats) > rateLimitChatCleanupThreshold {
+			for jid, bucket := range rl.chats {
+				if bucket.refill(rl.PerChat, now) {
+					delete(rl.chats, jid)
+				}
+			}
+		}
+		if rl.chats == nil {
+			rl.chats = make(map[types.JID]*tokenBucket)
+		}
+		bucket, ok := rl.chats[chat]
+		if !ok {
+			bucket = &tokenBucket{tokens: rl.PerChat.burst(), last: now}
+			rl.chats[chat] = bucket
+		}
+		if chatWait := bucket.reserve(rl.PerChat, now); chatWait > wait {
+			wait = chatWait
+		}
+	}
+	if wait > 0 {
+		rl.waiting++
+	}
+	return wait
+}
+
+// cancel returns the tokens of a reservation that wasn't used.
+func (rl *SendRateLimiter) cancel(chat types.JID) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	if rl.Global.PerSecond > 0 {
+		rl.global.tokens++
+	}
+	if bucket, ok := rl.chats[chat]; ok {
+		bucket.tokens++
+	}
+}
+
+func (rl *SendRateLimiter) doneWaiting() {
+	rl.lock.Lock()
+	rl.waiting--
+	rl.lock.Unlock()
+}
+
+// waitSendRateLimit blocks until the given message can be sent according to Client.SendRateLimiter.
+func (cli *Client) waitSendRateLimit(ctx context.Context, to types.JID, id types.MessageID) error {
+	rl := cli.SendRateLimiter
+	if rl == nil {
+		return nil
+	}
+	wait := rl.reserve(to, time.Now())
+	if wait <= 0 {
+		return nil
+	}
+	defer rl.doneWaiting()
+	cli.Log.Debugf("Delaying sending %s to %s by %s due to rate limit", id, to, wait)
+	// The event is dispatched in the background, as event handlers may need to send messages themselves
+	go cli.dispatchEvent(&events.SendThrottled{
+		Chat:       to,
+		MessageID:  id,
+		Delay:      wait,
+		QueueDepth: rl.QueueDepth(),
+	})
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		rl.cancel(to)
+		return ctx.Err()
+	}
+}
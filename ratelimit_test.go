@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestSendRateLimiter(t *testing.T) {
+	rl := NewSendRateLimiter(RateLimit{PerSecond: 10, Burst: 3}, RateLimit{PerSecond: 1, Burst: 2})
+	alice := types.NewJID("1111111111", types.DefaultUserServer)
+	bob := types.NewJID("2222222222", types.DefaultUserServer)
+	now := time.Now()
+
+	if wait := rl.reserve(alice, now); wait != 0 {
+		t.Errorf("First message was delayed by %s", wait)
+	}
+	if wait := rl.reserve(alice, now); wait != 0 {
+		t.Errorf("Second message within chat burst was delayed by %s", wait)
+	}
+	if wait := rl.reserve(alice, now); wait != time.Second {
+		t.Errorf("Expected third message to same chat to wait 1s, got %s", wait)
+	}
+	// The global burst of 3 is used up now, so other chats have to wait for the global limit
+	if wait := rl.reserve(bob, now); wait != 100*time.Millisecond {
+		t.Errorf("Expected message to other chat to wait 100ms, got %s", wait)
+	}
+	if depth := rl.QueueDepth(); depth != 2 {
+		t.Errorf("Expected queue depth 2, got %d", depth)
+	}
+	if wait := rl.reserve(bob, now.Add(time.Second)); wait != 0 {
+		t.Errorf("Message after refill was delayed by %s", wait)
+	}
+}
+
+func TestSendThrottledEvent(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	cli.SendRateLimiter = NewSendRateLimiter(RateLimit{}, RateLimit{PerSecond: 50, Burst: 1})
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	sent := make(chan struct{})
+	throttled := make(chan *events.SendThrottled, 1)
+	cli.AddEventHandler(func(evt interface{}) {
+		if evt, ok := evt.(*events.SendThrottled); ok {
+			// A handler that waits for the message to be sent would deadlock if the event was dispatched synchronously
+			<-sent
+			throttled <- evt
+		}
+	})
+
+	if err := cli.waitSendRateLimit(context.Background(), chat, "FIRST"); err != nil {
+		t.Fatalf("First message failed: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cli.waitSendRateLimit(context.Background(), chat, "SECOND")
+	}()
+	select {
+	case err := <-done:
+		close(sent)
+		if err != nil {
+			t.Fatalf("Second message failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Sending was blocked by the SendThrottled event handler")
+	}
+	select {
+	case evt := <-throttled:
+		if evt.Chat != chat || evt.MessageID != "SECOND" || evt.Delay <= 0 || evt.QueueDepth != 1 {
+			t.Errorf("Unexpected SendThrottled event: %+v", evt)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("SendThrottled event wasn't dispatched")
+	}
+}
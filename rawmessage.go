@@ -0,0 +1,56 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// Field numbers of the waProto.Message and waProto.DeviceSentMessage fields that are added around
+// already-serialized messages.
+//
+// Outgoing messages are marshaled exactly once in SendMessage and the plaintext bytes are reused for everything
+// else (the own-device copy, the recent message cache and retry receipts), so that fields which this version of
+// the protobuf definitions doesn't know about are sent byte-for-byte identically every time. The helpers in this
+// file only append fields to the original bytes and must never unmarshal and re-marshal them.
+//
+// Builders that construct new messages out of old ones (e.g. forwarding) intentionally only copy known fields.
+const (
+	messageFieldSenderKeyDistributionMessage protowire.Number = 2
+	messageFieldDeviceSentMessage            protowire.Number = 31
+
+	deviceSentMessageFieldDestinationJID protowire.Number = 1
+	deviceSentMessageFieldMessage        protowire.Number = 2
+)
+
+// wrapDeviceSentMessage wraps the given serialized message in a DeviceSentMessage, which is what gets sent to our
+// own other devices when sending a DM. The result is equivalent to marshaling a waProto.Message with the
+// DeviceSentMessage field set, but the original bytes are embedded as-is.
+func wrapDeviceSentMessage(to types.JID, plaintext []byte) []byte {
+	var dsm []byte
+	dsm = protowire.AppendTag(dsm, deviceSentMessageFieldDestinationJID, protowire.BytesType)
+	dsm = protowire.AppendString(dsm, to.String())
+	dsm = protowire.AppendTag(dsm, deviceSentMessageFieldMessage, protowire.BytesType)
+	dsm = protowire.AppendBytes(dsm, plaintext)
+
+	var wrapped []byte
+	wrapped = protowire.AppendTag(wrapped, messageFieldDeviceSentMessage, protowire.BytesType)
+	wrapped = protowire.AppendBytes(wrapped, dsm)
+	return wrapped
+}
+
+// appendSenderKeyDistribution appends a serialized SenderKeyDistributionMessage to the given serialized message.
+// The original bytes are copied without modifications.
+func appendSenderKeyDistribution(plaintext, skdm []byte) []byte {
+	output := make([]byte, len(plaintext), len(plaintext)+len(skdm)+8)
+	copy(output, plaintext)
+	output = protowire.AppendTag(output, messageFieldSenderKeyDistributionMessage, protowire.BytesType)
+	output = protowire.AppendBytes(output, skdm)
+	return output
+}
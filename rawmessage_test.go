@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// goldenMessageWithUnknownFields returns a serialized extended text message that contains
+// fields which aren't in the protobuf definitions, both at the top level and inside the nested message.
+func goldenMessageWithUnknownFields(t *testing.T) []byte {
+	extendedText, err := proto.Marshal(&waProto.ExtendedTextMessage{Text: proto.String("hello")})
+	if err != nil {
+		t.Fatalf("Failed to marshal extended text message: %v", err)
+	}
+	extendedText = protowire.AppendTag(extendedText, 9001, protowire.VarintType)
+	extendedText = protowire.AppendVarint(extendedText, 42)
+
+	var golden []byte
+	golden = protowire.AppendTag(golden, 6, protowire.BytesType)
+	golden = protowire.AppendBytes(golden, extendedText)
+	golden = protowire.AppendTag(golden, 9002, protowire.BytesType)
+	golden = protowire.AppendString(golden, "future feature")
+	return golden
+}
+
+func assertRoundTrip(t *testing.T, golden []byte, msg *waProto.Message) {
+	if len(msg.ProtoReflect().GetUnknown()) == 0 {
+		t.Errorf("Top-level unknown field was dropped")
+	}
+	if len(msg.GetExtendedTextMessage().ProtoReflect().GetUnknown()) == 0 {
+		t.Errorf("Nested unknown field was dropped")
+	}
+	remarshaled, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to re-marshal message: %v", err)
+	}
+	if !bytes.Equal(remarshaled, golden) {
+		t.Errorf("Re-marshaled message differs from original:\n%x\n%x", remarshaled, golden)
+	}
+}
+
+func TestRetryPlaintextPreservesUnknownFields(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	to := types.NewJID("1111111111", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	golden := goldenMessageWithUnknownFields(t)
+
+	var parsed waProto.Message
+	err := proto.Unmarshal(golden, &parsed)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal golden message: %v", err)
+	}
+	assertRoundTrip(t, golden, &parsed)
+
+	cli.addRecentMessage(to, "AAAA", golden)
+	receipt := &events.Receipt{MessageSource: types.MessageSource{Chat: to, Sender: to}}
+	cached, err := cli.getMessageForRetry(receipt, "AAAA")
+	if err != nil {
+		t.Fatalf("Failed to get message for retry: %v", err)
+	} else if !bytes.Equal(cached, golden) {
+		t.Errorf("Cached message differs from original:\n%x\n%x", cached, golden)
+	}
+
+	dsm := wrapDeviceSentMessage(to, cached)
+	if !bytes.HasSuffix(dsm, golden) {
+		t.Errorf("Device sent message doesn't contain the original bytes as-is")
+	}
+	var wrapped waProto.Message
+	err = proto.Unmarshal(dsm, &wrapped)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal device sent message: %v", err)
+	} else if wrapped.GetDeviceSentMessage().GetDestinationJid() != to.String() {
+		t.Errorf("Unexpected device sent message destination %q", wrapped.GetDeviceSentMessage().GetDestinationJid())
+	}
+	assertRoundTrip(t, golden, wrapped.GetDeviceSentMessage().GetMessage())
+
+	skdm, err := proto.Marshal(&waProto.SenderKeyDistributionMessage{GroupId: proto.String("123456789-123456@g.us")})
+	if err != nil {
+		t.Fatalf("Failed to marshal sender key distribution message: %v", err)
+	}
+	withSKDM := appendSenderKeyDistribution(cached, skdm)
+	if !bytes.HasPrefix(withSKDM, golden) {
+		t.Errorf("Message with sender key distribution doesn't start with the original bytes")
+	} else if !bytes.Equal(cached, golden) {
+		t.Errorf("Appending sender key distribution modified the cached message")
+	}
+	var withSKDMParsed waProto.Message
+	err = proto.Unmarshal(withSKDM, &withSKDMParsed)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal message with sender key distribution: %v", err)
+	} else if withSKDMParsed.GetSenderKeyDistributionMessage().GetGroupId() != "123456789-123456@g.us" {
+		t.Errorf("Sender key distribution message missing after appending")
+	} else if len(withSKDMParsed.ProtoReflect().GetUnknown()) == 0 {
+		t.Errorf("Top-level unknown field was dropped after appending sender key distribution")
+	}
+}
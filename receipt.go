@@ -16,26 +16,84 @@ import (
 )
 
 func (cli *Client) handleReceipt(node *waBinary.Node) {
-	receipt, err := cli.parseReceipt(node)
+	receipts, err := cli.parseReceipts(node)
 	if err != nil {
 		cli.Log.Warnf("Failed to parse receipt: %v", err)
-	} else {
+	}
+	for _, receipt := range receipts {
 		if receipt.Type == events.ReceiptTypeRetry {
-			go func() {
+			go func(receipt *events.Receipt) {
 				err := cli.handleRetryReceipt(receipt, node)
 				if err != nil {
 					cli.Log.Errorf("Failed to handle retry receipt for %s/%s from %s: %v", receipt.Chat, receipt.MessageIDs[0], receipt.Sender, err)
 				}
-			}()
+			}(receipt)
+		}
+		cli.trackUnread(receipt)
+		for _, evt := range cli.handleViewOnceOpenedReceipt(receipt) {
+			go cli.dispatchEvent(evt)
 		}
 		go cli.dispatchEvent(receipt)
 	}
 	go cli.sendAck(node)
 }
 
+// parseReceipts parses a receipt node into receipt events. Group receipts that the server has combined from
+// multiple participants are split into one event per participant.
+func (cli *Client) parseReceipts(node *waBinary.Node) ([]*events.Receipt, error) {
+	source, err := cli.parseMessageSource(node, false)
+	if err != nil {
+		return nil, err
+	} else if source.IsGroup && source.Sender.IsEmpty() {
+		return cli.parseGroupedReceipt(node, source)
+	}
+	receipt, err := cli.parseReceipt(node)
+	if err != nil {
+		return nil, err
+	}
+	return []*events.Receipt{receipt}, nil
+}
+
+// parseGroupedReceipt parses a group receipt without a participant attribute, which contains the receipts
+// of multiple participants, e.g. <participants key="message ID"><user jid="participant" t="timestamp"/></participants>
+func (cli *Client) parseGroupedReceipt(node *waBinary.Node, source types.MessageSource) ([]*events.Receipt, error) {
+	receiptType := events.ReceiptType(node.AttrGetter().OptionalString("type"))
+	participantsNodes := node.GetChildrenByTag("participants")
+	if len(participantsNodes) == 0 {
+		return nil, &ElementMissingError{Tag: "participants", In: "grouped receipt"}
+	}
+	var receipts []*events.Receipt
+	for _, participants := range participantsNodes {
+		ag := participants.AttrGetter()
+		messageID := ag.String("key")
+		if !ag.OK() {
+			return receipts, fmt.Errorf("failed to parse participants in grouped receipt: %w", ag.Error())
+		}
+		for _, user := range participants.GetChildren() {
+			if user.Tag != "user" {
+				continue
+			}
+			userAG := user.AttrGetter()
+			receipt := &events.Receipt{
+				MessageSource: source,
+				MessageIDs:    []types.MessageID{messageID},
+				Timestamp:     time.Unix(userAG.Int64("t"), 0),
+				Type:          receiptType,
+			}
+			receipt.Sender = userAG.JID("jid")
+			if !userAG.OK() {
+				return receipts, fmt.Errorf("failed to parse user in grouped receipt: %w", userAG.Error())
+			}
+			receipt.IsFromMe = cli.Store.ID != nil && receipt.Sender.User == cli.Store.ID.User
+			receipts = append(receipts, receipt)
+		}
+	}
+	return receipts, nil
+}
+
 func (cli *Client) parseReceipt(node *waBinary.Node) (*events.Receipt, error) {
 	ag := node.AttrGetter()
-	source, err := cli.parseMessageSource(node)
+	source, err := cli.parseMessageSource(node, true)
 	if err != nil {
 		return nil, err
 	}
@@ -89,23 +147,94 @@ func (cli *Client) sendAck(node *waBinary.Node) {
 	}
 }
 
+// Number of received audio messages to remember for Client.MarkAudioPlayedOnRead and for telling apart played
+// receipts of voice messages and view-once messages.
+const audioMessagesSize = 256
+
+type audioMessageKey struct {
+	Chat types.JID
+	ID   types.MessageID
+}
+
+func (cli *Client) addAudioMessage(info *types.MessageInfo) {
+	cli.audioMessageLock.Lock()
+	if oldKey := cli.audioMessageList[cli.audioMessagePtr]; oldKey.ID != "" {
+		delete(cli.audioMessages, oldKey)
+	}
+	key := audioMessageKey{info.Chat, info.ID}
+	cli.audioMessages[key] = struct{}{}
+	cli.audioMessageList[cli.audioMessagePtr] = key
+	cli.audioMessagePtr = (cli.audioMessagePtr + 1) % len(cli.audioMessageList)
+	cli.audioMessageLock.Unlock()
+}
+
+// filterAudioMessages returns the IDs of the given messages that are known to be incoming audio messages.
+func (cli *Client) filterAudioMessages(chat types.JID, ids []types.MessageID) []types.MessageID {
+	cli.audioMessageLock.Lock()
+	defer cli.audioMessageLock.Unlock()
+	var audioIDs []types.MessageID
+	for _, id := range ids {
+		if _, ok := cli.audioMessages[audioMessageKey{chat, id}]; ok {
+			audioIDs = append(audioIDs, id)
+		}
+	}
+	return audioIDs
+}
+
 // MarkRead sends a read receipt for the given message IDs including the given timestamp as the read at time.
+// All the IDs are sent in a single receipt stanza. If read receipts are disabled in the privacy settings,
+// a read-self receipt is sent instead, which only marks the messages as read on the user's own devices.
 //
 // The first JID parameter (chat) must always be set to the chat ID (user ID in DMs and group ID in group chats).
 // The second JID parameter (sender) must be set in group chats and must be the user ID who sent the message.
+//
+// If Client.MarkAudioPlayedOnRead is set, played receipts are also sent for any audio messages in the list.
 func (cli *Client) MarkRead(ids []types.MessageID, timestamp time.Time, chat, sender types.JID) error {
+	if len(ids) == 0 {
+		return ErrNoMessageIDs
+	}
+	receiptType := events.ReceiptTypeRead
+	if cli.GetPrivacySettings().ReadReceipts == types.PrivacySettingNone {
+		receiptType = events.ReceiptTypeReadSelf
+	}
+	err := cli.sendNode(buildReceiptNode(ids, receiptType, timestamp, chat, sender))
+	if err != nil || !cli.MarkAudioPlayedOnRead {
+		return err
+	}
+	if audioIDs := cli.filterAudioMessages(chat, ids); len(audioIDs) > 0 {
+		err = cli.MarkPlayed(audioIDs, timestamp, chat, sender)
+	}
+	return err
+}
+
+// MarkPlayed sends a played receipt for the given voice messages or view-once media messages. The parameters are
+// the same as in MarkRead. If read receipts are disabled in the privacy settings, a played-self receipt is sent instead.
+//
+// The official clients show played voice messages with a blue microphone icon. Played receipts don't mark messages
+// as read, so MarkRead should be called too (or enable Client.MarkAudioPlayedOnRead to send both with MarkRead).
+func (cli *Client) MarkPlayed(ids []types.MessageID, timestamp time.Time, chat, sender types.JID) error {
+	if len(ids) == 0 {
+		return ErrNoMessageIDs
+	}
+	receiptType := events.ReceiptTypePlayed
+	if cli.GetPrivacySettings().ReadReceipts == types.PrivacySettingNone {
+		receiptType = events.ReceiptTypePlayedSelf
+	}
+	return cli.sendNode(buildReceiptNode(ids, receiptType, timestamp, chat, sender))
+}
+
+// buildReceiptNode builds a receipt stanza for the given message IDs. The first ID is the main ID of the receipt,
+// and the rest are included in a list inside the receipt.
+func buildReceiptNode(ids []types.MessageID, receiptType events.ReceiptType, timestamp time.Time, chat, sender types.JID) waBinary.Node {
 	node := waBinary.Node{
 		Tag: "receipt",
 		Attrs: waBinary.Attrs{
 			"id":   ids[0],
-			"type": "read",
+			"type": string(receiptType),
 			"to":   chat,
 			"t":    timestamp.Unix(),
 		},
 	}
-	if cli.GetPrivacySettings().ReadReceipts == types.PrivacySettingNone {
-		node.Attrs["type"] = "read-self"
-	}
 	if !sender.IsEmpty() && chat.Server != types.DefaultUserServer {
 		node.Attrs["participant"] = sender.ToNonAD()
 	}
@@ -120,7 +249,35 @@ func (cli *Client) MarkRead(ids []types.MessageID, timestamp time.Time, chat, se
 			Content: children,
 		}}
 	}
-	return cli.sendNode(node)
+	return node
+}
+
+// DeliveryReceiptChats is a set of chat types, used for Client.AutoSendDeliveryReceipts.
+type DeliveryReceiptChats uint8
+
+// The chat types that can be combined in DeliveryReceiptChats.
+const (
+	DeliveryReceiptsPrivate DeliveryReceiptChats = 1 << iota
+	DeliveryReceiptsGroup
+	// Status updates and broadcast lists
+	DeliveryReceiptsBroadcast
+
+	DeliveryReceiptsNone = DeliveryReceiptChats(0)
+	DeliveryReceiptsAll  = DeliveryReceiptsPrivate | DeliveryReceiptsGroup | DeliveryReceiptsBroadcast
+)
+
+// Includes returns true if the type of the given chat is in the set.
+func (drc DeliveryReceiptChats) Includes(chat types.JID) bool {
+	switch chat.Server {
+	case types.DefaultUserServer:
+		return drc&DeliveryReceiptsPrivate != 0
+	case types.GroupServer:
+		return drc&DeliveryReceiptsGroup != 0
+	case types.BroadcastServer:
+		return drc&DeliveryReceiptsBroadcast != 0
+	default:
+		return false
+	}
 }
 
 func (cli *Client) sendMessageReceipt(info *types.MessageInfo) {
@@ -129,7 +286,7 @@ func (cli *Client) sendMessageReceipt(info *types.MessageInfo) {
 	}
 	if info.IsFromMe {
 		attrs["type"] = "sender"
-	} else {
+	} else if !cli.AutoSendDeliveryReceipts.Includes(info.Chat) {
 		attrs["type"] = "inactive"
 	}
 	attrs["to"] = info.Chat
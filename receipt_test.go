@@ -0,0 +1,131 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestDeliveryReceiptChatsIncludes(t *testing.T) {
+	user := types.NewJID("1111", types.DefaultUserServer)
+	group := types.NewJID("12345-67890", types.GroupServer)
+	tests := []struct {
+		chats    DeliveryReceiptChats
+		chat     types.JID
+		expected bool
+	}{
+		{DeliveryReceiptsNone, user, false},
+		{DeliveryReceiptsAll, user, true},
+		{DeliveryReceiptsAll, types.StatusBroadcastJID, true},
+		{DeliveryReceiptsPrivate, user, true},
+		{DeliveryReceiptsPrivate, group, false},
+		{DeliveryReceiptsPrivate | DeliveryReceiptsGroup, group, true},
+		{DeliveryReceiptsGroup, types.StatusBroadcastJID, false},
+	}
+	for _, test := range tests {
+		if result := test.chats.Includes(test.chat); result != test.expected {
+			t.Errorf("Expected %v for %s with %b, got %v", test.expected, test.chat, test.chats, result)
+		}
+	}
+}
+
+func TestBuildReceiptNode(t *testing.T) {
+	group := types.NewJID("12345-67890", types.GroupServer)
+	sender := types.NewADJID("1111", 0, 3)
+	node := buildReceiptNode([]types.MessageID{"A", "B", "C"}, events.ReceiptTypeRead, time.Unix(1600000000, 0), group, sender)
+	if node.Attrs["id"] != "A" || node.Attrs["type"] != "read" || node.Attrs["to"] != group || node.Attrs["t"] != int64(1600000000) {
+		t.Errorf("Unexpected receipt attributes %v", node.Attrs)
+	}
+	if node.Attrs["participant"] != sender.ToNonAD() {
+		t.Errorf("Expected non-AD participant, got %v", node.Attrs["participant"])
+	}
+	list := node.GetChildByTag("list")
+	items := list.GetChildren()
+	if len(items) != 2 || items[0].Attrs["id"] != "B" || items[1].Attrs["id"] != "C" {
+		t.Errorf("Unexpected receipt list %v", items)
+	}
+
+	dm := buildReceiptNode([]types.MessageID{"A"}, events.ReceiptTypeRead, time.Unix(1600000000, 0), sender.ToNonAD(), sender)
+	if _, hasParticipant := dm.Attrs["participant"]; hasParticipant || dm.Content != nil {
+		t.Errorf("Unexpected participant or list in DM receipt: %v", dm)
+	}
+}
+
+func TestFilterAudioMessages(t *testing.T) {
+	cli := &Client{
+		MarkAudioPlayedOnRead: true,
+		audioMessages:         make(map[audioMessageKey]struct{}),
+	}
+	chat := types.NewJID("1111", types.DefaultUserServer)
+	otherChat := types.NewJID("2222", types.DefaultUserServer)
+	cli.addAudioMessage(&types.MessageInfo{MessageSource: types.MessageSource{Chat: chat}, ID: "AUDIO"})
+	cli.addAudioMessage(&types.MessageInfo{MessageSource: types.MessageSource{Chat: otherChat}, ID: "OTHER"})
+	audioIDs := cli.filterAudioMessages(chat, []types.MessageID{"TEXT", "AUDIO", "OTHER"})
+	if len(audioIDs) != 1 || audioIDs[0] != "AUDIO" {
+		t.Errorf("Expected only the audio message in the chat, got %v", audioIDs)
+	}
+	// Fill the cache to make sure old messages are forgotten
+	for i := 0; i < audioMessagesSize; i++ {
+		cli.addAudioMessage(&types.MessageInfo{MessageSource: types.MessageSource{Chat: otherChat}, ID: fmt.Sprintf("%d", i)})
+	}
+	if audioIDs = cli.filterAudioMessages(chat, []types.MessageID{"AUDIO"}); len(audioIDs) != 0 {
+		t.Errorf("Expected old audio message to be removed from cache, got %v", audioIDs)
+	} else if len(cli.audioMessages) != audioMessagesSize {
+		t.Errorf("Expected cache to contain %d messages, got %d", audioMessagesSize, len(cli.audioMessages))
+	}
+}
+
+func TestParseGroupedReceipt(t *testing.T) {
+	ownID := types.NewADJID("1111", 0, 2)
+	cli := &Client{Store: &store.Device{ID: &ownID}}
+	group := types.NewJID("12345-67890", types.GroupServer)
+	alice := types.NewJID("2222", types.DefaultUserServer)
+	bob := types.NewADJID("3333", 0, 1)
+	receipts, err := cli.parseReceipts(&waBinary.Node{
+		Tag:   "receipt",
+		Attrs: waBinary.Attrs{"from": group, "id": "RECEIPT", "type": "read"},
+		Content: []waBinary.Node{
+			{Tag: "participants", Attrs: waBinary.Attrs{"key": "MSG1"}, Content: []waBinary.Node{
+				{Tag: "user", Attrs: waBinary.Attrs{"jid": alice, "t": "1600000000"}},
+				{Tag: "user", Attrs: waBinary.Attrs{"jid": bob, "t": "1600000100"}},
+			}},
+			{Tag: "participants", Attrs: waBinary.Attrs{"key": "MSG2"}, Content: []waBinary.Node{
+				{Tag: "user", Attrs: waBinary.Attrs{"jid": alice, "t": "1600000200"}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse grouped receipt: %v", err)
+	} else if len(receipts) != 3 {
+		t.Fatalf("Expected 3 receipts, got %d", len(receipts))
+	}
+	expected := []struct {
+		sender types.JID
+		id     types.MessageID
+		ts     int64
+	}{{alice, "MSG1", 1600000000}, {bob, "MSG1", 1600000100}, {alice, "MSG2", 1600000200}}
+	for i, exp := range expected {
+		receipt := receipts[i]
+		if receipt.Chat != group || !receipt.IsGroup || receipt.Sender != exp.sender || receipt.IsFromMe ||
+			len(receipt.MessageIDs) != 1 || receipt.MessageIDs[0] != exp.id ||
+			receipt.Timestamp.Unix() != exp.ts || receipt.Type != events.ReceiptTypeRead {
+			t.Errorf("Unexpected receipt #%d: %+v", i, receipt)
+		}
+	}
+
+	_, err = cli.parseReceipts(&waBinary.Node{Tag: "receipt", Attrs: waBinary.Attrs{"from": group, "id": "RECEIPT"}})
+	if err == nil {
+		t.Errorf("Expected error for group receipt without participants")
+	}
+}
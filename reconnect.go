@@ -0,0 +1,107 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// These are the default values for the auto-reconnect fields in Client. Changing them only affects clients created afterwards.
+var (
+	// AutoReconnectBaseDelay is the delay before the first automatic reconnection attempt.
+	// The delay is doubled after each failed attempt.
+	AutoReconnectBaseDelay = 2 * time.Second
+	// AutoReconnectMaxDelay is the maximum delay between automatic reconnection attempts.
+	AutoReconnectMaxDelay = 5 * time.Minute
+)
+
+// reconnectDelay calculates the exponential backoff delay for the given reconnection attempt (starting from 1).
+// The returned delay is randomized between half and the full backoff so that many clients don't reconnect at once.
+func reconnectDelay(attempt int, base, max time.Duration, rng *rand.Rand) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if max > 0 && delay > max {
+		delay = max
+	}
+	if delay <= 1 {
+		return delay
+	}
+	half := delay / 2
+	return half + time.Duration(rng.Int63n(int64(delay-half)+1))
+}
+
+// wakeAutoReconnect makes a running automatic reconnection loop skip the rest of its current delay.
+func (cli *Client) wakeAutoReconnect() {
+	select {
+	case cli.autoReconnectWake <- struct{}{}:
+	default:
+	}
+}
+
+// disconnectedState returns the connection state to switch to when the socket is closed or Connect() fails.
+// During automatic reconnections, the state stays as reconnecting instead of going to disconnected in between.
+func (cli *Client) disconnectedState() types.ConnectionState {
+	if atomic.LoadUint32(&cli.isAutoReconnecting) == 1 {
+		return types.ConnectionStateReconnecting
+	}
+	return types.ConnectionStateDisconnected
+}
+
+func (cli *Client) autoReconnect() {
+	defer atomic.StoreUint32(&cli.isAutoReconnecting, 0)
+	if !cli.EnableAutoReconnect || cli.Store.ID == nil {
+		cli.setConnectionState(types.ConnectionStateDisconnected)
+		return
+	}
+	atomic.StoreUint32(&cli.isAutoReconnecting, 1)
+	// Drop wakeups that were meant for a previous reconnection loop
+	select {
+	case <-cli.autoReconnectWake:
+	default:
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var lastErr error
+	for {
+		cli.AutoReconnectErrors++
+		autoReconnectDelay := reconnectDelay(cli.AutoReconnectErrors, cli.AutoReconnectBaseDelay, cli.AutoReconnectMaxDelay, rng)
+		if cli.PreReconnectHook != nil && !cli.PreReconnectHook(cli.AutoReconnectErrors, autoReconnectDelay) {
+			cli.Log.Debugf("Pre-reconnect hook cancelled automatic reconnection attempt #%d", cli.AutoReconnectErrors)
+			cli.setConnectionState(types.ConnectionStateDisconnected)
+			return
+		}
+		cli.Log.Debugf("Automatically reconnecting after %v", autoReconnectDelay)
+		cli.setConnectionState(types.ConnectionStateReconnecting)
+		cli.dispatchEvent(&events.Reconnecting{
+			Attempt:   cli.AutoReconnectErrors,
+			Delay:     autoReconnectDelay,
+			LastError: lastErr,
+		})
+		select {
+		case <-time.After(autoReconnectDelay):
+		case <-cli.autoReconnectWake:
+			cli.Log.Debugf("Automatic reconnection delay interrupted, reconnecting immediately")
+		}
+		err := cli.Connect()
+		if errors.Is(err, ErrAlreadyConnected) {
+			cli.Log.Debugf("Connect() said we're already connected after autoreconnect sleep")
+			return
+		} else if err != nil {
+			cli.Log.Errorf("Error reconnecting after autoreconnect sleep: %v", err)
+			lastErr = err
+		} else {
+			return
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestReconnectDelay(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	base, max := 2*time.Second, time.Minute
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, time.Second, 2 * time.Second},
+		{2, 2 * time.Second, 4 * time.Second},
+		{4, 8 * time.Second, 16 * time.Second},
+		{6, 30 * time.Second, time.Minute},
+		{100, 30 * time.Second, time.Minute},
+	}
+	for _, test := range tests {
+		for i := 0; i < 100; i++ {
+			delay := reconnectDelay(test.attempt, base, max, rng)
+			if delay < test.min || delay > test.max {
+				t.Fatalf("Delay for attempt #%d is %s, expected between %s and %s", test.attempt, delay, test.min, test.max)
+			}
+		}
+	}
+}
@@ -20,15 +20,18 @@ func (cli *Client) generateRequestID() string {
 	return cli.uniqueID + strconv.FormatUint(uint64(atomic.AddUint32(&cli.idCounter, 1)), 10)
 }
 
+// closedNode is sent to all response waiters when the websocket is disconnected.
 var closedNode = &waBinary.Node{Tag: "xmlstreamend"}
 
 func (cli *Client) clearResponseWaiters() {
 	cli.responseWaitersLock.Lock()
 	for _, waiter := range cli.responseWaiters {
+		// Waiter channels are never closed, as that would make receivers get a nil node.
+		// The channels are buffered and removed from the map when they receive a response,
+		// so this shouldn't block, but don't risk a deadlock in case something is already buffered.
 		select {
 		case waiter <- closedNode:
 		default:
-			close(waiter)
 		}
 	}
 	cli.responseWaiters = make(map[string]chan<- *waBinary.Node)
@@ -43,9 +46,8 @@ func (cli *Client) waitResponse(reqID string) chan *waBinary.Node {
 	return ch
 }
 
-func (cli *Client) cancelResponse(reqID string, ch chan *waBinary.Node) {
+func (cli *Client) cancelResponse(reqID string) {
 	cli.responseWaitersLock.Lock()
-	close(ch)
 	delete(cli.responseWaiters, reqID)
 	cli.responseWaitersLock.Unlock()
 }
@@ -104,17 +106,25 @@ func (cli *Client) sendIQAsync(query infoQuery) (<-chan *waBinary.Node, error) {
 		Content: query.Content,
 	})
 	if err != nil {
-		cli.cancelResponse(query.ID, waiter)
+		cli.cancelResponse(query.ID)
 		return nil, err
 	}
 	return waiter, nil
 }
 
 func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
+	if len(query.ID) == 0 {
+		query.ID = cli.generateRequestID()
+	}
+	start := time.Now()
 	resChan, err := cli.sendIQAsync(query)
 	if err != nil {
 		return nil, err
 	}
+	return cli.receiveIQResponse(query, resChan, start)
+}
+
+func (cli *Client) receiveIQResponse(query infoQuery, resChan <-chan *waBinary.Node, start time.Time) (*waBinary.Node, error) {
 	if query.Timeout == 0 {
 		query.Timeout = 1 * time.Minute
 	}
@@ -124,7 +134,7 @@ func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
 	select {
 	case res := <-resChan:
 		if res == closedNode {
-			return nil, ErrIQDisconnected
+			return nil, &DisconnectedBeforeResponseError{RequestID: query.ID, Elapsed: time.Since(start)}
 		}
 		resType, _ := res.Attrs["type"].(string)
 		if res.Tag != "iq" || (resType != "result" && resType != "error") {
@@ -134,8 +144,10 @@ func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
 		}
 		return res, nil
 	case <-query.Context.Done():
+		cli.cancelResponse(query.ID)
 		return nil, query.Context.Err()
 	case <-time.After(query.Timeout):
+		cli.cancelResponse(query.ID)
 		return nil, ErrIQTimedOut
 	}
 }
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestDisconnectDuringInfoQueries(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	const queryCount = 200
+
+	var wg sync.WaitGroup
+	results := make(chan error, queryCount)
+	for i := 0; i < queryCount; i++ {
+		query := infoQuery{ID: fmt.Sprintf("req-%d", i), Timeout: 10 * time.Second}
+		resChan := cli.waitResponse(query.ID)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if err := recover(); err != nil {
+					results <- fmt.Errorf("panic: %v", err)
+				}
+			}()
+			_, err := cli.receiveIQResponse(query, resChan, time.Now())
+			results <- err
+		}()
+	}
+
+	// Race some responses and cancellations against the disconnection.
+	var raceWg sync.WaitGroup
+	raceWg.Add(2)
+	go func() {
+		defer raceWg.Done()
+		for i := 0; i < queryCount; i += 3 {
+			cli.receiveResponse(&waBinary.Node{Tag: "iq", Attrs: waBinary.Attrs{"id": fmt.Sprintf("req-%d", i), "type": "result"}})
+		}
+	}()
+	go func() {
+		defer raceWg.Done()
+		cli.clearResponseWaiters()
+	}()
+	raceWg.Wait()
+	wg.Wait()
+	close(results)
+
+	var succeeded, disconnected int
+	for err := range results {
+		var dbrErr *DisconnectedBeforeResponseError
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.As(err, &dbrErr):
+			disconnected++
+			if !errors.Is(err, ErrIQDisconnected) || !errors.Is(err, ErrDisconnectedBeforeResponse) || len(dbrErr.RequestID) == 0 {
+				t.Errorf("Unexpected disconnection error %#v", err)
+			}
+		default:
+			t.Errorf("Unexpected error from info query: %v", err)
+		}
+	}
+	if succeeded+disconnected != queryCount {
+		t.Errorf("Expected %d results, got %d successes and %d disconnections", queryCount, succeeded, disconnected)
+	}
+	if len(cli.responseWaiters) != 0 {
+		t.Errorf("Expected no response waiters to be left after disconnecting, got %d", len(cli.responseWaiters))
+	}
+}
@@ -7,6 +7,7 @@
 package whatsmeow
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"time"
@@ -26,6 +27,30 @@ import (
 // Number of sent messages to cache in memory for handling retry receipts.
 const recentMessagesSize = 256
 
+// DefaultPersistentRetryCacheTTL is the default value for Client.PersistentRetryCacheTTL.
+const DefaultPersistentRetryCacheTTL = 24 * time.Hour
+
+// These are the default values for the retry receipt fields in Client. Changing them only affects clients created afterwards.
+var (
+	// MaxRetryReceipts is the maximum number of retry receipts to send for a single undecryptable message.
+	MaxRetryReceipts = 4
+	// RetryReceiptDelay is the delay before sending the second retry receipt for a message.
+	// The delay is doubled for each subsequent receipt. Zero means retry receipts are sent immediately.
+	RetryReceiptDelay time.Duration
+)
+
+// retryReceiptDelay calculates the delay before sending the given retry receipt (starting from 1).
+func retryReceiptDelay(retryCount int, base time.Duration) time.Duration {
+	if retryCount <= 1 || base <= 0 {
+		return 0
+	}
+	delay := base
+	for i := 2; i < retryCount; i++ {
+		delay *= 2
+	}
+	return delay
+}
+
 type recentMessageKey struct {
 	To types.JID
 	ID types.MessageID
@@ -37,41 +62,81 @@ type RecentMessage struct {
 	Timestamp time.Time
 }
 
-func (cli *Client) addRecentMessage(to types.JID, id types.MessageID, message *waProto.Message) {
+// addRecentMessage stores the serialized plaintext of a sent message for handling retry receipts.
+// The bytes are stored rather than the parsed message so that retries are byte-identical to the original.
+func (cli *Client) addRecentMessage(to types.JID, id types.MessageID, plaintext []byte) {
 	cli.recentMessagesLock.Lock()
 	key := recentMessageKey{to, id}
 	if cli.recentMessagesList[cli.recentMessagesPtr].ID != "" {
 		delete(cli.recentMessagesMap, cli.recentMessagesList[cli.recentMessagesPtr])
 	}
-	cli.recentMessagesMap[key] = message
+	cli.recentMessagesMap[key] = plaintext
 	cli.recentMessagesList[cli.recentMessagesPtr] = key
 	cli.recentMessagesPtr++
 	if cli.recentMessagesPtr >= len(cli.recentMessagesList) {
 		cli.recentMessagesPtr = 0
 	}
 	cli.recentMessagesLock.Unlock()
+	if cli.persistentRetryCacheEnabled() {
+		err := cli.Store.SentMessages.PutSentMessage(to, id, plaintext)
+		if err != nil {
+			cli.Log.Warnf("Failed to store sent message %s/%s for handling retry receipts: %v", to, id, err)
+		}
+	}
 }
 
-func (cli *Client) getRecentMessage(to types.JID, id types.MessageID) *waProto.Message {
+func (cli *Client) persistentRetryCacheEnabled() bool {
+	return cli.EnablePersistentRetryCache && cli.Store.SentMessages != nil
+}
+
+// pruneSentMessages removes messages older than Client.PersistentRetryCacheTTL from the persistent retry cache.
+func (cli *Client) pruneSentMessages() {
+	if !cli.persistentRetryCacheEnabled() || cli.PersistentRetryCacheTTL <= 0 {
+		return
+	}
+	err := cli.Store.SentMessages.DeleteOldSentMessages(time.Now().Add(-cli.PersistentRetryCacheTTL))
+	if err != nil {
+		cli.Log.Warnf("Failed to prune old messages from persistent retry cache: %v", err)
+	}
+}
+
+func (cli *Client) getRecentMessage(to types.JID, id types.MessageID) []byte {
 	cli.recentMessagesLock.RLock()
 	msg, _ := cli.recentMessagesMap[recentMessageKey{to, id}]
 	cli.recentMessagesLock.RUnlock()
 	return msg
 }
 
-func (cli *Client) getMessageForRetry(receipt *events.Receipt, messageID types.MessageID) (*waProto.Message, error) {
-	msg := cli.getRecentMessage(receipt.Chat, messageID)
-	if msg == nil {
-		msg = cli.GetMessageForRetry(receipt.Chat, messageID)
-		if msg == nil {
-			return nil, fmt.Errorf("couldn't find message %s", messageID)
-		} else {
-			cli.Log.Debugf("Found message in GetMessageForRetry to accept retry receipt for %s/%s from %s", receipt.Chat, messageID, receipt.Sender)
-		}
-	} else {
+// getMessageForRetry returns the serialized plaintext of a sent message for handling a retry receipt.
+//
+// Messages from the local cache are returned exactly as they were originally sent. Messages from the
+// GetMessageForRetry callback have to be marshaled again, which keeps unknown fields (as long as the
+// callback didn't discard them), but doesn't guarantee that the bytes are identical to the original.
+func (cli *Client) getMessageForRetry(receipt *events.Receipt, messageID types.MessageID) ([]byte, error) {
+	plaintext := cli.getRecentMessage(receipt.Chat, messageID)
+	if plaintext != nil {
 		cli.Log.Debugf("Found message in local cache to accept retry receipt for %s/%s from %s", receipt.Chat, messageID, receipt.Sender)
+		return plaintext, nil
+	}
+	if cli.persistentRetryCacheEnabled() {
+		plaintext, err := cli.Store.SentMessages.GetSentMessage(receipt.Chat, messageID)
+		if err != nil {
+			cli.Log.Warnf("Failed to get %s/%s from persistent retry cache: %v", receipt.Chat, messageID, err)
+		} else if plaintext != nil {
+			cli.Log.Debugf("Found message in persistent cache to accept retry receipt for %s/%s from %s", receipt.Chat, messageID, receipt.Sender)
+			return plaintext, nil
+		}
 	}
-	return proto.Clone(msg).(*waProto.Message), nil
+	msg := cli.GetMessageForRetry(receipt.Chat, messageID)
+	if msg == nil {
+		return nil, fmt.Errorf("couldn't find message %s", messageID)
+	}
+	cli.Log.Debugf("Found message in GetMessageForRetry to accept retry receipt for %s/%s from %s", receipt.Chat, messageID, receipt.Sender)
+	plaintext, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return plaintext, nil
 }
 
 // handleRetryReceipt handles an incoming retry receipt for an outgoing message.
@@ -87,10 +152,21 @@ func (cli *Client) handleRetryReceipt(receipt *events.Receipt, node *waBinary.No
 	if !ag.OK() {
 		return ag.Error()
 	}
-	msg, err := cli.getMessageForRetry(receipt, messageID)
+	plaintext, err := cli.getMessageForRetry(receipt, messageID)
 	if err != nil {
 		return err
 	}
+	if cli.PreRetryCallback != nil {
+		var msg waProto.Message
+		err = proto.Unmarshal(plaintext, &msg)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal message for PreRetryCallback: %w", err)
+		}
+		if !cli.PreRetryCallback(receipt, messageID, retryCount, &msg) {
+			cli.Log.Debugf("Cancelled retry #%d for %s/%s to %s due to PreRetryCallback", retryCount, receipt.Chat, messageID, receipt.Sender)
+			return nil
+		}
+	}
 
 	if receipt.IsGroup {
 		builder := groups.NewGroupSessionBuilder(cli.Store, pbSerializer)
@@ -99,22 +175,18 @@ func (cli *Client) handleRetryReceipt(receipt *events.Receipt, node *waBinary.No
 		if err != nil {
 			cli.Log.Warnf("Failed to create sender key distribution message to include in retry of %s in %s to %s: %v", messageID, receipt.Chat, receipt.Sender, err)
 		} else {
-			msg.SenderKeyDistributionMessage = &waProto.SenderKeyDistributionMessage{
+			var skdm []byte
+			skdm, err = proto.Marshal(&waProto.SenderKeyDistributionMessage{
 				GroupId:                             proto.String(receipt.Chat.String()),
 				AxolotlSenderKeyDistributionMessage: signalSKDMessage.Serialize(),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to marshal sender key distribution message: %w", err)
 			}
+			plaintext = appendSenderKeyDistribution(plaintext, skdm)
 		}
 	} else if receipt.IsFromMe {
-		msg = &waProto.Message{
-			DeviceSentMessage: &waProto.DeviceSentMessage{
-				DestinationJid: proto.String(receipt.Chat.String()),
-				Message:        msg,
-			},
-		}
-	}
-	plaintext, err := proto.Marshal(msg)
-	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+		plaintext = wrapDeviceSentMessage(receipt.Chat, plaintext)
 	}
 	_, hasKeys := node.GetOptionalChildByTag("keys")
 	var bundle *prekey.Bundle
@@ -126,7 +198,7 @@ func (cli *Client) handleRetryReceipt(receipt *events.Receipt, node *waBinary.No
 	} else if retryCount >= 2 {
 		cli.Log.Debugf("Fetching prekeys for %s due to retry receipt with count>1 but no prekey bundle", receipt.Sender)
 		var keys map[types.JID]preKeyResp
-		keys, err = cli.fetchPreKeys([]types.JID{receipt.Sender})
+		keys, err = cli.fetchPreKeys(context.Background(), []types.JID{receipt.Sender})
 		if err != nil {
 			return err
 		}
@@ -179,8 +251,25 @@ func (cli *Client) handleRetryReceipt(receipt *events.Receipt, node *waBinary.No
 	return nil
 }
 
-// sendRetryReceipt sends a retry receipt for an incoming message.
-func (cli *Client) sendRetryReceipt(node *waBinary.Node, forceIncludeIdentity bool) {
+// handleUndecryptableMessage sends a retry receipt for a message that couldn't be decrypted, unless the retry
+// limit has been reached, and dispatches the UndecryptableMessage event.
+func (cli *Client) handleUndecryptableMessage(node *waBinary.Node, evt *events.UndecryptableMessage, forceIncludeIdentity bool) {
+	retryCount := cli.incrementRetryCount(node)
+	evt.RetryReceiptSent = retryCount <= cli.MaxRetryReceipts
+	if evt.RetryReceiptSent {
+		go cli.sendRetryReceipt(node, retryCount, forceIncludeIdentity)
+	}
+	cli.dispatchEvent(evt)
+	if !evt.RetryReceiptSent {
+		cli.Log.Warnf("Not sending any more retry receipts for %s", evt.Info.ID)
+		if retryCount == cli.MaxRetryReceipts+1 {
+			cli.dispatchEvent(&events.RetryReceiptLimitReached{Info: evt.Info, RetryCount: cli.MaxRetryReceipts})
+		}
+	}
+}
+
+// incrementRetryCount increments the number of retry receipts sent for an incoming message and returns the new count.
+func (cli *Client) incrementRetryCount(node *waBinary.Node) int {
 	id, _ := node.Attrs["id"].(string)
 	children := node.GetChildren()
 	var retryCountInMsg int
@@ -197,9 +286,15 @@ func (cli *Client) sendRetryReceipt(node *waBinary.Node, forceIncludeIdentity bo
 		cli.messageRetries[id] = retryCount
 	}
 	cli.messageRetriesLock.Unlock()
-	if retryCount >= 5 {
-		cli.Log.Warnf("Not sending any more retry receipts for %s", id)
-		return
+	return retryCount
+}
+
+// sendRetryReceipt sends a retry receipt for an incoming message.
+func (cli *Client) sendRetryReceipt(node *waBinary.Node, retryCount int, forceIncludeIdentity bool) {
+	id, _ := node.Attrs["id"].(string)
+	if delay := retryReceiptDelay(retryCount, cli.RetryReceiptDelay); delay > 0 {
+		cli.Log.Debugf("Waiting %s before sending retry receipt #%d for %s", delay, retryCount, id)
+		time.Sleep(delay)
 	}
 
 	var registrationIDBytes [4]byte
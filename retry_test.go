@@ -0,0 +1,156 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.mau.fi/libsignal/signalerror"
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestPreRetryCallback(t *testing.T) {
+	chat := types.NewJID("1234", types.DefaultUserServer)
+	cli := &Client{
+		Log:               waLog.Noop,
+		recentMessagesMap: make(map[recentMessageKey][]byte),
+	}
+	plaintext, _ := proto.Marshal(&waProto.Message{Conversation: proto.String("hello")})
+	cli.addRecentMessage(chat, "ABCD", plaintext)
+
+	var called bool
+	cli.PreRetryCallback = func(receipt *events.Receipt, id types.MessageID, retryCount int, msg *waProto.Message) bool {
+		called = true
+		if id != "ABCD" || retryCount != 2 || msg.GetConversation() != "hello" {
+			t.Errorf("Unexpected PreRetryCallback parameters %s/%d/%v", id, retryCount, msg)
+		}
+		return false
+	}
+	receipt := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, Sender: chat}}
+	node := &waBinary.Node{Tag: "receipt", Content: []waBinary.Node{
+		{Tag: "retry", Attrs: waBinary.Attrs{"id": "ABCD", "t": "1600000000", "count": "2"}},
+	}}
+	// The callback returns false, so the message shouldn't be encrypted or sent
+	err := cli.handleRetryReceipt(receipt, node)
+	if err != nil {
+		t.Fatalf("Unexpected error handling retry receipt: %v", err)
+	} else if !called {
+		t.Errorf("PreRetryCallback wasn't called")
+	}
+}
+
+func TestRetryReceiptDelay(t *testing.T) {
+	base := 2 * time.Second
+	expected := []time.Duration{0, 0, 2 * time.Second, 4 * time.Second, 8 * time.Second}
+	for retryCount, exp := range expected {
+		if delay := retryReceiptDelay(retryCount, base); delay != exp {
+			t.Errorf("Expected delay %s for retry #%d, got %s", exp, retryCount, delay)
+		}
+	}
+	if delay := retryReceiptDelay(3, 0); delay != 0 {
+		t.Errorf("Expected no delay with zero base, got %s", delay)
+	}
+}
+
+func TestRetryReceiptLimitReached(t *testing.T) {
+	cli := &Client{
+		Log:              waLog.Noop,
+		messageRetries:   make(map[string]int),
+		MaxRetryReceipts: 2,
+	}
+	var undecryptableEvents []*events.UndecryptableMessage
+	var limitEvents []*events.RetryReceiptLimitReached
+	cli.AddEventHandler(func(evt interface{}) {
+		switch typedEvt := evt.(type) {
+		case *events.UndecryptableMessage:
+			undecryptableEvents = append(undecryptableEvents, typedEvt)
+		case *events.RetryReceiptLimitReached:
+			limitEvents = append(limitEvents, typedEvt)
+		}
+	})
+	info := types.MessageInfo{ID: "ABCD"}
+	node := &waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{"id": "ABCD"}}
+	// Pretend the allowed receipts were already sent
+	cli.messageRetries["ABCD"] = 2
+	cli.handleUndecryptableMessage(node, &events.UndecryptableMessage{Info: info}, false)
+	cli.handleUndecryptableMessage(node, &events.UndecryptableMessage{Info: info}, false)
+	if len(undecryptableEvents) != 2 || undecryptableEvents[0].RetryReceiptSent {
+		t.Errorf("Expected two undecryptable message events without retry receipts, got %+v", undecryptableEvents)
+	}
+	if len(limitEvents) != 1 {
+		t.Fatalf("Expected exactly one limit event, got %d", len(limitEvents))
+	} else if limitEvents[0].Info.ID != "ABCD" || limitEvents[0].RetryCount != 2 {
+		t.Errorf("Unexpected limit event %+v", limitEvents[0])
+	}
+}
+
+func TestClassifyDecryptError(t *testing.T) {
+	tests := map[error]events.DecryptFailMode{
+		fmt.Errorf("failed to decrypt prekey message: %w", signalerror.ErrNoSessionForUser):    events.DecryptFailNoSession,
+		fmt.Errorf("failed to decrypt group message: %w", signalerror.ErrNoSenderKeyForUser):   events.DecryptFailNoSession,
+		fmt.Errorf("failed to decrypt normal message: %w", signalerror.ErrNoValidSessions):     events.DecryptFailBadMAC,
+		fmt.Errorf("failed to decrypt group message: %w", signalerror.ErrOldCounter):           events.DecryptFailDuplicate,
+		fmt.Errorf("failed to parse normal message: %w", signalerror.ErrUnknownMessageVersion): events.DecryptFailUnsupportedVersion,
+		fmt.Errorf("failed to parse group message"):                                            events.DecryptFailUnknown,
+	}
+	for err, expected := range tests {
+		if mode := classifyDecryptError(err); mode != expected {
+			t.Errorf("Expected %s for %q, got %s", expected, err, mode)
+		}
+	}
+}
+
+type memorySentMessageStore map[recentMessageKey][]byte
+
+func (m memorySentMessageStore) PutSentMessage(chat types.JID, id types.MessageID, plaintext []byte) error {
+	m[recentMessageKey{chat, id}] = plaintext
+	return nil
+}
+
+func (m memorySentMessageStore) GetSentMessage(chat types.JID, id types.MessageID) ([]byte, error) {
+	return m[recentMessageKey{chat, id}], nil
+}
+
+func (m memorySentMessageStore) DeleteOldSentMessages(before time.Time) error {
+	return nil
+}
+
+func TestPersistentRetryCache(t *testing.T) {
+	chat := types.NewJID("1234", types.DefaultUserServer)
+	sentMessages := make(memorySentMessageStore)
+	newClient := func() *Client {
+		return &Client{
+			Log:                        waLog.Noop,
+			Store:                      &store.Device{SentMessages: sentMessages},
+			recentMessagesMap:          make(map[recentMessageKey][]byte),
+			GetMessageForRetry:         func(to types.JID, id types.MessageID) *waProto.Message { return nil },
+			EnablePersistentRetryCache: true,
+		}
+	}
+	newClient().addRecentMessage(chat, "ABCD", []byte{1, 2, 3})
+	// A new client doesn't have the message in memory, so it must come from the store
+	receipt := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, Sender: chat}}
+	plaintext, err := newClient().getMessageForRetry(receipt, "ABCD")
+	if err != nil {
+		t.Fatalf("Failed to get message for retry: %v", err)
+	} else if !bytes.Equal(plaintext, []byte{1, 2, 3}) {
+		t.Errorf("Unexpected plaintext %v", plaintext)
+	}
+	if _, err = newClient().getMessageForRetry(receipt, "EFGH"); err == nil {
+		t.Errorf("Expected error for unknown message")
+	}
+}
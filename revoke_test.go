@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestGetRevokeTarget(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	alice := types.NewJID("1111111111", types.DefaultUserServer)
+	admin := types.NewJID("2222222222", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+
+	tests := []struct {
+		name     string
+		stanza   waBinary.Node
+		key      *waProto.MessageKey
+		expected types.RevokeTarget
+	}{{
+		name: "sender revoke",
+		stanza: waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{
+			"from": group, "participant": alice, "id": "REVOKE1", "t": "1600000000", "type": "text", "edit": "7",
+		}},
+		key: &waProto.MessageKey{RemoteJid: proto.String(group.String()), FromMe: proto.Bool(true), Id: proto.String("AAAA")},
+		expected: types.RevokeTarget{
+			MessageSource: types.MessageSource{Chat: group, Sender: alice, IsGroup: true},
+			ID:            "AAAA",
+			Actor:         alice,
+		},
+	}, {
+		name: "admin revoke",
+		stanza: waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{
+			"from": group, "participant": admin, "id": "REVOKE2", "t": "1600000000", "type": "text", "edit": "8",
+		}},
+		key: &waProto.MessageKey{RemoteJid: proto.String(group.String()), FromMe: proto.Bool(false), Id: proto.String("BBBB"), Participant: proto.String(alice.String())},
+		expected: types.RevokeTarget{
+			MessageSource: types.MessageSource{Chat: group, Sender: alice, IsGroup: true},
+			ID:            "BBBB",
+			Actor:         admin,
+			ByAdmin:       true,
+		},
+	}, {
+		name: "admin revoke of own message",
+		stanza: waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{
+			"from": group, "participant": admin, "id": "REVOKE3", "t": "1600000000", "type": "text", "edit": "8",
+		}},
+		key: &waProto.MessageKey{RemoteJid: proto.String(group.String()), FromMe: proto.Bool(false), Id: proto.String("CCCC"), Participant: proto.String(ownID.ToNonAD().String())},
+		expected: types.RevokeTarget{
+			MessageSource: types.MessageSource{Chat: group, Sender: ownID.ToNonAD(), IsFromMe: true, IsGroup: true},
+			ID:            "CCCC",
+			Actor:         admin,
+			ByAdmin:       true,
+		},
+	}, {
+		name: "revoke without participant in key",
+		stanza: waBinary.Node{Tag: "message", Attrs: waBinary.Attrs{
+			"from": group, "participant": alice, "id": "REVOKE4", "t": "1600000000", "type": "text", "edit": "7",
+		}},
+		key: &waProto.MessageKey{RemoteJid: proto.String(group.String()), FromMe: proto.Bool(false), Id: proto.String("DDDD")},
+		expected: types.RevokeTarget{
+			MessageSource: types.MessageSource{Chat: group, IsGroup: true},
+			ID:            "DDDD",
+			Actor:         alice,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := cli.parseMessageInfo(&test.stanza)
+			if err != nil {
+				t.Fatalf("Failed to parse message info: %v", err)
+			}
+			evt := &events.Message{
+				Info: *info,
+				Message: &waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{
+					Type: waProto.ProtocolMessage_REVOKE.Enum(),
+					Key:  test.key,
+				}},
+			}
+			target, ok := cli.GetRevokeTarget(evt)
+			if !ok {
+				t.Fatalf("Message wasn't detected as a revoke")
+			} else if *target != test.expected {
+				t.Errorf("Unexpected revoke target:\nexpected %+v\ngot      %+v", test.expected, *target)
+			}
+		})
+	}
+}
+
+func TestBuildRevokeEditAttribute(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	alice := types.NewJID("1111111111", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+
+	tests := []struct {
+		name     string
+		sender   types.JID
+		expected string
+	}{
+		{"own message with empty sender", types.EmptyJID, "7"},
+		{"own message with own JID", ownID, "7"},
+		{"other user's message", alice, "8"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if edit := getEditAttribute(cli.BuildRevoke(group, test.sender, "AAAA")); string(edit) != test.expected {
+				t.Errorf("Expected edit attribute %q, got %q", test.expected, edit)
+			}
+		})
+	}
+}
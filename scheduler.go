@@ -0,0 +1,91 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// ScheduleMessage saves the given message in the outbox to be sent at the given time, and returns the ID it will be
+// sent with.
+//
+// The message is sent when the client is connected at or after the send time. If the client is offline at that time,
+// the message is sent right after connecting, unless it's later than Client.ScheduledMessageMaxDelay. After the first
+// attempt, scheduled messages are retried like any other outbox message (see QueueMessage), and the result is
+// reported with events.OutboxDelivered or events.OutboxFailed, which have the SendAt field set.
+//
+// The outbox must be enabled with Client.EnableOutbox.
+func (cli *Client) ScheduleMessage(to types.JID, message *waProto.Message, sendAt time.Time) (types.MessageID, error) {
+	if sendAt.IsZero() {
+		sendAt = time.Now()
+	}
+	return cli.queueOutboxMessage(to, message, sendAt)
+}
+
+// getScheduledMessage finds a message that was scheduled with ScheduleMessage and hasn't been attempted yet.
+// The outbox lock must be held when calling this, so that the outbox can't start sending the message in the meantime.
+func (cli *Client) getScheduledMessage(id types.MessageID) (*store.OutboxMessage, error) {
+	if !cli.outboxEnabled() {
+		return nil, ErrOutboxDisabled
+	} else if _, sending := cli.outboxSending[id]; sending {
+		return nil, ErrScheduledMessageInFlight
+	}
+	msgs, err := cli.Store.Outbox.GetOutboxMessages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox messages: %w", err)
+	}
+	for _, msg := range msgs {
+		if msg.ID == id && !msg.SendAt.IsZero() && msg.Attempts == 0 {
+			return msg, nil
+		}
+	}
+	return nil, ErrScheduledMessageNotFound
+}
+
+// RescheduleMessage changes the send time of a message scheduled with ScheduleMessage.
+// Messages that have already been attempted can't be rescheduled, and ErrScheduledMessageInFlight is returned if
+// the message is being sent right now.
+func (cli *Client) RescheduleMessage(id types.MessageID, sendAt time.Time) error {
+	cli.outboxLock.Lock()
+	defer cli.outboxLock.Unlock()
+	msg, err := cli.getScheduledMessage(id)
+	if err != nil {
+		return err
+	}
+	msg.SendAt = sendAt
+	msg.NextAttempt = sendAt
+	err = cli.Store.Outbox.PutOutboxMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to update scheduled message: %w", err)
+	}
+	cli.outboxStale[id] = struct{}{}
+	cli.wakeOutbox()
+	return nil
+}
+
+// CancelScheduledMessage removes a message scheduled with ScheduleMessage from the outbox.
+// Messages that have already been attempted can't be canceled, and ErrScheduledMessageInFlight is returned if
+// the message is being sent right now.
+func (cli *Client) CancelScheduledMessage(id types.MessageID) error {
+	cli.outboxLock.Lock()
+	defer cli.outboxLock.Unlock()
+	_, err := cli.getScheduledMessage(id)
+	if err != nil {
+		return err
+	}
+	err = cli.Store.Outbox.DeleteOutboxMessage(id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scheduled message: %w", err)
+	}
+	cli.outboxStale[id] = struct{}{}
+	return nil
+}
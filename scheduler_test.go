@@ -0,0 +1,153 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestScheduleMessage(t *testing.T) {
+	outbox := &memoryOutboxStore{}
+	cli := NewClient(&store.Device{Outbox: outbox}, nil)
+	to := types.NewJID("1234567890", types.DefaultUserServer)
+	if _, err := cli.ScheduleMessage(to, cli.BuildText("hi"), time.Now()); !errors.Is(err, ErrOutboxDisabled) {
+		t.Fatalf("Expected ErrOutboxDisabled, got %v", err)
+	}
+	cli.EnableOutbox = true
+	sendAt := time.Now().Add(time.Hour)
+	id, err := cli.ScheduleMessage(to, cli.BuildText("hi"), sendAt)
+	if err != nil {
+		t.Fatalf("Failed to schedule message: %v", err)
+	} else if len(outbox.msgs) != 1 || outbox.msgs[0].ID != id || !outbox.msgs[0].SendAt.Equal(sendAt) || !outbox.msgs[0].NextAttempt.Equal(sendAt) {
+		t.Errorf("Scheduled message wasn't stored correctly: %+v", outbox.msgs)
+	}
+	<-cli.outboxWake
+
+	sendAt = sendAt.Add(time.Hour)
+	if err = cli.RescheduleMessage(id, sendAt); err != nil {
+		t.Fatalf("Failed to reschedule message: %v", err)
+	} else if !outbox.msgs[0].SendAt.Equal(sendAt) || !outbox.msgs[0].NextAttempt.Equal(sendAt) {
+		t.Errorf("Send time wasn't updated: %+v", outbox.msgs[0])
+	}
+	if err = cli.RescheduleMessage("unknown", sendAt); !errors.Is(err, ErrScheduledMessageNotFound) {
+		t.Errorf("Expected ErrScheduledMessageNotFound, got %v", err)
+	}
+
+	queuedID, _ := cli.QueueMessage(to, cli.BuildText("not scheduled"))
+	if err = cli.CancelScheduledMessage(queuedID); !errors.Is(err, ErrScheduledMessageNotFound) {
+		t.Errorf("Expected ErrScheduledMessageNotFound when canceling a queued message, got %v", err)
+	}
+	if err = cli.CancelScheduledMessage(id); err != nil {
+		t.Errorf("Failed to cancel scheduled message: %v", err)
+	} else if len(outbox.msgs) != 1 || outbox.msgs[0].ID != queuedID {
+		t.Errorf("Expected only the queued message to remain, got %+v", outbox.msgs)
+	}
+}
+
+func TestProcessOutboxDropsLateScheduledMessages(t *testing.T) {
+	outbox := &memoryOutboxStore{}
+	cli := NewClient(&store.Device{Outbox: outbox}, nil)
+	cli.EnableOutbox = true
+	cli.ScheduledMessageMaxDelay = time.Minute
+	var failed []*events.OutboxFailed
+	cli.AddEventHandler(func(evt interface{}) {
+		if failEvt, ok := evt.(*events.OutboxFailed); ok {
+			failed = append(failed, failEvt)
+		}
+	})
+	to := types.NewJID("1234567890", types.DefaultUserServer)
+	future := time.Now().Add(time.Hour)
+	lateID, _ := cli.ScheduleMessage(to, cli.BuildText("late"), time.Now().Add(-time.Hour))
+	futureID, _ := cli.ScheduleMessage(to, cli.BuildText("future"), future)
+
+	next := cli.processOutbox(context.Background())
+	if !next.Equal(future) {
+		t.Errorf("Expected next attempt to be %s, got %s", future, next)
+	}
+	if len(failed) != 1 || failed[0].ID != lateID || failed[0].SendAt.IsZero() || !errors.Is(failed[0].Error, ErrScheduledMessageTooLate) {
+		t.Errorf("Expected late message to fail with ErrScheduledMessageTooLate, got %+v", failed)
+	}
+	if len(outbox.msgs) != 1 || outbox.msgs[0].ID != futureID {
+		t.Errorf("Expected only the future message to remain in the outbox, got %+v", outbox.msgs)
+	}
+}
+
+func TestCancelScheduledMessageInFlight(t *testing.T) {
+	outbox := &memoryOutboxStore{}
+	cli := NewClient(&store.Device{Outbox: outbox}, nil)
+	cli.EnableOutbox = true
+	to := types.NewJID("1234567890", types.DefaultUserServer)
+	id, _ := cli.ScheduleMessage(to, cli.BuildText("hi"), time.Now())
+
+	if !cli.claimOutboxMessage(id) {
+		t.Fatalf("Failed to claim scheduled message")
+	}
+	if err := cli.CancelScheduledMessage(id); !errors.Is(err, ErrScheduledMessageInFlight) {
+		t.Errorf("Expected ErrScheduledMessageInFlight when canceling, got %v", err)
+	}
+	if err := cli.RescheduleMessage(id, time.Now().Add(time.Hour)); !errors.Is(err, ErrScheduledMessageInFlight) {
+		t.Errorf("Expected ErrScheduledMessageInFlight when rescheduling, got %v", err)
+	}
+	if len(outbox.msgs) != 1 {
+		t.Errorf("Message being sent was removed from the outbox")
+	}
+	cli.releaseOutboxMessage(id)
+	if err := cli.CancelScheduledMessage(id); err != nil {
+		t.Errorf("Failed to cancel message after it was released: %v", err)
+	}
+}
+
+// cancelingOutboxStore cancels a scheduled message right after the outbox is fetched for the first time.
+type cancelingOutboxStore struct {
+	*memoryOutboxStore
+	cli      *Client
+	cancelID types.MessageID
+	done     bool
+}
+
+func (s *cancelingOutboxStore) GetOutboxMessages() ([]*store.OutboxMessage, error) {
+	msgs := append([]*store.OutboxMessage{}, s.msgs...)
+	if !s.done {
+		s.done = true
+		if err := s.cli.CancelScheduledMessage(s.cancelID); err != nil {
+			return nil, err
+		}
+	}
+	return msgs, nil
+}
+
+func TestProcessOutboxSkipsCanceledMessages(t *testing.T) {
+	outbox := &cancelingOutboxStore{memoryOutboxStore: &memoryOutboxStore{}}
+	cli := NewClient(&store.Device{Outbox: outbox}, nil)
+	cli.EnableOutbox = true
+	outbox.cli = cli
+	to := types.NewJID("1234567890", types.DefaultUserServer)
+	outbox.cancelID, _ = cli.ScheduleMessage(to, cli.BuildText("hi"), time.Now().Add(-time.Second))
+	var failed []*events.OutboxFailed
+	cli.AddEventHandler(func(evt interface{}) {
+		if failEvt, ok := evt.(*events.OutboxFailed); ok {
+			failed = append(failed, failEvt)
+		}
+	})
+
+	if next := cli.processOutbox(context.Background()); !next.IsZero() {
+		t.Errorf("Expected no next attempt, got %s", next)
+	}
+	if len(outbox.msgs) != 0 || len(failed) != 0 {
+		t.Errorf("Canceled message was sent after the outbox was fetched: %+v %+v", outbox.msgs, failed)
+	}
+	if len(cli.outboxSending) != 0 {
+		t.Errorf("Expected no messages to be marked as being sent")
+	}
+}
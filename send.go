@@ -7,14 +7,17 @@
 package whatsmeow
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -30,6 +33,8 @@ import (
 )
 
 // GenerateMessageID generates a random string that can be used as a message ID on WhatsApp.
+//
+// Client.GenerateMessageID should be preferred, as it generates IDs in the same format as the official clients.
 func GenerateMessageID() types.MessageID {
 	id := make([]byte, 16)
 	_, err := rand.Read(id)
@@ -40,59 +45,322 @@ func GenerateMessageID() types.MessageID {
 	return strings.ToUpper(hex.EncodeToString(id))
 }
 
+// GenerateMessageID generates a new message ID in the same format as the official WhatsApp clients.
+//
+// The ID can be stored before calling SendMessage with it, so that acks and receipts can be correlated with the
+// message even if the process crashes while sending.
+func (cli *Client) GenerateMessageID() types.MessageID {
+	data := make([]byte, 8, 8+20+16)
+	binary.BigEndian.PutUint64(data, uint64(time.Now().Unix()))
+	if cli.Store.ID != nil {
+		data = append(data, []byte(cli.Store.ID.ToNonAD().String())...)
+	}
+	randomPart := make([]byte, 16)
+	_, err := rand.Read(randomPart)
+	if err != nil {
+		// Out of entropy
+		panic(err)
+	}
+	data = append(data, randomPart...)
+	hash := sha256.Sum256(data)
+	return "3EB0" + strings.ToUpper(hex.EncodeToString(hash[:9]))
+}
+
+// MessageDebugTimings contains the time spent in different parts of sending a message.
+type MessageDebugTimings struct {
+	Marshal         time.Duration
+	GetParticipants time.Duration
+	GetDevices      time.Duration
+	GroupEncrypt    time.Duration
+	PeerEncrypt     time.Duration
+	Send            time.Duration
+	Resp            time.Duration
+}
+
+// SendResponse contains the result of sending a message.
+type SendResponse struct {
+	// The message ID that was used, which is useful if the ID was generated automatically.
+	ID types.MessageID
+	// The message timestamp returned by the server. This is the authoritative timestamp of the message,
+	// which should be used for ordering instead of the local time.
+	Timestamp time.Time
+	// Debug info about how long different parts of sending the message took.
+	DebugTimings MessageDebugTimings
+}
+
+// SendRequestExtra contains optional parameters for SendMessage.
+//
+// New options are added to this struct rather than as new parameters, so that the signature of SendMessage
+// doesn't need to change.
+type SendRequestExtra struct {
+	// The message ID to use. This is an alternative to the id parameter of SendMessage and is used if it's empty.
+	ID types.MessageID
+	// Send the message as a peer message, which is only delivered to the given device of your own account
+	// (the primary device if the recipient is a non-AD JID). Peer messages are used for protocol messages
+	// like app state sync key requests.
+	Peer bool
+	// The media handle from UploadResponse.Handle. Including it allows the server to reuse
+	// the already uploaded media instead of having recipients re-request it.
+	MediaHandle string
+	// How long to wait for the server to acknowledge the message before returning ErrMessageTimedOut.
+	// By default, SendMessage waits until the context is canceled or the connection is closed.
+	Timeout time.Duration
+	// Don't keep the message in the recent message cache. Retry receipts for the message can't be handled
+	// without the cache, so recipients who fail to decrypt the message won't get it at all.
+	NoRetryCache bool
+}
+
 // SendMessage sends the given message.
 //
-// If the message ID is not provided, a random message ID will be generated.
+// If the message ID is not provided, a new message ID will be generated with Client.GenerateMessageID.
+// Applications that want to persist the ID before sending should generate it themselves and pass it here.
+//
+// Optional parameters can be passed in a SendRequestExtra struct. Only one extra struct may be passed.
 //
 // This method will wait for the server to acknowledge the message before returning.
-// The return value is the timestamp of the message from the server.
-func (cli *Client) SendMessage(to types.JID, id types.MessageID, message *waProto.Message) (time.Time, error) {
-	if to.AD {
-		return time.Time{}, ErrRecipientADJID
+// The returned SendResponse contains the message ID and the timestamp of the message from the server.
+//
+// The message is only marshaled once: the same bytes are sent to own devices and reused when other devices ask for
+// the message again with a retry receipt, so any unknown fields in the message are preserved as-is.
+func (cli *Client) SendMessage(to types.JID, id types.MessageID, message *waProto.Message, extra ...SendRequestExtra) (SendResponse, error) {
+	return cli.SendMessageContext(context.Background(), to, id, message, extra...)
+}
+
+// SendMessageContext sends the given message like SendMessage, but the given context can be used to cancel sending.
+//
+// The context is checked while fetching the group info and participant device lists, while encrypting the message
+// for each device, and while waiting for the server to acknowledge the message. If the context is canceled after
+// the message has already been sent to the server, the message may still be delivered even though an error is returned.
+func (cli *Client) SendMessageContext(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, extra ...SendRequestExtra) (resp SendResponse, err error) {
+	var req SendRequestExtra
+	if len(extra) > 1 {
+		err = ErrTooManySendExtras
+		return
+	} else if len(extra) == 1 {
+		req = extra[0]
+	}
+	if len(id) == 0 {
+		id = req.ID
+	}
+	return cli.sendMessage(ctx, to, id, message, req, nil)
+}
+
+// sendMessage sends a message like SendMessageContext. If the message is a status broadcast,
+// statusRecipients overrides the recipients derived from the status privacy settings.
+func (cli *Client) sendMessage(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, req SendRequestExtra, statusRecipients []types.JID) (resp SendResponse, err error) {
+	if req.Peer {
+		if ownID := cli.Store.ID; ownID == nil {
+			err = ErrNotLoggedIn
+			return
+		} else if to.Server != types.DefaultUserServer || to.User != ownID.User {
+			err = ErrInvalidPeerRecipient
+			return
+		}
+	} else if to.AD {
+		err = ErrRecipientADJID
+		return
+	}
+	if message.GetPollCreationMessage() != nil {
+		if err = cli.checkFeature(FeaturePolls); err != nil {
+			return
+		}
 	}
 
 	if len(id) == 0 {
-		id = GenerateMessageID()
+		id = cli.GenerateMessageID()
+	}
+	resp.ID = id
+	if err = cli.waitSendRateLimit(ctx, to, id); err != nil {
+		return
+	}
+	if ownID := cli.Store.ID; ownID != nil {
+		cli.storeMessageSecret(to, *ownID, id, message)
 	}
 
-	cli.addRecentMessage(to, id, message)
+	start := time.Now()
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		err = fmt.Errorf("failed to marshal message: %w", err)
+		return
+	}
+	resp.DebugTimings.Marshal = time.Since(start)
+	if !req.NoRetryCache {
+		cli.addRecentMessage(to, id, plaintext)
+	}
 	respChan := cli.waitResponse(id)
-	var err error
-	switch to.Server {
-	case types.GroupServer:
-		err = cli.sendGroup(to, id, message)
-	case types.DefaultUserServer:
-		err = cli.sendDM(to, id, message)
-	case types.BroadcastServer:
-		err = ErrBroadcastListUnsupported
-	default:
-		err = fmt.Errorf("%w %s", ErrUnknownServer, to.Server)
+	var node *waBinary.Node
+	if req.Peer {
+		node, err = cli.preparePeerMessage(ctx, to, id, message, plaintext, &resp.DebugTimings)
+	} else {
+		switch to.Server {
+		case types.GroupServer:
+			node, err = cli.prepareGroupMessage(ctx, to, id, message, plaintext, &resp.DebugTimings)
+		case types.DefaultUserServer:
+			node, err = cli.prepareDMMessage(ctx, to, id, message, plaintext, &resp.DebugTimings)
+		case types.BroadcastServer:
+			if to == types.StatusBroadcastJID {
+				node, err = cli.prepareStatusMessage(ctx, id, message, plaintext, statusRecipients, &resp.DebugTimings)
+			} else {
+				err = ErrBroadcastListUnsupported
+			}
+		default:
+			err = fmt.Errorf("%w %s", ErrUnknownServer, to.Server)
+		}
 	}
 	if err != nil {
-		cli.cancelResponse(id, respChan)
-		return time.Time{}, err
+		cli.cancelResponse(id)
+		return
+	}
+	if len(req.MediaHandle) > 0 {
+		node.Attrs["media_id"] = req.MediaHandle
 	}
-	resp := <-respChan
-	ts := time.Unix(resp.AttrGetter().Int64("t"), 0)
-	return ts, nil
+	sendStart := time.Now()
+	err = cli.sendNode(*node)
+	resp.DebugTimings.Send = time.Since(sendStart)
+	if err != nil {
+		cli.cancelResponse(id)
+		err = fmt.Errorf("failed to send message node: %w", err)
+		return
+	}
+	var timeout <-chan time.Time
+	if req.Timeout > 0 {
+		timer := time.NewTimer(req.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	respStart := time.Now()
+	var respNode *waBinary.Node
+	select {
+	case respNode = <-respChan:
+	case <-ctx.Done():
+		cli.cancelResponse(id)
+		err = ctx.Err()
+		return
+	case <-timeout:
+		cli.cancelResponse(id)
+		err = ErrMessageTimedOut
+		return
+	}
+	resp.DebugTimings.Resp = time.Since(respStart)
+	if respNode == closedNode {
+		err = &DisconnectedBeforeResponseError{RequestID: id, Elapsed: time.Since(start)}
+		return
+	}
+	resp.Timestamp = time.Unix(respNode.AttrGetter().Int64("t"), 0)
+	cli.trackOwnPoll(to, id, resp.Timestamp, message)
+	return
 }
 
 // RevokeMessage deletes the given message from everyone in the chat.
 // You can only revoke your own messages, and if the message is too old, then other users will ignore the deletion.
+// Group admins can delete messages from other members using BuildRevoke.
 //
 // This method will wait for the server to acknowledge the revocation message before returning.
-// The return value is the timestamp of the message from the server.
-func (cli *Client) RevokeMessage(chat types.JID, id types.MessageID) (time.Time, error) {
-	return cli.SendMessage(chat, cli.generateRequestID(), &waProto.Message{
+// The returned SendResponse contains the ID and server timestamp of the revocation message.
+func (cli *Client) RevokeMessage(chat types.JID, id types.MessageID) (SendResponse, error) {
+	return cli.SendMessage(chat, cli.GenerateMessageID(), cli.BuildRevoke(chat, types.EmptyJID, id))
+}
+
+// BuildRevoke builds a message revocation message using the given variables.
+// The built message can be sent normally using Client.SendMessage.
+//
+// To revoke your own messages, pass your JID or an empty JID as the second parameter (sender).
+// To revoke someone else's messages as a group admin, pass the message sender's JID as the sender parameter.
+func (cli *Client) BuildRevoke(chat, sender types.JID, id types.MessageID) *waProto.Message {
+	return &waProto.Message{
 		ProtocolMessage: &waProto.ProtocolMessage{
 			Type: waProto.ProtocolMessage_REVOKE.Enum(),
-			Key: &waProto.MessageKey{
-				FromMe:    proto.Bool(true),
-				Id:        proto.String(id),
-				RemoteJid: proto.String(chat.String()),
-			},
+			Key:  cli.buildMessageKey(chat, sender, id),
 		},
-	})
+	}
+}
+
+// buildMessageKey builds a key pointing at the given message from the point of view of the current user.
+// An empty sender JID means the message was sent by the current user.
+func (cli *Client) buildMessageKey(chat, sender types.JID, id types.MessageID) *waProto.MessageKey {
+	key := &waProto.MessageKey{
+		FromMe:    proto.Bool(true),
+		Id:        proto.String(id),
+		RemoteJid: proto.String(chat.String()),
+	}
+	if !sender.IsEmpty() && (cli.Store.ID == nil || sender.User != cli.Store.ID.User) {
+		key.FromMe = proto.Bool(false)
+		if chat.Server != types.DefaultUserServer {
+			key.Participant = proto.String(sender.ToNonAD().String())
+		}
+	}
+	return key
+}
+
+// BuildReaction builds a message reacting to the given message with the given emoji.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The sender parameter is the sender of the message being reacted to (not the reactor), like in BuildRevoke.
+// To remove a previously sent reaction, pass an empty string as the emoji.
+func (cli *Client) BuildReaction(chat, sender types.JID, id types.MessageID, emoji string) *waProto.Message {
+	return &waProto.Message{
+		ReactionMessage: &waProto.ReactionMessage{
+			Key:               cli.buildMessageKey(chat, sender, id),
+			Text:              proto.String(emoji),
+			SenderTimestampMs: proto.Int64(time.Now().UnixMilli()),
+		},
+	}
+}
+
+func getTypeFromMessage(msg *waProto.Message) string {
+	switch {
+	case msg.GetReactionMessage() != nil:
+		return "reaction"
+	case msg.GetPollCreationMessage() != nil, msg.GetPollUpdateMessage() != nil:
+		return "poll"
+	case getMediaTypeFromMessage(msg) != "":
+		return "media"
+	default:
+		return "text"
+	}
+}
+
+// getMediaTypeFromMessage returns the value of the mediatype attribute that the official clients include in the enc
+// nodes of some messages, which the server uses for routing and push notifications.
+func getMediaTypeFromMessage(msg *waProto.Message) string {
+	switch {
+	case msg.GetLiveLocationMessage() != nil:
+		return "livelocation"
+	case msg.GetLocationMessage() != nil:
+		return "location"
+	default:
+		return ""
+	}
+}
+
+// addMediaTypeToEncNodes adds the mediatype attribute to all enc nodes in the given message node.
+func addMediaTypeToEncNodes(node *waBinary.Node, message *waProto.Message) {
+	mediaType := getMediaTypeFromMessage(message)
+	if len(mediaType) == 0 {
+		return
+	}
+	for _, child := range node.GetChildren() {
+		switch child.Tag {
+		case "enc":
+			child.Attrs["mediatype"] = mediaType
+		case "participants":
+			for _, to := range child.GetChildren() {
+				for _, enc := range to.GetChildrenByTag("enc") {
+					enc.Attrs["mediatype"] = mediaType
+				}
+			}
+		}
+	}
+}
+
+func getEditAttribute(msg *waProto.Message) types.EditAttribute {
+	if msg.GetProtocolMessage().GetType() == waProto.ProtocolMessage_REVOKE && msg.GetProtocolMessage().GetKey() != nil {
+		if msg.GetProtocolMessage().GetKey().GetFromMe() {
+			return types.EditAttributeSenderRevoke
+		}
+		return types.EditAttributeAdminRevoke
+	}
+	return types.EditAttributeEmpty
 }
 
 func participantListHashV2(participantJIDs []string) string {
@@ -101,22 +369,30 @@ func participantListHashV2(participantJIDs []string) string {
 	return fmt.Sprintf("2:%s", base64.RawStdEncoding.EncodeToString(hash[:6]))
 }
 
-func (cli *Client) sendGroup(to types.JID, id types.MessageID, message *waProto.Message) error {
-	groupInfo, err := cli.GetGroupInfo(to)
+func (cli *Client) prepareGroupMessage(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, plaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	start := time.Now()
+	groupInfo, err := cli.getGroupInfo(ctx, to)
 	if err != nil {
-		return fmt.Errorf("failed to get group info: %w", err)
+		return nil, fmt.Errorf("failed to get group info: %w", err)
 	}
+	timings.GetParticipants = time.Since(start)
 
-	plaintext, _, err := marshalMessage(to, message)
-	if err != nil {
-		return err
+	participants := make([]types.JID, len(groupInfo.Participants))
+	for i, part := range groupInfo.Participants {
+		participants[i] = part.JID
 	}
+	return cli.prepareSenderKeyMessage(ctx, to, id, message, plaintext, participants, true, timings)
+}
 
+// prepareSenderKeyMessage encrypts the message with the sender key of the given chat and builds the message node,
+// distributing the sender key to any participant devices that don't have it yet.
+func (cli *Client) prepareSenderKeyMessage(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, plaintext []byte, participants []types.JID, includePhash bool, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	start := time.Now()
 	builder := groups.NewGroupSessionBuilder(cli.Store, pbSerializer)
 	senderKeyName := protocol.NewSenderKeyName(to.String(), cli.Store.ID.SignalAddress())
 	signalSKDMessage, err := builder.Create(senderKeyName)
 	if err != nil {
-		return fmt.Errorf("failed to create sender key distribution message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to create sender key distribution message to send %s to %s: %w", id, to, err)
 	}
 	skdMessage := &waProto.Message{
 		SenderKeyDistributionMessage: &waProto.SenderKeyDistributionMessage{
@@ -126,71 +402,97 @@ func (cli *Client) sendGroup(to types.JID, id types.MessageID, message *waProto.
 	}
 	skdPlaintext, err := proto.Marshal(skdMessage)
 	if err != nil {
-		return fmt.Errorf("failed to marshal sender key distribution message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to marshal sender key distribution message to send %s to %s: %w", id, to, err)
 	}
 
 	cipher := groups.NewGroupCipher(builder, senderKeyName, cli.Store)
 	encrypted, err := cipher.Encrypt(padMessage(plaintext))
 	if err != nil {
-		return fmt.Errorf("failed to encrypt group message to send %s to %s: %w", id, to, err)
+		return nil, fmt.Errorf("failed to encrypt group message to send %s to %s: %w", id, to, err)
 	}
 	ciphertext := encrypted.SignedSerialize()
+	timings.GroupEncrypt = time.Since(start)
 
-	participants := make([]types.JID, len(groupInfo.Participants))
-	participantsStrings := make([]string, len(groupInfo.Participants))
-	for i, part := range groupInfo.Participants {
-		participants[i] = part.JID
-		participantsStrings[i] = part.JID.String()
-	}
-
-	node, err := cli.prepareMessageNode(to, id, message, participants, skdPlaintext, nil)
+	node, err := cli.prepareMessageNode(ctx, to, id, message, participants, skdPlaintext, nil, timings)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	node.Attrs["phash"] = participantListHashV2(participantsStrings)
+	if includePhash {
+		participantsStrings := make([]string, len(participants))
+		for i, jid := range participants {
+			participantsStrings[i] = jid.String()
+		}
+		node.Attrs["phash"] = participantListHashV2(participantsStrings)
+	}
 	node.Content = append(node.GetChildren(), waBinary.Node{
 		Tag:     "enc",
 		Content: ciphertext,
 		Attrs:   waBinary.Attrs{"v": "2", "type": "skmsg"},
 	})
+	addMediaTypeToEncNodes(node, message)
+	return node, nil
+}
 
-	err = cli.sendNode(*node)
-	if err != nil {
-		return fmt.Errorf("failed to send message node: %w", err)
-	}
-	return nil
+func (cli *Client) prepareDMMessage(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, plaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	return cli.prepareMessageNode(ctx, to, id, message, []types.JID{to, *cli.Store.ID}, plaintext, wrapDeviceSentMessage(to, plaintext), timings)
 }
 
-func (cli *Client) sendDM(to types.JID, id types.MessageID, message *waProto.Message) error {
-	messagePlaintext, deviceSentMessagePlaintext, err := marshalMessage(to, message)
+// preparePeerMessage builds a message node that is only sent to the given device of the user's own account.
+func (cli *Client) preparePeerMessage(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, plaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	start := time.Now()
+	cli.establishSessions(ctx, []types.JID{to})
+	encrypted, includeIdentity, err := cli.encryptMessageForDevice(plaintext, to, nil)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to encrypt peer message for %s: %w", to, err)
 	}
-
-	node, err := cli.prepareMessageNode(to, id, message, []types.JID{to, *cli.Store.ID}, messagePlaintext, deviceSentMessagePlaintext)
-	if err != nil {
-		return err
+	timings.PeerEncrypt = time.Since(start)
+	node := &waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"id":       id,
+			"type":     getTypeFromMessage(message),
+			"to":       to,
+			"category": "peer",
+		},
+		Content: []waBinary.Node{*encrypted},
 	}
-	err = cli.sendNode(*node)
-	if err != nil {
-		return fmt.Errorf("failed to send message node: %w", err)
+	if message.GetProtocolMessage().GetType() == waProto.ProtocolMessage_APP_STATE_SYNC_KEY_REQUEST {
+		node.Attrs["push_priority"] = "high"
 	}
-	return nil
+	if includeIdentity {
+		err = cli.appendDeviceIdentityNode(node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
 }
 
-func (cli *Client) prepareMessageNode(to types.JID, id types.MessageID, message *waProto.Message, participants []types.JID, plaintext, dsmPlaintext []byte) (*waBinary.Node, error) {
-	allDevices, err := cli.GetUserDevices(participants)
+func (cli *Client) prepareMessageNode(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, participants []types.JID, plaintext, dsmPlaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	start := time.Now()
+	allDevices, err := cli.GetUserDevicesContext(ctx, participants)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get device list: %w", err)
 	}
-	participantNodes, includeIdentity := cli.encryptMessageForDevices(allDevices, id, plaintext, dsmPlaintext)
+	timings.GetDevices = time.Since(start)
+	return cli.buildMessageNode(ctx, to, id, message, allDevices, plaintext, dsmPlaintext, timings)
+}
+
+// buildMessageNode encrypts the message for the given devices and builds the message node to send.
+func (cli *Client) buildMessageNode(ctx context.Context, to types.JID, id types.MessageID, message *waProto.Message, allDevices []types.JID, plaintext, dsmPlaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	start := time.Now()
+	participantNodes, includeIdentity, err := cli.encryptMessageForDevices(ctx, allDevices, id, plaintext, dsmPlaintext)
+	if err != nil {
+		return nil, err
+	}
+	timings.PeerEncrypt = time.Since(start)
 
 	node := waBinary.Node{
 		Tag: "message",
 		Attrs: waBinary.Attrs{
 			"id":   id,
-			"type": "text",
+			"type": getTypeFromMessage(message),
 			"to":   to,
 		},
 		Content: []waBinary.Node{{
@@ -198,8 +500,12 @@ func (cli *Client) prepareMessageNode(to types.JID, id types.MessageID, message
 			Content: participantNodes,
 		}},
 	}
-	if message.ProtocolMessage != nil && message.GetProtocolMessage().GetType() == waProto.ProtocolMessage_REVOKE {
-		node.Attrs["edit"] = "7"
+	addMediaTypeToEncNodes(&node, message)
+	if editAttr := getEditAttribute(message); editAttr != types.EditAttributeEmpty {
+		node.Attrs["edit"] = string(editAttr)
+	}
+	if bizNode := getBizNode(message); bizNode != nil {
+		node.Content = append(node.GetChildren(), *bizNode)
 	}
 	if includeIdentity {
 		err := cli.appendDeviceIdentityNode(&node)
@@ -210,29 +516,6 @@ func (cli *Client) prepareMessageNode(to types.JID, id types.MessageID, message
 	return &node, nil
 }
 
-func marshalMessage(to types.JID, message *waProto.Message) (plaintext, dsmPlaintext []byte, err error) {
-	plaintext, err = proto.Marshal(message)
-	if err != nil {
-		err = fmt.Errorf("failed to marshal message: %w", err)
-		return
-	}
-
-	if to.Server != types.GroupServer {
-		dsmPlaintext, err = proto.Marshal(&waProto.Message{
-			DeviceSentMessage: &waProto.DeviceSentMessage{
-				DestinationJid: proto.String(to.String()),
-				Message:        message,
-			},
-		})
-		if err != nil {
-			err = fmt.Errorf("failed to marshal message (for own devices): %w", err)
-			return
-		}
-	}
-
-	return
-}
-
 func (cli *Client) appendDeviceIdentityNode(node *waBinary.Node) error {
 	deviceIdentity, err := proto.Marshal(cli.Store.Account)
 	if err != nil {
@@ -245,56 +528,84 @@ func (cli *Client) appendDeviceIdentityNode(node *waBinary.Node) error {
 	return nil
 }
 
-func (cli *Client) encryptMessageForDevices(allDevices []types.JID, id string, msgPlaintext, dsmPlaintext []byte) ([]waBinary.Node, bool) {
-	includeIdentity := false
-	participantNodes := make([]waBinary.Node, 0, len(allDevices))
-	var retryDevices []types.JID
-	for _, jid := range allDevices {
+// DefaultEncryptionConcurrency is the default value for Client.EncryptionConcurrency.
+const DefaultEncryptionConcurrency = 8
+
+// Messages for fewer devices than this are encrypted serially, as starting goroutines isn't worth it.
+const minParallelEncryptionDevices = 16
+
+func (cli *Client) encryptMessageForDevices(ctx context.Context, allDevices []types.JID, id string, msgPlaintext, dsmPlaintext []byte) ([]waBinary.Node, bool, error) {
+	// Fetch prekeys for all devices without a session in one query before encrypting
+	cli.establishSessions(ctx, allDevices)
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	type encryptResult struct {
+		node     *waBinary.Node
+		isPreKey bool
+	}
+	results := make([]encryptResult, len(allDevices))
+	encrypt := func(i int) {
+		jid := allDevices[i]
 		plaintext := msgPlaintext
 		if jid.User == cli.Store.ID.User && dsmPlaintext != nil {
 			plaintext = dsmPlaintext
 		}
 		encrypted, isPreKey, err := cli.encryptMessageForDeviceAndWrap(plaintext, jid, nil)
 		if errors.Is(err, ErrNoSession) {
-			retryDevices = append(retryDevices, jid)
-			continue
+			cli.Log.Warnf("Can't encrypt %s for %s: no session and fetching prekeys failed", id, jid)
 		} else if err != nil {
 			cli.Log.Warnf("Failed to encrypt %s for %s: %v", id, jid, err)
-			continue
-		}
-		participantNodes = append(participantNodes, *encrypted)
-		if isPreKey {
-			includeIdentity = true
+		} else {
+			results[i] = encryptResult{node: encrypted, isPreKey: isPreKey}
 		}
 	}
-	if len(retryDevices) > 0 {
-		bundles, err := cli.fetchPreKeys(retryDevices)
-		if err != nil {
-			cli.Log.Warnf("Failed to fetch prekeys for %d to retry encryption: %v", retryDevices, err)
-		} else {
-			for _, jid := range retryDevices {
-				resp := bundles[jid]
-				if resp.err != nil {
-					cli.Log.Warnf("Failed to fetch prekey for %s: %v", jid, resp.err)
-					continue
-				}
-				plaintext := msgPlaintext
-				if jid.User == cli.Store.ID.User && dsmPlaintext != nil {
-					plaintext = dsmPlaintext
-				}
-				encrypted, isPreKey, err := cli.encryptMessageForDeviceAndWrap(plaintext, jid, resp.bundle)
-				if err != nil {
-					cli.Log.Warnf("Failed to encrypt %s for %s (retry): %v", id, jid, err)
-					continue
-				}
-				participantNodes = append(participantNodes, *encrypted)
-				if isPreKey {
-					includeIdentity = true
+
+	workers := cli.EncryptionConcurrency
+	if workers <= 1 || len(allDevices) < minParallelEncryptionDevices {
+		for i := range allDevices {
+			if err := ctx.Err(); err != nil {
+				return nil, false, err
+			}
+			encrypt(i)
+		}
+	} else {
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					encrypt(i)
 				}
+			}()
+		}
+	Loop:
+		for i := range allDevices {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				break Loop
 			}
 		}
+		close(jobs)
+		wg.Wait()
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	includeIdentity := false
+	participantNodes := make([]waBinary.Node, 0, len(allDevices))
+	for _, result := range results {
+		if result.node != nil {
+			participantNodes = append(participantNodes, *result.node)
+			includeIdentity = includeIdentity || result.isPreKey
+		}
 	}
-	return participantNodes, includeIdentity
+	return participantNodes, includeIdentity, nil
 }
 
 func (cli *Client) encryptMessageForDeviceAndWrap(plaintext []byte, to types.JID, bundle *prekey.Bundle) (*waBinary.Node, bool, error) {
@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+var messageIDFormat = regexp.MustCompile("^3EB0[0-9A-F]{18}$")
+
+func TestClientGenerateMessageID(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	seen := make(map[types.MessageID]struct{})
+	for i := 0; i < 100; i++ {
+		id := cli.GenerateMessageID()
+		if !messageIDFormat.MatchString(id) {
+			t.Fatalf("Generated message ID %q doesn't match expected format", id)
+		} else if _, ok := seen[id]; ok {
+			t.Fatalf("Generated duplicate message ID %q", id)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestLiveLocationMediaType(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	msg := cli.BuildLiveLocationUpdate("SHARE", types.Coordinates{Latitude: 60.17, Longitude: 24.94}, 2, 0)
+	if msgType := getTypeFromMessage(msg); msgType != "media" {
+		t.Errorf("Expected live location message type to be media, got %q", msgType)
+	}
+	node := waBinary.Node{
+		Tag: "message",
+		Content: []waBinary.Node{{
+			Tag: "participants",
+			Content: []waBinary.Node{{
+				Tag:     "to",
+				Content: []waBinary.Node{{Tag: "enc", Attrs: waBinary.Attrs{"type": "msg"}}},
+			}},
+		}, {
+			Tag:   "enc",
+			Attrs: waBinary.Attrs{"type": "skmsg"},
+		}},
+	}
+	addMediaTypeToEncNodes(&node, msg)
+	participantEnc := node.GetChildren()[0].GetChildren()[0].GetChildren()[0]
+	if participantEnc.Attrs["mediatype"] != "livelocation" || node.GetChildren()[1].Attrs["mediatype"] != "livelocation" {
+		t.Errorf("Media type wasn't added to all enc nodes: %s", node.XMLString())
+	}
+}
+
+func TestSendMessageExtraValidation(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	to := types.NewJID("9876543210", types.DefaultUserServer)
+	msg := cli.BuildText("Hello")
+	if _, err := cli.SendMessage(to, "", msg, SendRequestExtra{}, SendRequestExtra{}); !errors.Is(err, ErrTooManySendExtras) {
+		t.Errorf("Expected ErrTooManySendExtras, got %v", err)
+	}
+	if _, err := cli.SendMessage(to, "", msg, SendRequestExtra{Peer: true}); !errors.Is(err, ErrInvalidPeerRecipient) {
+		t.Errorf("Expected ErrInvalidPeerRecipient for other user, got %v", err)
+	}
+	if _, err := cli.SendMessage(types.NewADJID("9876543210", 0, 2), "", msg); !errors.Is(err, ErrRecipientADJID) {
+		t.Errorf("Expected ErrRecipientADJID for normal message to AD JID, got %v", err)
+	}
+}
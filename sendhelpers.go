@@ -0,0 +1,353 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// detectMimetype detects the mime type of the given file, using the file extension if a file name is provided
+// and falling back to sniffing the content.
+//
+// Parameters like "; charset=utf-8" are removed. Voice notes don't use this, as they always have VoiceNoteMimetype
+// (see BuildVoiceNote), so ogg files are sent with the detected type like any other document.
+func detectMimetype(data []byte, fileName string) string {
+	var mimeType string
+	if ext := filepath.Ext(fileName); len(ext) > 0 {
+		mimeType = mime.TypeByExtension(ext)
+	}
+	if len(mimeType) == 0 {
+		mimeType = http.DetectContentType(data)
+	}
+	if idx := strings.IndexByte(mimeType, ';'); idx > 0 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	return mimeType
+}
+
+func readAllMedia(data io.Reader) ([]byte, error) {
+	if buf, ok := data.(*bytes.Buffer); ok {
+		return buf.Bytes(), nil
+	}
+	bytesData, err := io.ReadAll(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %w", err)
+	}
+	return bytesData, nil
+}
+
+// BuildText builds a plain text message. The built message can be sent normally using Client.SendMessage.
+func (cli *Client) BuildText(text string) *waProto.Message {
+	return &waProto.Message{Conversation: proto.String(text)}
+}
+
+// BuildImage uploads the given image and builds an image message with it.
+// The dimensions of the image are included if it's a JPEG, PNG or GIF.
+func (cli *Client) BuildImage(ctx context.Context, data []byte, caption string) (*waProto.Message, error) {
+	uploaded, err := cli.Upload(ctx, data, MediaImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+	msg := &waProto.ImageMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String(detectMimetype(data, "")),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+	}
+	if len(caption) > 0 {
+		msg.Caption = proto.String(caption)
+	}
+	if cfg, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		msg.Width = proto.Uint32(uint32(cfg.Width))
+		msg.Height = proto.Uint32(uint32(cfg.Height))
+	}
+	return &waProto.Message{ImageMessage: msg}, nil
+}
+
+// VideoOptions contains optional metadata for video messages built with BuildVideoWithOptions.
+// Any fields that aren't set are filled in using Client.VideoProber if it's set.
+type VideoOptions struct {
+	Width    uint32
+	Height   uint32
+	Duration time.Duration
+	// A small JPEG preview of the video.
+	Thumbnail []byte
+	// If true, the video is shown as a looping GIF without sound.
+	GIFPlayback bool
+}
+
+// BuildVideo uploads the given video and builds a video message with it.
+// The dimensions, duration and thumbnail are included if Client.VideoProber is set.
+func (cli *Client) BuildVideo(ctx context.Context, data []byte, caption string) (*waProto.Message, error) {
+	return cli.BuildVideoWithOptions(ctx, data, caption, VideoOptions{})
+}
+
+// BuildVideoWithOptions uploads the given video and builds a video message with it, using the given metadata.
+func (cli *Client) BuildVideoWithOptions(ctx context.Context, data []byte, caption string, opts VideoOptions) (*waProto.Message, error) {
+	needsProbe := opts.Width == 0 || opts.Height == 0 || opts.Duration == 0 || len(opts.Thumbnail) == 0
+	if needsProbe && cli.VideoProber != nil {
+		info, err := cli.VideoProber.ProbeVideo(ctx, data)
+		if err != nil {
+			cli.Log.Warnf("Failed to probe video: %v", err)
+		} else {
+			if opts.Width == 0 || opts.Height == 0 {
+				opts.Width, opts.Height = info.Width, info.Height
+			}
+			if opts.Duration == 0 {
+				opts.Duration = info.Duration
+			}
+			if len(opts.Thumbnail) == 0 {
+				opts.Thumbnail = info.Thumbnail
+			}
+		}
+	}
+	uploaded, err := cli.Upload(ctx, data, MediaVideo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload video: %w", err)
+	}
+	msg := &waProto.VideoMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String(detectMimetype(data, "")),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		JpegThumbnail:     opts.Thumbnail,
+	}
+	if len(caption) > 0 {
+		msg.Caption = proto.String(caption)
+	}
+	if opts.Width > 0 && opts.Height > 0 {
+		msg.Width = proto.Uint32(opts.Width)
+		msg.Height = proto.Uint32(opts.Height)
+	}
+	if opts.Duration > 0 {
+		msg.Seconds = proto.Uint32(uint32((opts.Duration + time.Second/2) / time.Second))
+	}
+	if opts.GIFPlayback {
+		msg.GifPlayback = proto.Bool(true)
+	}
+	return &waProto.Message{VideoMessage: msg}, nil
+}
+
+// BuildAudio uploads the given audio file and builds an audio message with it.
+func (cli *Client) BuildAudio(ctx context.Context, data []byte) (*waProto.Message, error) {
+	uploaded, err := cli.Upload(ctx, data, MediaAudio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload audio: %w", err)
+	}
+	return &waProto.Message{AudioMessage: &waProto.AudioMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String(detectMimetype(data, "")),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+	}}, nil
+}
+
+// DocumentOptions contains optional parameters for document messages built with BuildDocument.
+type DocumentOptions struct {
+	// The title shown in the message bubble. Defaults to the file name.
+	Title string
+	// A caption to show under the document. Documents with captions are wrapped in a DocumentWithCaptionMessage,
+	// which older clients may not support.
+	Caption string
+	// The number of pages in the document, shown for PDFs and other paged documents.
+	PageCount uint32
+	// A JPEG preview of the document, e.g. the first page of a PDF.
+	Thumbnail []byte
+}
+
+// BuildDocument uploads the given file and builds a document message with it.
+// The file name is shown to the recipient. If the mime type is empty, it's detected from the file name and content.
+func (cli *Client) BuildDocument(ctx context.Context, data []byte, fileName, mimeType string, opts DocumentOptions) (*waProto.Message, error) {
+	if len(mimeType) == 0 {
+		mimeType = detectMimetype(data, fileName)
+	}
+	if len(opts.Title) == 0 {
+		opts.Title = fileName
+	}
+	uploaded, err := cli.Upload(ctx, data, MediaDocument)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload document: %w", err)
+	}
+	msg := &waProto.DocumentMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String(mimeType),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		FileName:          proto.String(fileName),
+		Title:             proto.String(opts.Title),
+	}
+	if opts.PageCount > 0 {
+		msg.PageCount = proto.Uint32(opts.PageCount)
+	}
+	if len(opts.Thumbnail) > 0 {
+		msg.JpegThumbnail = opts.Thumbnail
+		if cfg, _, err := image.DecodeConfig(bytes.NewReader(opts.Thumbnail)); err == nil {
+			msg.ThumbnailWidth = proto.Uint32(uint32(cfg.Width))
+			msg.ThumbnailHeight = proto.Uint32(uint32(cfg.Height))
+		}
+	}
+	if len(opts.Caption) > 0 {
+		msg.Caption = proto.String(opts.Caption)
+		return &waProto.Message{DocumentWithCaptionMessage: &waProto.FutureProofMessage{
+			Message: &waProto.Message{DocumentMessage: msg},
+		}}, nil
+	}
+	return &waProto.Message{DocumentMessage: msg}, nil
+}
+
+// BuildLocation builds a static location message. The name and address are optional.
+// The built message can be sent normally using Client.SendMessage.
+func BuildLocation(coords types.Coordinates, name, address string) *waProto.Message {
+	msg := &waProto.LocationMessage{
+		DegreesLatitude:  proto.Float64(coords.Latitude),
+		DegreesLongitude: proto.Float64(coords.Longitude),
+	}
+	if coords.AccuracyInMeters != 0 {
+		msg.AccuracyInMeters = proto.Uint32(coords.AccuracyInMeters)
+	}
+	if len(name) > 0 {
+		msg.Name = proto.String(name)
+	}
+	if len(address) > 0 {
+		msg.Address = proto.String(address)
+	}
+	return &waProto.Message{LocationMessage: msg}
+}
+
+// SendText sends a plain text message to the given chat.
+//
+// If Client.AutoLinkPreview is set, a link preview is added when the text contains a link.
+func (cli *Client) SendText(ctx context.Context, to types.JID, text string) (SendResponse, error) {
+	msg := cli.BuildText(text)
+	if cli.AutoLinkPreview && len(findPreviewURL(text)) > 0 {
+		extText := &waProto.ExtendedTextMessage{Text: proto.String(text)}
+		err := cli.AddLinkPreview(ctx, extText)
+		if err != nil {
+			cli.Log.Warnf("Failed to generate link preview for message to %s: %v", to, err)
+		} else {
+			msg = &waProto.Message{ExtendedTextMessage: extText}
+		}
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
+
+// SendImage uploads the image from the given reader and sends it to the given chat. The caption is optional.
+func (cli *Client) SendImage(ctx context.Context, to types.JID, data io.Reader, caption string) (SendResponse, error) {
+	bytesData, err := readAllMedia(data)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	msg, err := cli.BuildImage(ctx, bytesData, caption)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
+
+// SendVideo uploads the video from the given reader and sends it to the given chat. The caption is optional.
+func (cli *Client) SendVideo(ctx context.Context, to types.JID, data io.Reader, caption string) (SendResponse, error) {
+	bytesData, err := readAllMedia(data)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	msg, err := cli.BuildVideo(ctx, bytesData, caption)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
+
+// SendAudio uploads the audio file from the given reader and sends it to the given chat.
+func (cli *Client) SendAudio(ctx context.Context, to types.JID, data io.Reader) (SendResponse, error) {
+	bytesData, err := readAllMedia(data)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	msg, err := cli.BuildAudio(ctx, bytesData)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
+
+// SendDocument uploads the file from the given reader and sends it to the given chat as a document.
+// If the mime type is empty, it's detected from the file name and content. See DocumentOptions for the optional fields.
+func (cli *Client) SendDocument(ctx context.Context, to types.JID, data io.Reader, fileName, mimeType string, opts DocumentOptions) (SendResponse, error) {
+	bytesData, err := readAllMedia(data)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	msg, err := cli.BuildDocument(ctx, bytesData, fileName, mimeType, opts)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
+
+// SendStickerOptions contains optional parameters for SendSticker.
+type SendStickerOptions struct {
+	// If set, the sticker pack info is embedded in the sticker, so the recipient's client shows which pack it's from.
+	Metadata *StickerMetadata
+}
+
+// SendSticker uploads the given webp file and sends it to the given chat as a sticker.
+//
+// The sticker must be a 512x512 webp image (see ValidateSticker). The animation flag and dimensions are read from
+// the file, as stickers without them aren't rendered properly by the official clients.
+func (cli *Client) SendSticker(ctx context.Context, to types.JID, webpData []byte, opts SendStickerOptions) (SendResponse, error) {
+	if opts.Metadata != nil {
+		var err error
+		webpData, err = AddStickerMetadata(webpData, *opts.Metadata)
+		if err != nil {
+			return SendResponse{}, fmt.Errorf("failed to add metadata to sticker: %w", err)
+		}
+	}
+	sticker, err := cli.UploadSticker(ctx, webpData)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", &waProto.Message{StickerMessage: sticker})
+}
+
+// SendLocation sends a static location to the given chat. The name and address are optional.
+// To share live location, use Client.StartLiveLocation instead.
+func (cli *Client) SendLocation(ctx context.Context, to types.JID, coords types.Coordinates, name, address string) (SendResponse, error) {
+	return cli.SendMessageContext(ctx, to, "", BuildLocation(coords, name, address))
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+)
+
+func TestDetectMimetype(t *testing.T) {
+	oggData := append([]byte("OggS\x00"), make([]byte, 32)...)
+	pngData := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name     string
+		data     []byte
+		fileName string
+		expected string
+	}{
+		{"sniffed text", []byte("hello world"), "", "text/plain"},
+		{"text extension", []byte("hello world"), "notes.txt", "text/plain"},
+		{"html extension", []byte("<p>hi</p>"), "page.html", "text/html"},
+		{"sniffed png", pngData, "", "image/png"},
+		{"extension wins over content", pngData, "file.pdf", "application/pdf"},
+		{"unknown extension falls back to sniffing", pngData, "file.unknownext", "image/png"},
+		{"sniffed ogg", oggData, "", "application/ogg"},
+		{"ogg extension", oggData, "voice.ogg", "audio/ogg"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if mimeType := detectMimetype(test.data, test.fileName); mimeType != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, mimeType)
+			}
+		})
+	}
+}
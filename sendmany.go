@@ -0,0 +1,200 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/libsignal/session"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// SendManyResponse contains the result of sending a message to one of the recipients in Client.SendMessageToMany.
+type SendManyResponse struct {
+	To types.JID
+	SendResponse
+	// The error that occurred while sending to this recipient, or nil if the server acknowledged the message.
+	Error error
+}
+
+// establishSessions fetches prekeys for all the given devices that don't have a Signal session yet using a single
+// query, so that encrypting messages for them later doesn't need a separate query per device.
+func (cli *Client) establishSessions(ctx context.Context, devices []types.JID) {
+	var missing []types.JID
+	for _, jid := range devices {
+		if !cli.Store.ContainsSession(jid.SignalAddress()) {
+			missing = append(missing, jid)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	bundles, err := cli.fetchPreKeys(ctx, missing)
+	if err != nil {
+		cli.Log.Warnf("Failed to prefetch prekeys for %d devices: %v", len(missing), err)
+		return
+	}
+	for _, jid := range missing {
+		resp := bundles[jid]
+		if resp.err != nil {
+			cli.Log.Warnf("Failed to prefetch prekey for %s: %v", jid, resp.err)
+			continue
+		}
+		builder := session.NewBuilderFromSignal(cli.Store, jid.SignalAddress(), pbSerializer)
+		err = builder.ProcessBundle(resp.bundle)
+		if err != nil {
+			cli.Log.Warnf("Failed to process prekey bundle of %s: %v", jid, err)
+		}
+	}
+}
+
+// SendMessageToMany sends the same message to many chats, each with its own message ID.
+//
+// This is much faster than calling SendMessage in a loop for direct chats: the message is only marshaled once,
+// the device lists of all recipients are fetched in one query, missing Signal sessions are established with one
+// prekey query, and all messages are sent before waiting for any of the server acknowledgements.
+//
+// Group recipients are supported too, but they don't benefit from any of this: after all direct chats are done,
+// each group is sent separately with SendMessageContext, which fetches the group info, encrypts the sender key
+// distribution for participants who need it and waits for the acknowledgement before moving on to the next group.
+//
+// The returned slice contains one response for each unique recipient in the order they were given. The error is only
+// set if sending to every recipient failed for a shared reason (e.g. marshaling the message or fetching device lists).
+func (cli *Client) SendMessageToMany(ctx context.Context, recipients []types.JID, message *waProto.Message) ([]SendManyResponse, error) {
+	ownID := cli.Store.ID
+	if ownID == nil {
+		return nil, ErrNotLoggedIn
+	}
+	if message.GetPollCreationMessage() != nil {
+		if err := cli.checkFeature(FeaturePolls); err != nil {
+			return nil, err
+		}
+	}
+	start := time.Now()
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	marshalTime := time.Since(start)
+
+	seen := make(map[types.JID]struct{}, len(recipients))
+	responses := make([]SendManyResponse, 0, len(recipients))
+	var dmUsers []types.JID
+	var dmIndexes []int
+	for _, to := range recipients {
+		if _, alreadySeen := seen[to]; alreadySeen {
+			continue
+		}
+		seen[to] = struct{}{}
+		resp := SendManyResponse{To: to}
+		if to.AD {
+			resp.Error = ErrRecipientADJID
+		} else if to.Server == types.DefaultUserServer {
+			dmUsers = append(dmUsers, to)
+			dmIndexes = append(dmIndexes, len(responses))
+		}
+		responses = append(responses, resp)
+	}
+
+	if len(dmUsers) > 0 {
+		start = time.Now()
+		deviceUsers := dmUsers
+		if _, sendingToSelf := seen[ownID.ToNonAD()]; !sendingToSelf {
+			deviceUsers = append(deviceUsers, ownID.ToNonAD())
+		}
+		allDevices, err := cli.GetUserDevicesContext(ctx, deviceUsers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get device lists: %w", err)
+		}
+		getDevicesTime := time.Since(start)
+		devicesByUser := make(map[string][]types.JID, len(dmUsers)+1)
+		for _, device := range allDevices {
+			devicesByUser[device.User] = append(devicesByUser[device.User], device)
+		}
+		cli.establishSessions(ctx, allDevices)
+
+		respChans := make([]<-chan *waBinary.Node, len(dmIndexes))
+		for i, index := range dmIndexes {
+			resp := &responses[index]
+			resp.DebugTimings.Marshal = marshalTime
+			resp.DebugTimings.GetDevices = getDevicesTime
+			respChans[i] = cli.sendDMWithDevices(ctx, resp, message, plaintext, devicesByUser)
+		}
+		for i, index := range dmIndexes {
+			if respChans[i] != nil {
+				cli.waitSendManyResponse(ctx, &responses[index], message, respChans[i])
+			}
+		}
+	}
+
+	for i := range responses {
+		resp := &responses[i]
+		if resp.To.Server != types.DefaultUserServer && resp.Error == nil {
+			resp.SendResponse, resp.Error = cli.SendMessageContext(ctx, resp.To, "", message)
+		}
+	}
+	return responses, nil
+}
+
+// sendDMWithDevices encrypts and sends a message to a single recipient of SendMessageToMany using already fetched
+// device lists. The returned channel will receive the server's acknowledgement, or is nil if sending failed.
+func (cli *Client) sendDMWithDevices(ctx context.Context, resp *SendManyResponse, message *waProto.Message, plaintext []byte, devicesByUser map[string][]types.JID) <-chan *waBinary.Node {
+	ownID := *cli.Store.ID
+	resp.ID = cli.GenerateMessageID()
+	if err := cli.waitSendRateLimit(ctx, resp.To, resp.ID); err != nil {
+		resp.Error = err
+		return nil
+	}
+	cli.storeMessageSecret(resp.To, ownID, resp.ID, message)
+	cli.addRecentMessage(resp.To, resp.ID, plaintext)
+
+	devices := append([]types.JID{}, devicesByUser[resp.To.User]...)
+	if resp.To.User != ownID.User {
+		devices = append(devices, devicesByUser[ownID.User]...)
+	}
+	node, err := cli.buildMessageNode(ctx, resp.To, resp.ID, message, devices, plaintext, wrapDeviceSentMessage(resp.To, plaintext), &resp.DebugTimings)
+	if err != nil {
+		resp.Error = err
+		return nil
+	}
+	respChan := cli.waitResponse(resp.ID)
+	start := time.Now()
+	err = cli.sendNode(*node)
+	resp.DebugTimings.Send = time.Since(start)
+	if err != nil {
+		cli.cancelResponse(resp.ID)
+		resp.Error = fmt.Errorf("failed to send message node: %w", err)
+		return nil
+	}
+	return respChan
+}
+
+func (cli *Client) waitSendManyResponse(ctx context.Context, resp *SendManyResponse, message *waProto.Message, respChan <-chan *waBinary.Node) {
+	start := time.Now()
+	var respNode *waBinary.Node
+	select {
+	case respNode = <-respChan:
+	case <-ctx.Done():
+		cli.cancelResponse(resp.ID)
+		resp.Error = ctx.Err()
+		return
+	}
+	resp.DebugTimings.Resp = time.Since(start)
+	if respNode == closedNode {
+		resp.Error = &DisconnectedBeforeResponseError{RequestID: resp.ID, Elapsed: time.Since(start)}
+		return
+	}
+	resp.Timestamp = time.Unix(respNode.AttrGetter().Int64("t"), 0)
+	cli.trackOwnPoll(resp.To, resp.ID, resp.Timestamp, message)
+}
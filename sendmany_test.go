@@ -0,0 +1,241 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mau.fi/libsignal/session"
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// newSendTestClient creates a logged in client with Signal sessions with the primary device of each given user.
+func newSendTestClient(t *testing.T, users ...types.JID) (*Client, map[types.JID]*testRecipientDevice) {
+	ownID := types.NewADJID("1111111111", 0, 1)
+	signalStore := newMemorySignalStore()
+	cli := NewClient(&store.Device{
+		Log:         waLog.Noop,
+		ID:          &ownID,
+		IdentityKey: keys.NewKeyPair(),
+		Identities:  signalStore,
+		Sessions:    signalStore,
+	}, nil)
+	recipients := make(map[types.JID]*testRecipientDevice, len(users)+1)
+	for _, user := range append(users, ownID.ToNonAD()) {
+		device := types.NewADJID(user.User, 0, 0)
+		recipient, bundle := newTestRecipientDevice(t, device, ownID)
+		recipients[device] = recipient
+		err := session.NewBuilderFromSignal(cli.Store, device.SignalAddress(), pbSerializer).ProcessBundle(bundle)
+		if err != nil {
+			t.Fatalf("Failed to process bundle of %s: %v", device, err)
+		}
+	}
+	return cli, recipients
+}
+
+// fakeSendHandler responds to device list queries with the primary device of each user, acks all messages
+// and fails group info queries.
+func fakeSendHandler(node *waBinary.Node) []waBinary.Node {
+	switch node.Tag {
+	case "iq":
+		if node.AttrGetter().String("xmlns") != "usync" {
+			return []waBinary.Node{fakeIQError(node, 404, "item-not-found")}
+		}
+		var users []waBinary.Node
+		list := node.GetChildByTag("usync", "list")
+		for _, user := range list.GetChildren() {
+			users = append(users, waBinary.Node{
+				Tag:   "user",
+				Attrs: waBinary.Attrs{"jid": user.Attrs["jid"]},
+				Content: []waBinary.Node{{Tag: "devices", Content: []waBinary.Node{{
+					Tag:     "device-list",
+					Content: []waBinary.Node{{Tag: "device", Attrs: waBinary.Attrs{"id": "0"}}},
+				}}}},
+			})
+		}
+		return []waBinary.Node{fakeIQResult(node, waBinary.Node{
+			Tag:     "usync",
+			Content: []waBinary.Node{{Tag: "list", Content: users}},
+		})}
+	case "message":
+		return []waBinary.Node{{Tag: "ack", Attrs: waBinary.Attrs{
+			"id": node.Attrs["id"], "class": "message", "from": node.Attrs["to"], "t": "1640000000",
+		}}}
+	}
+	return nil
+}
+
+func TestSendMessageToMany(t *testing.T) {
+	userA := types.NewJID("2222222222", types.DefaultUserServer)
+	userB := types.NewJID("3333333333", types.DefaultUserServer)
+	adJID := types.NewADJID("4444444444", 0, 1)
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	cli, recipients := newSendTestClient(t, userA, userB)
+	server := connectFakeServer(t, cli, fakeSendHandler)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	message := &waProto.Message{Conversation: proto.String("hello")}
+	responses, err := cli.SendMessageToMany(ctx, []types.JID{userA, adJID, userB, group, userA}, message)
+	if err != nil {
+		t.Fatalf("SendMessageToMany returned error: %v", err)
+	}
+
+	expectedOrder := []types.JID{userA, adJID, userB, group}
+	if len(responses) != len(expectedOrder) {
+		t.Fatalf("Expected one response per unique recipient, got %d", len(responses))
+	}
+	for i, resp := range responses {
+		if resp.To != expectedOrder[i] {
+			t.Errorf("Expected response #%d to be for %s, got %s", i, expectedOrder[i], resp.To)
+		}
+	}
+	if !errors.Is(responses[1].Error, ErrRecipientADJID) {
+		t.Errorf("Expected ErrRecipientADJID for device JID, got %v", responses[1].Error)
+	}
+	if responses[3].Error == nil {
+		t.Errorf("Expected error for group whose info can't be fetched")
+	}
+	if responses[0].ID == responses[2].ID {
+		t.Errorf("Expected each recipient to get its own message ID")
+	}
+
+	expectedPlaintext, _ := proto.Marshal(message)
+	for _, resp := range []SendManyResponse{responses[0], responses[2]} {
+		if resp.Error != nil {
+			t.Errorf("Failed to send to %s: %v", resp.To, resp.Error)
+			continue
+		} else if resp.Timestamp.Unix() != 1640000000 {
+			t.Errorf("Expected timestamp from ack for %s, got %s", resp.To, resp.Timestamp)
+		}
+		var msgNode *waBinary.Node
+		for _, node := range server.Received("message") {
+			if node.AttrGetter().String("id") == resp.ID {
+				msgNode = node
+			}
+		}
+		if msgNode == nil {
+			t.Errorf("Message to %s wasn't sent", resp.To)
+			continue
+		}
+		recipient := recipients[types.NewADJID(resp.To.User, 0, 0)]
+		participants := msgNode.GetChildByTag("participants")
+		decrypted := false
+		for _, to := range participants.GetChildren() {
+			if to.AttrGetter().JID("jid") != recipient.jid {
+				continue
+			}
+			decrypted = true
+			plaintext, err := recipient.decrypt(to)
+			if err != nil {
+				t.Errorf("Failed to decrypt message to %s: %v", resp.To, err)
+			} else if !proto.Equal(unmarshalTestMessage(t, plaintext), unmarshalTestMessage(t, expectedPlaintext)) {
+				t.Errorf("Unexpected plaintext for %s", resp.To)
+			}
+		}
+		if !decrypted {
+			t.Errorf("Message to %s wasn't encrypted for %s", resp.To, recipient.jid)
+		}
+	}
+
+	var usyncQueries int
+	for _, iq := range server.Received("iq") {
+		if iq.AttrGetter().String("xmlns") == "usync" {
+			usyncQueries++
+		}
+	}
+	if usyncQueries != 1 {
+		t.Errorf("Expected device lists of all direct chats to be fetched in one query, got %d queries", usyncQueries)
+	}
+}
+
+func TestSendMessageToManyOwnNumber(t *testing.T) {
+	other := types.NewJID("2222222222", types.DefaultUserServer)
+	cli, _ := newSendTestClient(t, other)
+	ownUser := cli.Store.ID.ToNonAD()
+	server := connectFakeServer(t, cli, fakeSendHandler)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	responses, err := cli.SendMessageToMany(ctx, []types.JID{ownUser, other}, &waProto.Message{Conversation: proto.String("note to self")})
+	if err != nil {
+		t.Fatalf("SendMessageToMany returned error: %v", err)
+	}
+	for _, resp := range responses {
+		if resp.Error != nil {
+			t.Fatalf("Failed to send to %s: %v", resp.To, resp.Error)
+		}
+	}
+	for _, iq := range server.Received("iq") {
+		if iq.AttrGetter().String("xmlns") != "usync" {
+			continue
+		}
+		list := iq.GetChildByTag("usync", "list")
+		if users := list.GetChildren(); len(users) != 2 {
+			t.Errorf("Expected own number to be queried once, got %d users", len(users))
+		}
+	}
+	for _, msgNode := range server.Received("message") {
+		if msgNode.AttrGetter().String("id") != responses[0].ID {
+			continue
+		}
+		seen := make(map[types.JID]bool)
+		participants := msgNode.GetChildByTag("participants")
+		for _, to := range participants.GetChildren() {
+			jid := to.AttrGetter().JID("jid")
+			if seen[jid] {
+				t.Errorf("Message to own number was encrypted for %s twice", jid)
+			}
+			seen[jid] = true
+		}
+		if len(seen) == 0 {
+			t.Errorf("Message to own number wasn't encrypted for any devices")
+		}
+	}
+}
+
+func unmarshalTestMessage(t *testing.T, data []byte) *waProto.Message {
+	var msg waProto.Message
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("Failed to unmarshal message: %v", err)
+	}
+	return &msg
+}
+
+func TestSendMessageToManyNotLoggedIn(t *testing.T) {
+	cli := NewClient(&store.Device{}, nil)
+	_, err := cli.SendMessageToMany(context.Background(), []types.JID{types.NewJID("2222222222", types.DefaultUserServer)}, &waProto.Message{})
+	if !errors.Is(err, ErrNotLoggedIn) {
+		t.Errorf("Expected ErrNotLoggedIn, got %v", err)
+	}
+}
+
+func TestSendMessageToManyDeviceListError(t *testing.T) {
+	cli, _ := newSendTestClient(t)
+	connectFakeServer(t, cli, func(node *waBinary.Node) []waBinary.Node {
+		if node.Tag == "iq" {
+			return []waBinary.Node{fakeIQError(node, 500, "internal-server-error")}
+		}
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := cli.SendMessageToMany(ctx, []types.JID{types.NewJID("2222222222", types.DefaultUserServer)}, &waProto.Message{Conversation: proto.String("hi")})
+	if err == nil {
+		t.Errorf("Expected shared error when device lists can't be fetched")
+	}
+}
@@ -19,6 +19,16 @@ const (
 	URL = "wss://web.whatsapp.com/ws/chat"
 )
 
+// URLOverride and OriginOverride can be set to connect to a different websocket URL with a different Origin header,
+// e.g. to point the traffic at a local relay or MITM proxy for protocol debugging or integration tests.
+// If URLOverride is set, it's used instead of Endpoints. Changes only affect sockets created afterwards.
+//
+// A MITM proxy that terminates TLS will also need a custom TLS config, see Client.SetWSDialer.
+var (
+	URLOverride    string
+	OriginOverride string
+)
+
 const (
 	NoiseStartPattern = "Noise_XX_25519_AESGCM_SHA256\x00\x00\x00\x00"
 
@@ -37,4 +47,5 @@ var (
 	ErrFrameTooLarge     = errors.New("frame too large")
 	ErrSocketClosed      = errors.New("frame socket is closed")
 	ErrSocketAlreadyOpen = errors.New("frame socket is already open")
+	ErrNoEndpoints       = errors.New("no websocket endpoints to connect to")
 )
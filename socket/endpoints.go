@@ -0,0 +1,120 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package socket
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Endpoints is the default list of websocket URLs that are tried in order when connecting.
+// WhatsApp web only has one public websocket endpoint, so additional endpoints (like relays) must be configured
+// by the user, either here, in FrameSocket.URLs or with whatsmeow.Client.WebsocketURLs.
+//
+// Each hostname is resolved once and the addresses are cached for DNSCacheTTL. If connecting to an address fails,
+// the next address of the same host is tried before moving on to the next endpoint, and the address that worked
+// is tried first on the next connection. A host usually resolves to several addresses, so this provides failover
+// even with a single endpoint.
+var Endpoints = []string{URL}
+
+// Resolver is used to resolve the hostnames of websocket endpoints. It's implemented by *net.Resolver.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DNSCacheTTL specifies how long the resolved addresses of websocket endpoints are cached.
+var DNSCacheTTL = 10 * time.Minute
+
+type dnsCacheEntry struct {
+	addrs      []string
+	preferred  int
+	resolvedAt time.Time
+}
+
+type dnsCache struct {
+	lock    sync.Mutex
+	entries map[string]*dnsCacheEntry
+}
+
+var endpointDNSCache = &dnsCache{entries: make(map[string]*dnsCacheEntry)}
+
+func (dc *dnsCache) lookup(ctx context.Context, resolver Resolver, host string) ([]string, int, error) {
+	dc.lock.Lock()
+	entry, ok := dc.entries[host]
+	dc.lock.Unlock()
+	if ok && time.Since(entry.resolvedAt) < DNSCacheTTL {
+		return entry.addrs, entry.preferred, nil
+	}
+	addrs, err := resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, 0, err
+	}
+	dc.lock.Lock()
+	dc.entries[host] = &dnsCacheEntry{addrs: addrs, resolvedAt: time.Now()}
+	dc.lock.Unlock()
+	return addrs, 0, nil
+}
+
+func (dc *dnsCache) setPreferred(host string, index int) {
+	dc.lock.Lock()
+	if entry, ok := dc.entries[host]; ok {
+		entry.preferred = index
+	}
+	dc.lock.Unlock()
+}
+
+func (dc *dnsCache) invalidate(host string) {
+	dc.lock.Lock()
+	delete(dc.entries, host)
+	dc.lock.Unlock()
+}
+
+type dialContextFunc = func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// wrapDialer returns a dial function that connects to the cached addresses of the given endpoint host
+// instead of letting the underlying dialer resolve the hostname. Connections to other hosts (e.g. proxies)
+// are passed through as-is.
+func (fs *FrameSocket) wrapDialer(base dialContextFunc, endpointHost string) dialContextFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil || host != endpointHost {
+			return base(ctx, network, addr)
+		}
+		var resolver Resolver = net.DefaultResolver
+		if fs.Resolver != nil {
+			resolver = fs.Resolver
+		}
+		addrs, preferred, err := endpointDNSCache.lookup(ctx, resolver, host)
+		if err != nil {
+			return nil, err
+		}
+		for i := range addrs {
+			index := (preferred + i) % len(addrs)
+			var conn net.Conn
+			conn, err = base(ctx, network, net.JoinHostPort(addrs[index], port))
+			if err == nil {
+				endpointDNSCache.setPreferred(host, index)
+				return conn, nil
+			}
+			fs.log.Debugf("Failed to connect to %s (%s): %v", host, addrs[index], err)
+		}
+		// None of the cached addresses worked, so resolve the host again next time
+		endpointDNSCache.invalidate(host)
+		return nil, err
+	}
+}
+
+func endpointHostname(endpoint string) string {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return parsed.Hostname()
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package socket
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+type fakeResolver struct {
+	hosts   map[string][]string
+	lookups int
+}
+
+func (fr *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	fr.lookups++
+	addrs, ok := fr.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+type fakeDialer struct {
+	lock    sync.Mutex
+	dialed  []string
+	working map[string]string
+}
+
+func (fd *fakeDialer) dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	fd.lock.Lock()
+	fd.dialed = append(fd.dialed, addr)
+	target, ok := fd.working[addr]
+	fd.lock.Unlock()
+	if !ok {
+		return nil, errors.New("connection refused")
+	} else if len(target) > 0 {
+		return (&net.Dialer{}).DialContext(ctx, network, target)
+	}
+	client, server := net.Pipe()
+	_ = server.Close()
+	return client, nil
+}
+
+func (fd *fakeDialer) takeDialed() []string {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+	dialed := fd.dialed
+	fd.dialed = nil
+	return dialed
+}
+
+func TestWrapDialerOrder(t *testing.T) {
+	const host = "dial-order.example"
+	resolver := &fakeResolver{hosts: map[string][]string{host: {"192.0.2.1", "192.0.2.2", "192.0.2.3"}}}
+	dialer := &fakeDialer{working: map[string]string{"192.0.2.2:443": ""}}
+	fs := &FrameSocket{log: waLog.Noop, Resolver: resolver}
+	dial := fs.wrapDialer(dialer.dial, host)
+
+	if _, err := dial(context.Background(), "tcp", host+":443"); err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	if dialed := dialer.takeDialed(); !reflect.DeepEqual(dialed, []string{"192.0.2.1:443", "192.0.2.2:443"}) {
+		t.Errorf("Unexpected dial order %v", dialed)
+	}
+	// The address that worked is tried first next time, and the cached addresses are reused
+	if _, err := dial(context.Background(), "tcp", host+":443"); err != nil {
+		t.Fatalf("Failed to dial again: %v", err)
+	}
+	if dialed := dialer.takeDialed(); !reflect.DeepEqual(dialed, []string{"192.0.2.2:443"}) {
+		t.Errorf("Expected working address to be tried first, got %v", dialed)
+	} else if resolver.lookups != 1 {
+		t.Errorf("Expected one lookup, got %d", resolver.lookups)
+	}
+
+	// Other hosts (e.g. proxies) are dialed directly
+	if _, err := dial(context.Background(), "tcp", "proxy.example:1080"); err == nil {
+		t.Errorf("Expected error from dialing proxy")
+	}
+	if dialed := dialer.takeDialed(); !reflect.DeepEqual(dialed, []string{"proxy.example:1080"}) {
+		t.Errorf("Expected proxy to be dialed as-is, got %v", dialed)
+	}
+
+	// If all addresses fail, the host is resolved again on the next attempt
+	dialer.working = nil
+	if _, err := dial(context.Background(), "tcp", host+":443"); err == nil {
+		t.Fatalf("Expected error when all addresses fail")
+	}
+	if dialed := dialer.takeDialed(); len(dialed) != 3 || dialed[0] != "192.0.2.2:443" {
+		t.Errorf("Expected all addresses to be tried starting from the preferred one, got %v", dialed)
+	}
+	_, _ = dial(context.Background(), "tcp", host+":443")
+	if resolver.lookups != 2 {
+		t.Errorf("Expected host to be resolved again after all addresses failed, got %d lookups", resolver.lookups)
+	}
+}
+
+func TestConnectFallbackEndpoint(t *testing.T) {
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err == nil {
+			_ = conn.Close()
+		}
+	}))
+	defer server.Close()
+
+	resolver := &fakeResolver{hosts: map[string][]string{
+		"primary.example":  {"192.0.2.10", "192.0.2.11"},
+		"fallback.example": {"192.0.2.20"},
+	}}
+	dialer := &fakeDialer{working: map[string]string{
+		"192.0.2.20:80": strings.TrimPrefix(server.URL, "http://"),
+	}}
+	fs := NewFrameSocket(waLog.Noop, nil, nil)
+	fs.URLs = []string{"ws://primary.example/ws/chat", "ws://fallback.example/ws/chat"}
+	fs.Resolver = resolver
+	fs.Dialer = &websocket.Dialer{NetDialContext: dialer.dial}
+	if err := fs.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer fs.Close(0)
+	if fs.Endpoint() != "ws://fallback.example/ws/chat" {
+		t.Errorf("Expected to be connected to the fallback endpoint, got %s", fs.Endpoint())
+	}
+	expected := []string{"192.0.2.10:80", "192.0.2.11:80", "192.0.2.20:80"}
+	if dialed := dialer.takeDialed(); !reflect.DeepEqual(dialed, expected) {
+		t.Errorf("Expected dial order %v, got %v", expected, dialed)
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -19,16 +22,44 @@ import (
 	waLog "go.mau.fi/whatsmeow/util/log"
 )
 
+// Proxy is a function that returns the proxy URL to use for a given request, like http.Transport.Proxy.
+// The URL scheme can be http, https or socks5.
+type Proxy = func(*http.Request) (*url.URL, error)
+
 type FrameSocket struct {
+	// Accessed atomically, kept first in the struct for 64-bit alignment
+	bytesSent      uint64
+	bytesReceived  uint64
+	framesSent     uint64
+	framesReceived uint64
+
 	conn   *websocket.Conn
 	ctx    context.Context
 	cancel func()
 	log    waLog.Logger
 	lock   sync.Mutex
+	proxy  Proxy
 
 	Frames       chan []byte
 	OnDisconnect func(remote bool)
 	WriteTimeout time.Duration
+	// OnTraffic is called synchronously after every frame that is sent or received, with the size of the frame
+	// in bytes including the frame header. It must return quickly, as it blocks reading or writing.
+	OnTraffic func(incoming bool, size int)
+	// ReadTimeout is the maximum time to wait for any data from the server. If nothing is received in time,
+	// the socket is closed as if the server had disconnected. Zero means no timeout.
+	ReadTimeout time.Duration
+	// Dialer is the websocket dialer to use for connecting. If nil, a zero dialer is used.
+	// The Proxy field of the dialer is ignored, the proxy passed to NewFrameSocket is used instead.
+	Dialer *websocket.Dialer
+	// URLs is the list of websocket URLs to try when connecting. Defaults to Endpoints, or URLOverride if it's set.
+	URLs []string
+	// Origin is the value of the Origin header sent when connecting. Defaults to Origin, or OriginOverride if it's set.
+	Origin string
+	// Resolver is used to resolve the hostnames in URLs. Defaults to net.DefaultResolver.
+	Resolver Resolver
+
+	endpoint string
 
 	Header []byte
 
@@ -38,19 +69,54 @@ type FrameSocket struct {
 	partialHeader  []byte
 }
 
-func NewFrameSocket(log waLog.Logger, header []byte) *FrameSocket {
-	return &FrameSocket{
+func NewFrameSocket(log waLog.Logger, header []byte, proxy Proxy) *FrameSocket {
+	fs := &FrameSocket{
 		conn:   nil,
 		log:    log,
 		Header: header,
 		Frames: make(chan []byte),
+		URLs:   Endpoints,
+		Origin: Origin,
+		proxy:  proxy,
+	}
+	if len(URLOverride) > 0 {
+		fs.URLs = []string{URLOverride}
 	}
+	if len(OriginOverride) > 0 {
+		fs.Origin = OriginOverride
+	}
+	return fs
 }
 
 func (fs *FrameSocket) IsConnected() bool {
 	return fs.conn != nil
 }
 
+// BytesSent returns the number of bytes sent through the socket, including frame headers.
+func (fs *FrameSocket) BytesSent() uint64 {
+	return atomic.LoadUint64(&fs.bytesSent)
+}
+
+// BytesReceived returns the number of bytes received through the socket, including frame headers.
+func (fs *FrameSocket) BytesReceived() uint64 {
+	return atomic.LoadUint64(&fs.bytesReceived)
+}
+
+// FramesSent returns the number of frames sent through the socket.
+func (fs *FrameSocket) FramesSent() uint64 {
+	return atomic.LoadUint64(&fs.framesSent)
+}
+
+// FramesReceived returns the number of complete frames received through the socket.
+func (fs *FrameSocket) FramesReceived() uint64 {
+	return atomic.LoadUint64(&fs.framesReceived)
+}
+
+// Endpoint returns the websocket URL that the socket is connected to.
+func (fs *FrameSocket) Endpoint() string {
+	return fs.endpoint
+}
+
 func (fs *FrameSocket) Context() context.Context {
 	return fs.ctx
 }
@@ -85,6 +151,12 @@ func (fs *FrameSocket) Close(code int) {
 }
 
 func (fs *FrameSocket) Connect() error {
+	return fs.ConnectContext(context.Background())
+}
+
+// ConnectContext connects to the websocket. The context is only used for dialing,
+// canceling it after the connection is established doesn't close the socket.
+func (fs *FrameSocket) ConnectContext(dialCtx context.Context) error {
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
 
@@ -92,11 +164,38 @@ func (fs *FrameSocket) Connect() error {
 		return ErrSocketAlreadyOpen
 	}
 	ctx, cancel := context.WithCancel(context.Background())
-	dialer := websocket.Dialer{}
+	var dialer websocket.Dialer
+	if fs.Dialer != nil {
+		dialer = *fs.Dialer
+	}
+	dialer.Proxy = fs.proxy
+	baseDial := dialer.NetDialContext
+	if baseDial == nil && dialer.NetDial != nil {
+		netDial := dialer.NetDial
+		baseDial = func(_ context.Context, network, addr string) (net.Conn, error) {
+			return netDial(network, addr)
+		}
+	} else if baseDial == nil {
+		baseDial = (&net.Dialer{}).DialContext
+	}
+	dialer.NetDial = nil
 
-	headers := http.Header{"Origin": []string{Origin}}
-	fs.log.Debugf("Dialing %s", URL)
-	conn, _, err := dialer.Dial(URL, headers)
+	headers := http.Header{"Origin": []string{fs.Origin}}
+	var conn *websocket.Conn
+	var err error
+	for _, endpoint := range fs.URLs {
+		dialer.NetDialContext = fs.wrapDialer(baseDial, endpointHostname(endpoint))
+		fs.log.Debugf("Dialing %s", endpoint)
+		conn, _, err = dialer.DialContext(dialCtx, endpoint, headers)
+		if err == nil {
+			fs.endpoint = endpoint
+			break
+		}
+		fs.log.Warnf("Failed to dial %s: %v", endpoint, err)
+	}
+	if conn == nil && err == nil {
+		err = ErrNoEndpoints
+	}
 	if err != nil {
 		cancel()
 		return fmt.Errorf("couldn't dial whatsapp web websocket: %w", err)
@@ -152,11 +251,23 @@ func (fs *FrameSocket) SendFrame(data []byte) error {
 			fs.log.Warnf("Failed to set write deadline: %v", err)
 		}
 	}
-	return conn.WriteMessage(websocket.BinaryMessage, wholeFrame)
+	err := conn.WriteMessage(websocket.BinaryMessage, wholeFrame)
+	if err == nil {
+		atomic.AddUint64(&fs.bytesSent, uint64(len(wholeFrame)))
+		atomic.AddUint64(&fs.framesSent, 1)
+		if fs.OnTraffic != nil {
+			fs.OnTraffic(false, len(wholeFrame))
+		}
+	}
+	return err
 }
 
 func (fs *FrameSocket) frameComplete() {
 	data := fs.incoming
+	atomic.AddUint64(&fs.framesReceived, 1)
+	if fs.OnTraffic != nil {
+		fs.OnTraffic(true, FrameLengthSize+len(data))
+	}
 	fs.incoming = nil
 	fs.partialHeader = nil
 	fs.incomingLength = 0
@@ -212,6 +323,12 @@ func (fs *FrameSocket) readPump(conn *websocket.Conn, ctx context.Context) {
 		go fs.Close(0)
 	}()
 	for {
+		if fs.ReadTimeout > 0 {
+			err := conn.SetReadDeadline(time.Now().Add(fs.ReadTimeout))
+			if err != nil {
+				fs.log.Warnf("Failed to set read deadline: %v", err)
+			}
+		}
 		msgType, data, err := conn.ReadMessage()
 		if err != nil {
 			// Ignore the error if the context has been closed
@@ -223,6 +340,7 @@ func (fs *FrameSocket) readPump(conn *websocket.Conn, ctx context.Context) {
 			fs.log.Warnf("Got unexpected websocket message type %d", msgType)
 			continue
 		}
+		atomic.AddUint64(&fs.bytesReceived, uint64(len(data)))
 		fs.processData(data)
 	}
 }
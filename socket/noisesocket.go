@@ -66,6 +66,31 @@ func generateIV(count uint32) []byte {
 	return iv
 }
 
+// BytesSent returns the number of bytes sent through the underlying frame socket.
+func (ns *NoiseSocket) BytesSent() uint64 {
+	return ns.fs.BytesSent()
+}
+
+// BytesReceived returns the number of bytes received through the underlying frame socket.
+func (ns *NoiseSocket) BytesReceived() uint64 {
+	return ns.fs.BytesReceived()
+}
+
+// FramesSent returns the number of frames sent through the underlying frame socket.
+func (ns *NoiseSocket) FramesSent() uint64 {
+	return ns.fs.FramesSent()
+}
+
+// FramesReceived returns the number of frames received through the underlying frame socket.
+func (ns *NoiseSocket) FramesReceived() uint64 {
+	return ns.fs.FramesReceived()
+}
+
+// Endpoint returns the websocket URL that the socket is connected to.
+func (ns *NoiseSocket) Endpoint() string {
+	return ns.fs.Endpoint()
+}
+
 func (ns *NoiseSocket) Context() context.Context {
 	return ns.fs.Context()
 }
@@ -0,0 +1,213 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// GetStatusPrivacy gets the user's status privacy settings (who to send status broadcasts to).
+//
+// There can be multiple different stored settings, the first one is always the default.
+func (cli *Client) GetStatusPrivacy() ([]types.StatusPrivacy, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Namespace: "status",
+		Type:      iqGet,
+		To:        types.ServerJID,
+		Content:   []waBinary.Node{{Tag: "privacy"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	privacyNode, ok := resp.GetOptionalChildByTag("privacy")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "privacy", In: "response to status privacy request"}
+	}
+	return parseStatusPrivacy(&privacyNode)
+}
+
+func parseStatusPrivacy(privacyNode *waBinary.Node) ([]types.StatusPrivacy, error) {
+	var outputs []types.StatusPrivacy
+	for _, list := range privacyNode.GetChildren() {
+		if list.Tag != "list" {
+			continue
+		}
+		ag := list.AttrGetter()
+		var out types.StatusPrivacy
+		out.IsDefault = ag.OptionalBool("default")
+		out.Type = types.StatusPrivacyType(ag.String("type"))
+		children := list.GetChildren()
+		if len(children) > 0 {
+			out.List = make([]types.JID, 0, len(children))
+			for _, child := range children {
+				jid, ok := child.Attrs["jid"].(types.JID)
+				if child.Tag == "user" && ok {
+					out.List = append(out.List, jid)
+				}
+			}
+		}
+		if !ag.OK() {
+			return nil, fmt.Errorf("failed to parse status privacy list: %w", ag.Error())
+		}
+		if out.IsDefault {
+			// Move the default setting to the front
+			outputs = append([]types.StatusPrivacy{out}, outputs...)
+		} else {
+			outputs = append(outputs, out)
+		}
+	}
+	return outputs, nil
+}
+
+// GetStatusRecipients returns the users who will receive status broadcasts according to the default
+// status privacy setting. The users are taken from the contact store, so the list is only complete
+// after the contact list has been synced from the phone.
+func (cli *Client) GetStatusRecipients() ([]types.JID, error) {
+	privacy, err := cli.GetStatusPrivacy()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status privacy settings: %w", err)
+	}
+	contacts, err := cli.Store.Contacts.GetAllContacts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact list: %w", err)
+	}
+	var setting types.StatusPrivacy
+	if len(privacy) > 0 {
+		setting = privacy[0]
+	} else {
+		setting.Type = types.StatusPrivacyTypeContacts
+	}
+	return filterStatusRecipients(setting, contacts), nil
+}
+
+// filterStatusRecipients returns the contacts who are allowed to see statuses with the given privacy setting.
+func filterStatusRecipients(setting types.StatusPrivacy, contacts map[types.JID]types.ContactInfo) []types.JID {
+	if setting.Type == types.StatusPrivacyTypeWhitelist {
+		return setting.List
+	}
+	excluded := make(map[types.JID]struct{})
+	if setting.Type == types.StatusPrivacyTypeBlacklist {
+		for _, jid := range setting.List {
+			excluded[jid] = struct{}{}
+		}
+	}
+	recipients := make([]types.JID, 0, len(contacts))
+	for jid, contact := range contacts {
+		// Users who only have a push name stored aren't in the address book
+		if len(contact.FirstName) == 0 && len(contact.FullName) == 0 {
+			continue
+		} else if _, isExcluded := excluded[jid]; !isExcluded {
+			recipients = append(recipients, jid)
+		}
+	}
+	return recipients
+}
+
+// SendStatus posts the given message as a status (story) to the given recipients.
+// If recipients is nil, they're derived from the status privacy settings using GetStatusRecipients.
+//
+// Sending a message to types.StatusBroadcastJID with SendMessage is equivalent to calling this with nil recipients.
+// The message can be built with BuildTextStatus, BuildImage or BuildVideo.
+func (cli *Client) SendStatus(ctx context.Context, message *waProto.Message, recipients []types.JID) (SendResponse, error) {
+	return cli.sendMessage(ctx, types.StatusBroadcastJID, "", message, SendRequestExtra{}, recipients)
+}
+
+func (cli *Client) prepareStatusMessage(ctx context.Context, id types.MessageID, message *waProto.Message, plaintext []byte, recipients []types.JID, timings *MessageDebugTimings) (*waBinary.Node, error) {
+	ownID := cli.Store.ID
+	if ownID == nil {
+		return nil, ErrNotLoggedIn
+	}
+	if recipients == nil {
+		start := time.Now()
+		var err error
+		recipients, err = cli.GetStatusRecipients()
+		if err != nil {
+			return nil, err
+		}
+		timings.GetParticipants = time.Since(start)
+	}
+	participants := make([]types.JID, 0, len(recipients)+1)
+	seen := make(map[types.JID]struct{}, len(recipients)+1)
+	for _, jid := range recipients {
+		jid = jid.ToNonAD()
+		if _, alreadySeen := seen[jid]; !alreadySeen && jid.Server == types.DefaultUserServer && jid.User != ownID.User {
+			seen[jid] = struct{}{}
+			participants = append(participants, jid)
+		}
+	}
+	if len(participants) == 0 {
+		return nil, ErrNoStatusRecipients
+	}
+	// Include our own other devices so that they also see the status
+	participants = append(participants, ownID.ToNonAD())
+	return cli.prepareSenderKeyMessage(ctx, types.StatusBroadcastJID, id, message, plaintext, participants, false, timings)
+}
+
+// TextStatusOptions contains optional styling for text statuses built with BuildTextStatus.
+type TextStatusOptions struct {
+	// The background and text colors as ARGB values, e.g. 0xFF1E88E5. Zero values use the defaults of the official clients.
+	BackgroundColor uint32
+	TextColor       uint32
+	Font            waProto.ExtendedTextMessage_ExtendedTextMessageFontType
+}
+
+// Default colors for text statuses.
+const (
+	DefaultTextStatusBackground uint32 = 0xFF7ACBA5
+	DefaultTextStatusColor      uint32 = 0xFFFFFFFF
+)
+
+// BuildTextStatus builds a text status message with the given colors and font.
+// The built message can be sent using Client.SendStatus.
+func BuildTextStatus(text string, opts TextStatusOptions) *waProto.Message {
+	if opts.BackgroundColor == 0 {
+		opts.BackgroundColor = DefaultTextStatusBackground
+	}
+	if opts.TextColor == 0 {
+		opts.TextColor = DefaultTextStatusColor
+	}
+	return &waProto.Message{ExtendedTextMessage: &waProto.ExtendedTextMessage{
+		Text:           proto.String(text),
+		BackgroundArgb: proto.Uint32(opts.BackgroundColor),
+		TextArgb:       proto.Uint32(opts.TextColor),
+		Font:           opts.Font.Enum(),
+	}}
+}
+
+// SendTextStatus posts a text status to the given recipients. If recipients is nil, they're derived from the
+// status privacy settings.
+func (cli *Client) SendTextStatus(ctx context.Context, text string, opts TextStatusOptions, recipients []types.JID) (SendResponse, error) {
+	return cli.SendStatus(ctx, BuildTextStatus(text, opts), recipients)
+}
+
+// SendImageStatus uploads the given image and posts it as a status to the given recipients.
+// If recipients is nil, they're derived from the status privacy settings.
+func (cli *Client) SendImageStatus(ctx context.Context, data []byte, caption string, recipients []types.JID) (SendResponse, error) {
+	msg, err := cli.BuildImage(ctx, data, caption)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendStatus(ctx, msg, recipients)
+}
+
+// SendVideoStatus uploads the given video and posts it as a status to the given recipients.
+// If recipients is nil, they're derived from the status privacy settings.
+func (cli *Client) SendVideoStatus(ctx context.Context, data []byte, caption string, recipients []types.JID) (SendResponse, error) {
+	msg, err := cli.BuildVideo(ctx, data, caption)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendStatus(ctx, msg, recipients)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"sort"
+	"testing"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestParseStatusPrivacy(t *testing.T) {
+	alice := types.NewJID("1111", types.DefaultUserServer)
+	node := &waBinary.Node{Tag: "privacy", Content: []waBinary.Node{
+		{Tag: "list", Attrs: waBinary.Attrs{"type": "whitelist"}, Content: []waBinary.Node{
+			{Tag: "user", Attrs: waBinary.Attrs{"jid": alice}},
+		}},
+		{Tag: "list", Attrs: waBinary.Attrs{"type": "contacts", "default": "true"}},
+	}}
+	privacy, err := parseStatusPrivacy(node)
+	if err != nil {
+		t.Fatalf("Failed to parse status privacy: %v", err)
+	}
+	if len(privacy) != 2 {
+		t.Fatalf("Expected 2 lists, got %d", len(privacy))
+	}
+	if !privacy[0].IsDefault || privacy[0].Type != types.StatusPrivacyTypeContacts {
+		t.Errorf("Expected default contacts list first, got %+v", privacy[0])
+	}
+	if privacy[1].Type != types.StatusPrivacyTypeWhitelist || len(privacy[1].List) != 1 || privacy[1].List[0] != alice {
+		t.Errorf("Unexpected whitelist %+v", privacy[1])
+	}
+}
+
+func TestFilterStatusRecipients(t *testing.T) {
+	alice := types.NewJID("1111", types.DefaultUserServer)
+	bob := types.NewJID("2222", types.DefaultUserServer)
+	stranger := types.NewJID("3333", types.DefaultUserServer)
+	contacts := map[types.JID]types.ContactInfo{
+		alice:    {Found: true, FullName: "Alice"},
+		bob:      {Found: true, FirstName: "Bob"},
+		stranger: {Found: true, PushName: "Stranger"},
+	}
+	sortJIDs := func(jids []types.JID) []types.JID {
+		sort.Slice(jids, func(i, j int) bool { return jids[i].User < jids[j].User })
+		return jids
+	}
+
+	all := sortJIDs(filterStatusRecipients(types.StatusPrivacy{Type: types.StatusPrivacyTypeContacts}, contacts))
+	if len(all) != 2 || all[0] != alice || all[1] != bob {
+		t.Errorf("Expected all saved contacts, got %v", all)
+	}
+	blacklisted := filterStatusRecipients(types.StatusPrivacy{Type: types.StatusPrivacyTypeBlacklist, List: []types.JID{alice}}, contacts)
+	if len(blacklisted) != 1 || blacklisted[0] != bob {
+		t.Errorf("Expected only bob with alice blacklisted, got %v", blacklisted)
+	}
+	whitelisted := filterStatusRecipients(types.StatusPrivacy{Type: types.StatusPrivacyTypeWhitelist, List: []types.JID{stranger}}, contacts)
+	if len(whitelisted) != 1 || whitelisted[0] != stranger {
+		t.Errorf("Expected only the whitelisted user, got %v", whitelisted)
+	}
+}
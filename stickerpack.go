@@ -0,0 +1,364 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+const (
+	// MaxStickerFileSize is the maximum size of a single sticker file.
+	MaxStickerFileSize = 512 * 1024
+	// StickerDimension is the required width and height of stickers.
+	StickerDimension = 512
+
+	minStickerPackSize = 3
+	maxStickerPackSize = 30
+)
+
+// WebPInfo contains the basic information parsed from a webp file header.
+type WebPInfo struct {
+	Width    int
+	Height   int
+	Animated bool
+}
+
+// ParseWebPHeader parses the dimensions and animation flag from the header of a webp file.
+func ParseWebPHeader(data []byte) (info WebPInfo, err error) {
+	if len(data) < 30 || !bytes.Equal(data[0:4], []byte("RIFF")) || !bytes.Equal(data[8:12], []byte("WEBP")) {
+		return info, ErrInvalidWebP
+	}
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// Lossy: 3 byte frame tag, 3 byte start code, then 14-bit width and height
+		if !bytes.Equal(data[23:26], []byte{0x9d, 0x01, 0x2a}) {
+			return info, ErrInvalidWebP
+		}
+		info.Width = int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff)
+		info.Height = int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff)
+	case "VP8L":
+		// Lossless: 1 byte signature, then 14-bit width-1 and height-1
+		if data[20] != 0x2f {
+			return info, ErrInvalidWebP
+		}
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		info.Width = int(bits&0x3fff) + 1
+		info.Height = int((bits>>14)&0x3fff) + 1
+	case "VP8X":
+		// Extended: 1 byte flags, 3 reserved bytes, then 24-bit canvas width-1 and height-1
+		info.Animated = data[20]&0x02 != 0
+		info.Width = int(uint32(data[24])|uint32(data[25])<<8|uint32(data[26])<<16) + 1
+		info.Height = int(uint32(data[27])|uint32(data[28])<<8|uint32(data[29])<<16) + 1
+	default:
+		return info, ErrInvalidWebP
+	}
+	return info, nil
+}
+
+// ValidateSticker checks that the given file can be sent as a WhatsApp sticker,
+// i.e. that it's a webp image of the right size.
+func ValidateSticker(data []byte) (WebPInfo, error) {
+	if len(data) > MaxStickerFileSize {
+		return WebPInfo{}, fmt.Errorf("%w (%d bytes, max %d)", ErrStickerTooLarge, len(data), MaxStickerFileSize)
+	}
+	info, err := ParseWebPHeader(data)
+	if err != nil {
+		return info, err
+	} else if info.Width != StickerDimension || info.Height != StickerDimension {
+		return info, fmt.Errorf("%w (got %dx%d)", ErrInvalidStickerDimensions, info.Width, info.Height)
+	}
+	return info, nil
+}
+
+// StickerMetadata contains the sticker pack info that is embedded in individual stickers.
+// The official clients show the pack name and publisher when opening a sticker, and use the emojis for suggestions.
+type StickerMetadata struct {
+	PackID        string // Optional, a random ID will be generated if not set.
+	PackName      string
+	PackPublisher string
+	Emojis        []string
+}
+
+type stickerMetadataJSON struct {
+	PackID        string   `json:"sticker-pack-id"`
+	PackName      string   `json:"sticker-pack-name"`
+	PackPublisher string   `json:"sticker-pack-publisher"`
+	Emojis        []string `json:"emojis,omitempty"`
+}
+
+// The EXIF tag that WhatsApp stores the sticker metadata JSON in.
+const stickerMetadataEXIFTag = 0x5741
+
+const (
+	webpFlagAlpha = 0x10
+	webpFlagEXIF  = 0x08
+)
+
+func appendWebPChunk(out []byte, fourCC string, data []byte) []byte {
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+	out = append(out, fourCC...)
+	out = append(out, size[:]...)
+	out = append(out, data...)
+	if len(data)%2 == 1 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+// makeStickerEXIF builds a little-endian TIFF structure with a single IFD entry containing the given JSON.
+func makeStickerEXIF(jsonData []byte) []byte {
+	const dataOffset = 8 + 2 + 12 + 4
+	exif := make([]byte, dataOffset, dataOffset+len(jsonData))
+	copy(exif[0:4], "II*\x00")
+	binary.LittleEndian.PutUint32(exif[4:8], 8)
+	binary.LittleEndian.PutUint16(exif[8:10], 1)
+	binary.LittleEndian.PutUint16(exif[10:12], stickerMetadataEXIFTag)
+	// Type 7 = UNDEFINED (raw bytes)
+	binary.LittleEndian.PutUint16(exif[12:14], 7)
+	binary.LittleEndian.PutUint32(exif[14:18], uint32(len(jsonData)))
+	binary.LittleEndian.PutUint32(exif[18:22], dataOffset)
+	// Bytes 22-26 are the offset of the next IFD, which is zero as there isn't one.
+	return append(exif, jsonData...)
+}
+
+// AddStickerMetadata embeds the given sticker pack info into a webp file as EXIF metadata, replacing any existing
+// EXIF data. Simple (lossy or lossless) webp files are converted to the extended format, as only it supports metadata.
+func AddStickerMetadata(data []byte, meta StickerMetadata) ([]byte, error) {
+	info, err := ParseWebPHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(meta.PackID) == 0 {
+		meta.PackID = GenerateMessageID()
+	}
+	jsonData, err := json.Marshal(&stickerMetadataJSON{
+		PackID:        meta.PackID,
+		PackName:      meta.PackName,
+		PackPublisher: meta.PackPublisher,
+		Emojis:        meta.Emojis,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sticker metadata: %w", err)
+	}
+
+	var vp8x, chunks []byte
+	if string(data[12:16]) != "VP8X" {
+		vp8x = make([]byte, 10)
+		w, h := info.Width-1, info.Height-1
+		vp8x[4], vp8x[5], vp8x[6] = byte(w), byte(w>>8), byte(w>>16)
+		vp8x[7], vp8x[8], vp8x[9] = byte(h), byte(h>>8), byte(h>>16)
+		if string(data[12:16]) == "VP8L" && binary.LittleEndian.Uint32(data[21:25])&(1<<28) != 0 {
+			vp8x[0] |= webpFlagAlpha
+		}
+	}
+	for ptr := 12; ptr < len(data); {
+		if ptr+8 > len(data) {
+			return nil, ErrInvalidWebP
+		}
+		fourCC := string(data[ptr : ptr+4])
+		size := int(binary.LittleEndian.Uint32(data[ptr+4 : ptr+8]))
+		if ptr+8+size > len(data) {
+			return nil, ErrInvalidWebP
+		}
+		chunk := data[ptr+8 : ptr+8+size]
+		ptr += 8 + size + size%2
+		switch fourCC {
+		case "VP8X":
+			vp8x = append([]byte{}, chunk...)
+		case "EXIF":
+			// Skip existing metadata, the new one is added at the end
+		default:
+			chunks = appendWebPChunk(chunks, fourCC, chunk)
+		}
+	}
+	if len(vp8x) < 10 {
+		return nil, ErrInvalidWebP
+	}
+	vp8x[0] |= webpFlagEXIF
+	out := make([]byte, 12, len(data)+len(jsonData)+64)
+	copy(out, data[0:12])
+	out = appendWebPChunk(out, "VP8X", vp8x)
+	out = append(out, chunks...)
+	out = appendWebPChunk(out, "EXIF", makeStickerEXIF(jsonData))
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
+
+// UploadSticker validates and uploads the given webp file, and returns a sticker message that can be sent as-is
+// or forwarded.
+func (cli *Client) UploadSticker(ctx context.Context, data []byte) (*waProto.StickerMessage, error) {
+	info, err := ValidateSticker(data)
+	if err != nil {
+		return nil, err
+	}
+	uploaded, err := cli.Upload(ctx, data, MediaImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload sticker: %w", err)
+	}
+	return &waProto.StickerMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String("image/webp"),
+		Width:             proto.Uint32(uint32(info.Width)),
+		Height:            proto.Uint32(uint32(info.Height)),
+		IsAnimated:        proto.Bool(info.Animated),
+	}, nil
+}
+
+// StickerPackEntry is a single sticker to include in a sticker pack built with BuildStickerPack.
+type StickerPackEntry struct {
+	Data               []byte // The webp file, which must pass ValidateSticker
+	Emojis             []string
+	AccessibilityLabel string
+}
+
+// StickerPackInfo contains the metadata of a sticker pack to build with BuildStickerPack.
+type StickerPackInfo struct {
+	ID          string // Optional, a random ID will be generated if not set.
+	Name        string
+	Publisher   string
+	Description string
+}
+
+// stickerFileName returns the file name that is used for a sticker inside a sticker pack archive.
+func stickerFileName(data []byte) string {
+	hash := sha256.Sum256(data)
+	return base64.URLEncoding.EncodeToString(hash[:]) + ".webp"
+}
+
+// makeStickerPackArchive validates the given stickers and builds the zip archive that is uploaded as the media of
+// a sticker pack message, along with the sticker list of the message.
+func makeStickerPackArchive(stickers []StickerPackEntry) ([]byte, []*waProto.StickerPackMessageSticker, error) {
+	if len(stickers) < minStickerPackSize || len(stickers) > maxStickerPackSize {
+		return nil, nil, fmt.Errorf("%w (got %d)", ErrInvalidStickerPackSize, len(stickers))
+	}
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	packStickers := make([]*waProto.StickerPackMessageSticker, len(stickers))
+	for i, item := range stickers {
+		info, err := ValidateSticker(item.Data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sticker #%d: %w", i+1, err)
+		}
+		fileName := stickerFileName(item.Data)
+		// webp files are already compressed, so they're stored as-is
+		file, err := zipWriter.CreateHeader(&zip.FileHeader{Name: fileName, Method: zip.Store})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to add sticker #%d to archive: %w", i+1, err)
+		} else if _, err = file.Write(item.Data); err != nil {
+			return nil, nil, fmt.Errorf("failed to add sticker #%d to archive: %w", i+1, err)
+		}
+		packStickers[i] = &waProto.StickerPackMessageSticker{
+			FileName:           proto.String(fileName),
+			IsAnimated:         proto.Bool(info.Animated),
+			Emojis:             item.Emojis,
+			AccessibilityLabel: proto.String(item.AccessibilityLabel),
+			Mimetype:           proto.String("image/webp"),
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to finish sticker pack archive: %w", err)
+	}
+	return buf.Bytes(), packStickers, nil
+}
+
+// BuildStickerPack builds a sticker pack message out of the given webp files.
+//
+// The stickers are put in a zip archive, which is uploaded as the media of the pack message. Each sticker in the
+// message refers to a file in the archive by its name. The built message can be sent normally using
+// Client.SendMessage, and recipients can extract the stickers with Client.DownloadStickerPack.
+func (cli *Client) BuildStickerPack(ctx context.Context, info StickerPackInfo, stickers []StickerPackEntry) (*waProto.Message, error) {
+	archive, packStickers, err := makeStickerPackArchive(stickers)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.ID) == 0 {
+		info.ID = GenerateMessageID()
+	}
+	uploaded, err := cli.Upload(ctx, archive, MediaStickerPack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload sticker pack: %w", err)
+	}
+	return &waProto.Message{
+		StickerPackMessage: &waProto.StickerPackMessage{
+			StickerPackId:     proto.String(info.ID),
+			Name:              proto.String(info.Name),
+			Publisher:         proto.String(info.Publisher),
+			PackDescription:   proto.String(info.Description),
+			Stickers:          packStickers,
+			DirectPath:        proto.String(uploaded.DirectPath),
+			MediaKey:          uploaded.MediaKey,
+			FileEncSha256:     uploaded.FileEncSHA256,
+			FileSha256:        uploaded.FileSHA256,
+			FileLength:        proto.Uint64(uploaded.FileLength),
+			MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		},
+	}, nil
+}
+
+// readStickerPackArchive extracts the stickers listed in the given pack message from a sticker pack archive.
+func readStickerPackArchive(archive []byte, pack *waProto.StickerPackMessage) (map[string][]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sticker pack archive: %w", err)
+	}
+	wanted := make(map[string]struct{}, len(pack.GetStickers()))
+	for _, sticker := range pack.GetStickers() {
+		wanted[sticker.GetFileName()] = struct{}{}
+	}
+	files := make(map[string][]byte, len(wanted))
+	for _, file := range zipReader.File {
+		if _, ok := wanted[file.Name]; !ok {
+			continue
+		} else if file.UncompressedSize64 > MaxStickerFileSize {
+			return nil, fmt.Errorf("%s: %w", file.Name, ErrStickerTooLarge)
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s in sticker pack archive: %w", file.Name, err)
+		}
+		data, err := io.ReadAll(io.LimitReader(reader, MaxStickerFileSize+1))
+		_ = reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from sticker pack archive: %w", file.Name, err)
+		} else if len(data) > MaxStickerFileSize {
+			return nil, fmt.Errorf("%s: %w", file.Name, ErrStickerTooLarge)
+		}
+		files[file.Name] = data
+	}
+	return files, nil
+}
+
+// DownloadStickerPack downloads the archive of the given sticker pack message and returns the webp files of the
+// stickers in it, keyed by the file names in the message (see events.Message.GetStickerPack).
+// Stickers that are listed in the message but missing from the archive are not included in the map.
+func (cli *Client) DownloadStickerPack(pack *waProto.StickerPackMessage) (map[string][]byte, error) {
+	archive, err := cli.Download(pack)
+	if err != nil {
+		return nil, err
+	}
+	return readStickerPackArchive(archive, pack)
+}
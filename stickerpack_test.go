@@ -0,0 +1,140 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+func makeWebPHeader(chunk string, width, height int, animated bool) []byte {
+	data := make([]byte, 64)
+	copy(data[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+	copy(data[8:12], "WEBP")
+	copy(data[12:16], chunk)
+	switch chunk {
+	case "VP8 ":
+		copy(data[23:26], []byte{0x9d, 0x01, 0x2a})
+		binary.LittleEndian.PutUint16(data[26:28], uint16(width))
+		binary.LittleEndian.PutUint16(data[28:30], uint16(height))
+	case "VP8L":
+		data[20] = 0x2f
+		binary.LittleEndian.PutUint32(data[21:25], uint32(width-1)|uint32(height-1)<<14)
+	case "VP8X":
+		if animated {
+			data[20] = 0x02
+		}
+		w, h := width-1, height-1
+		data[24], data[25], data[26] = byte(w), byte(w>>8), byte(w>>16)
+		data[27], data[28], data[29] = byte(h), byte(h>>8), byte(h>>16)
+	}
+	return data
+}
+
+func TestValidateSticker(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		err      error
+		animated bool
+	}{
+		{"lossy", makeWebPHeader("VP8 ", 512, 512, false), nil, false},
+		{"lossless", makeWebPHeader("VP8L", 512, 512, false), nil, false},
+		{"animated", makeWebPHeader("VP8X", 512, 512, true), nil, true},
+		{"wrong size", makeWebPHeader("VP8L", 512, 256, false), ErrInvalidStickerDimensions, false},
+		{"png", append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...), ErrInvalidWebP, false},
+		{"truncated", makeWebPHeader("VP8 ", 512, 512, false)[:20], ErrInvalidWebP, false},
+		{"too large", append(makeWebPHeader("VP8 ", 512, 512, false), make([]byte, MaxStickerFileSize)...), ErrStickerTooLarge, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := ValidateSticker(test.data)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Expected error %v, got %v", test.err, err)
+			} else if err == nil && info.Animated != test.animated {
+				t.Errorf("Expected animated=%t, got %t", test.animated, info.Animated)
+			}
+		})
+	}
+}
+
+func TestAddStickerMetadata(t *testing.T) {
+	for _, chunk := range []string{"VP8 ", "VP8L", "VP8X"} {
+		t.Run(chunk, func(t *testing.T) {
+			input := makeWebPHeader(chunk, 512, 512, chunk == "VP8X")
+			// The chunk size isn't set by makeWebPHeader
+			binary.LittleEndian.PutUint32(input[16:20], uint32(len(input)-20))
+			output, err := AddStickerMetadata(input, StickerMetadata{PackName: "Pack", PackPublisher: "Me"})
+			if err != nil {
+				t.Fatalf("Failed to add metadata: %v", err)
+			}
+			info, err := ValidateSticker(output)
+			if err != nil {
+				t.Fatalf("Sticker with metadata isn't valid: %v", err)
+			} else if info.Animated != (chunk == "VP8X") {
+				t.Errorf("Animation flag changed")
+			} else if int(binary.LittleEndian.Uint32(output[4:8])) != len(output)-8 {
+				t.Errorf("Wrong RIFF size")
+			} else if output[20]&webpFlagEXIF == 0 {
+				t.Errorf("EXIF flag not set")
+			}
+			exifStart := bytes.LastIndex(output, []byte("EXIF"))
+			if exifStart < 0 || !bytes.Contains(output[exifStart:], []byte(`"sticker-pack-name":"Pack"`)) {
+				t.Fatalf("Metadata not found in output")
+			}
+			again, err := AddStickerMetadata(output, StickerMetadata{PackName: "Other"})
+			if err != nil {
+				t.Fatalf("Failed to replace metadata: %v", err)
+			} else if bytes.Count(again, []byte("EXIF")) != 1 || bytes.Contains(again, []byte(`"Pack"`)) {
+				t.Errorf("Old metadata wasn't replaced")
+			}
+		})
+	}
+}
+
+func TestStickerPackArchive(t *testing.T) {
+	entries := make([]StickerPackEntry, 3)
+	for i := range entries {
+		data := makeWebPHeader("VP8X", 512, 512, i == 0)
+		data[len(data)-1] = byte(i)
+		entries[i] = StickerPackEntry{Data: data, Emojis: []string{"\U0001F600"}}
+	}
+	archive, packStickers, err := makeStickerPackArchive(entries)
+	if err != nil {
+		t.Fatalf("Failed to build sticker pack archive: %v", err)
+	} else if len(packStickers) != len(entries) {
+		t.Fatalf("Expected %d stickers in message, got %d", len(entries), len(packStickers))
+	} else if !packStickers[0].GetIsAnimated() || packStickers[1].GetIsAnimated() {
+		t.Errorf("Animation flags weren't read from the stickers")
+	}
+	files, err := readStickerPackArchive(archive, &waProto.StickerPackMessage{Stickers: packStickers})
+	if err != nil {
+		t.Fatalf("Failed to read sticker pack archive: %v", err)
+	}
+	for i, sticker := range packStickers {
+		if sticker.GetFileName() != stickerFileName(entries[i].Data) {
+			t.Errorf("Unexpected file name %s for sticker #%d", sticker.GetFileName(), i+1)
+		} else if !bytes.Equal(files[sticker.GetFileName()], entries[i].Data) {
+			t.Errorf("Sticker #%d didn't round-trip through the archive", i+1)
+		}
+	}
+
+	_, _, err = makeStickerPackArchive(entries[:2])
+	if !errors.Is(err, ErrInvalidStickerPackSize) {
+		t.Errorf("Expected ErrInvalidStickerPackSize for small pack, got %v", err)
+	}
+	entries[1].Data = makeWebPHeader("VP8L", 512, 256, false)
+	_, _, err = makeStickerPackArchive(entries)
+	if !errors.Is(err, ErrInvalidStickerDimensions) {
+		t.Errorf("Expected ErrInvalidStickerDimensions for invalid sticker, got %v", err)
+	}
+}
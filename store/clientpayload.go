@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"google.golang.org/protobuf/proto"
 
@@ -20,19 +21,84 @@ import (
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 )
 
+// WAVersionContainer is a WhatsApp web client version number.
+type WAVersionContainer [3]uint32
+
+// ParseVersion parses a version string (three dot-separated numbers) into a WAVersionContainer.
+func ParseVersion(version string) (parsed WAVersionContainer, err error) {
+	var part1, part2, part3 int
+	if parts := strings.Split(version, "."); len(parts) != 3 {
+		err = fmt.Errorf("'%s' doesn't contain three dot-separated parts", version)
+	} else if part1, err = strconv.Atoi(parts[0]); err != nil {
+		err = fmt.Errorf("first part of '%s' is not a number: %w", version, err)
+	} else if part2, err = strconv.Atoi(parts[1]); err != nil {
+		err = fmt.Errorf("second part of '%s' is not a number: %w", version, err)
+	} else if part3, err = strconv.Atoi(parts[2]); err != nil {
+		err = fmt.Errorf("third part of '%s' is not a number: %w", version, err)
+	} else {
+		parsed = WAVersionContainer{uint32(part1), uint32(part2), uint32(part3)}
+	}
+	return
+}
+
+// String returns the version as a dot-separated string.
+func (vc WAVersionContainer) String() string {
+	parts := make([]string, len(vc))
+	for i, part := range vc {
+		parts[i] = strconv.Itoa(int(part))
+	}
+	return strings.Join(parts, ".")
+}
+
+// Hash returns the md5 hash of the String representation of this version.
+func (vc WAVersionContainer) Hash() [16]byte {
+	return md5.Sum([]byte(vc.String()))
+}
+
+// LessThan returns true if this version is older than the given version.
+func (vc WAVersionContainer) LessThan(other WAVersionContainer) bool {
+	for i := range vc {
+		if vc[i] != other[i] {
+			return vc[i] < other[i]
+		}
+	}
+	return false
+}
+
 // waVersion is the WhatsApp web client version
-var waVersion = []uint32{2, 2147, 14}
+var waVersion = WAVersionContainer{2, 2147, 14}
 
 // waVersionHash is the md5 hash of a dot-separated waVersion
-var waVersionHash [16]byte
+var waVersionHash = waVersion.Hash()
 
-func init() {
-	waVersionParts := make([]string, len(waVersion))
-	for i, part := range waVersion {
-		waVersionParts[i] = strconv.Itoa(int(part))
+// payloadLock protects waVersion, waVersionHash and the fields of BaseClientPayload and CompanionProps that are changed by setters
+var payloadLock sync.RWMutex
+
+// GetWAVersion returns the WhatsApp web client version that is sent to the server when connecting.
+func GetWAVersion() WAVersionContainer {
+	payloadLock.RLock()
+	defer payloadLock.RUnlock()
+	return waVersion
+}
+
+// SetWAVersion sets the WhatsApp web client version that is sent to the server when connecting.
+// The change only affects new connections.
+//
+// The version should usually be updated by updating whatsmeow, but this can be used to fix 405 connect failures
+// (events.ClientOutdated) without waiting for a release. See also whatsmeow.GetLatestVersion.
+func SetWAVersion(version WAVersionContainer) {
+	if version == (WAVersionContainer{}) {
+		return
+	}
+	payloadLock.Lock()
+	waVersion = version
+	waVersionHash = version.Hash()
+	BaseClientPayload.UserAgent.AppVersion = &waProto.AppVersion{
+		Primary:   proto.Uint32(version[0]),
+		Secondary: proto.Uint32(version[1]),
+		Tertiary:  proto.Uint32(version[2]),
 	}
-	waVersionString := strings.Join(waVersionParts, ".")
-	waVersionHash = md5.Sum([]byte(waVersionString))
+	payloadLock.Unlock()
 }
 
 var BaseClientPayload = &waProto.ClientPayload{
@@ -40,9 +106,9 @@ var BaseClientPayload = &waProto.ClientPayload{
 		Platform:       waProto.UserAgent_WEB.Enum(),
 		ReleaseChannel: waProto.UserAgent_RELEASE.Enum(),
 		AppVersion: &waProto.AppVersion{
-			Primary:   &waVersion[0],
-			Secondary: &waVersion[1],
-			Tertiary:  &waVersion[2],
+			Primary:   proto.Uint32(waVersion[0]),
+			Secondary: proto.Uint32(waVersion[1]),
+			Tertiary:  proto.Uint32(waVersion[2]),
 		},
 		Mcc:                         proto.String("000"),
 		Mnc:                         proto.String("000"),
@@ -71,7 +137,10 @@ var CompanionProps = &waProto.CompanionProps{
 	RequireFullSync: proto.Bool(false),
 }
 
+// SetOSInfo sets the OS name and version that are shown on the phone in the linked devices list.
 func SetOSInfo(name string, version [3]uint32) {
+	payloadLock.Lock()
+	defer payloadLock.Unlock()
 	CompanionProps.Os = &name
 	CompanionProps.Version.Primary = &version[0]
 	CompanionProps.Version.Secondary = &version[1]
@@ -80,13 +149,42 @@ func SetOSInfo(name string, version [3]uint32) {
 	BaseClientPayload.UserAgent.OsBuildNumber = BaseClientPayload.UserAgent.OsVersion
 }
 
+// SetPlatformType sets the platform type (e.g. Chrome, Firefox or Desktop) that is used for the icon and name
+// of the device in the linked devices list on the phone.
+func SetPlatformType(platformType waProto.CompanionProps_CompanionPropsPlatformType) {
+	payloadLock.Lock()
+	CompanionProps.PlatformType = platformType.Enum()
+	payloadLock.Unlock()
+}
+
+// SetRequireFullSync sets whether the phone should send the full history when pairing.
+func SetRequireFullSync(requireFullSync bool) {
+	payloadLock.Lock()
+	CompanionProps.RequireFullSync = proto.Bool(requireFullSync)
+	payloadLock.Unlock()
+}
+
+// GetCompanionProps returns the companion props that will be sent when pairing this device.
+// If the device doesn't have its own props, a copy of the global CompanionProps is returned.
+func (device *Device) GetCompanionProps() *waProto.CompanionProps {
+	if device.Props != nil {
+		return device.Props
+	}
+	payloadLock.RLock()
+	defer payloadLock.RUnlock()
+	return proto.Clone(CompanionProps).(*waProto.CompanionProps)
+}
+
 func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
+	payloadLock.RLock()
 	payload := proto.Clone(BaseClientPayload).(*waProto.ClientPayload)
+	versionHash := waVersionHash
+	payloadLock.RUnlock()
 	regID := make([]byte, 4)
 	binary.BigEndian.PutUint32(regID, device.RegistrationID)
 	preKeyID := make([]byte, 4)
 	binary.BigEndian.PutUint32(preKeyID, device.SignedPreKey.KeyID)
-	companionProps, _ := proto.Marshal(CompanionProps)
+	companionProps, _ := proto.Marshal(device.GetCompanionProps())
 	payload.RegData = &waProto.CompanionRegData{
 		ERegid:         regID,
 		EKeytype:       []byte{ecc.DjbType},
@@ -94,7 +192,7 @@ func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
 		ESkeyId:        preKeyID[1:],
 		ESkeyVal:       device.SignedPreKey.Pub[:],
 		ESkeySig:       device.SignedPreKey.Signature[:],
-		BuildHash:      waVersionHash[:],
+		BuildHash:      versionHash[:],
 		CompanionProps: companionProps,
 	}
 	payload.Passive = proto.Bool(false)
@@ -102,7 +200,9 @@ func (device *Device) getRegistrationPayload() *waProto.ClientPayload {
 }
 
 func (device *Device) getLoginPayload() *waProto.ClientPayload {
+	payloadLock.RLock()
 	payload := proto.Clone(BaseClientPayload).(*waProto.ClientPayload)
+	payloadLock.RUnlock()
 	payload.Username = proto.Uint64(device.ID.UserInt())
 	payload.Device = proto.Uint32(uint32(device.ID.Device))
 	payload.Passive = proto.Bool(true)
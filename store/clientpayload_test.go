@@ -0,0 +1,91 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package store
+
+import (
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// restorePayloadGlobals restores the global payload and props after the test.
+func restorePayloadGlobals(t *testing.T) {
+	originalProps := proto.Clone(CompanionProps).(*waProto.CompanionProps)
+	originalPayload := proto.Clone(BaseClientPayload).(*waProto.ClientPayload)
+	t.Cleanup(func() {
+		payloadLock.Lock()
+		CompanionProps = originalProps
+		BaseClientPayload = originalPayload
+		payloadLock.Unlock()
+	})
+}
+
+func TestCompanionPropsSetters(t *testing.T) {
+	restorePayloadGlobals(t)
+	SetPlatformType(waProto.CompanionProps_FIREFOX)
+	SetRequireFullSync(true)
+
+	device := &Device{IdentityKey: keys.NewKeyPair()}
+	device.SignedPreKey = device.IdentityKey.CreateSignedPreKey(1)
+	var props waProto.CompanionProps
+	if err := proto.Unmarshal(device.GetClientPayload().GetRegData().GetCompanionProps(), &props); err != nil {
+		t.Fatalf("Failed to unmarshal companion props: %v", err)
+	} else if props.GetPlatformType() != waProto.CompanionProps_FIREFOX || !props.GetRequireFullSync() {
+		t.Errorf("Setters weren't applied to the registration payload: %v", &props)
+	}
+
+	// The returned props must be a copy, so that callers can't race with the setters
+	returned := device.GetCompanionProps()
+	SetRequireFullSync(false)
+	if !returned.GetRequireFullSync() {
+		t.Errorf("GetCompanionProps returned the global props instead of a copy")
+	}
+
+	device.Props = &waProto.CompanionProps{Os: proto.String("custom")}
+	if device.GetCompanionProps() != device.Props {
+		t.Errorf("GetCompanionProps didn't return the device's own props")
+	}
+}
+
+// TestCompanionPropsConcurrentAccess is mostly useful with -race.
+func TestCompanionPropsConcurrentAccess(t *testing.T) {
+	restorePayloadGlobals(t)
+	device := &Device{IdentityKey: keys.NewKeyPair()}
+	device.SignedPreKey = device.IdentityKey.CreateSignedPreKey(1)
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetPlatformType(waProto.CompanionProps_CompanionPropsPlatformType(i % 10))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetRequireFullSync(i%2 == 0)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			SetOSInfo("test", [3]uint32{1, 2, uint32(i)})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = device.GetClientPayload()
+			_ = device.GetCompanionProps().GetPlatformType()
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package compat contains converters for importing sessions from other WhatsApp multidevice libraries.
+//
+// The long-term keys and account info are converted into a store.Device, and the prekeys and app state sync keys
+// can be imported into the device's stores afterwards. Signal sessions and sender keys aren't imported, they will be
+// re-established through retry receipts.
+//
+// whatsapp-web.js is not supported: it runs the official web client in a browser, so its sessions are browser
+// profiles (or legacy non-multidevice tokens), which don't contain the keys in any portable format.
+//
+// To store a converted device, use the ImportDevice method of sqlstore.Container, then call ImportKeys:
+//
+//	state, err := compat.ReadBaileysAuthStateDir("auth_info_baileys")
+//	device, err := state.Creds.ToDevice()
+//	err = container.ImportDevice(device)
+//	err = state.ImportKeys(device)
+package compat
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+var (
+	ErrBaileysNotLoggedIn = errors.New("baileys credentials don't contain a logged-in account")
+	ErrInvalidBaileysJID  = errors.New("invalid JID in baileys credentials")
+	ErrInvalidKeyLength   = errors.New("invalid key length in baileys credentials")
+
+	ErrPreKeyImportNotSupported = errors.New("the device's prekey store doesn't support importing prekeys")
+)
+
+// BaileysBuffer is a byte array in the format used by Baileys' BufferJSON helper, i.e. {"type": "Buffer", "data": ...}.
+// The data can be either base64 (newer versions) or an array of numbers (older versions). Plain base64 strings are
+// also accepted, as some fields (like advSecretKey) are stored that way.
+type BaileysBuffer []byte
+
+func (buf *BaileysBuffer) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*buf, err = base64.StdEncoding.DecodeString(str)
+		return err
+	}
+	var wrapped struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal(data, &wrapped)
+	if err != nil {
+		return err
+	} else if wrapped.Type != "Buffer" {
+		return fmt.Errorf("unexpected buffer type %q", wrapped.Type)
+	}
+	if err = json.Unmarshal(wrapped.Data, &str); err == nil {
+		*buf, err = base64.StdEncoding.DecodeString(str)
+		return err
+	}
+	var ints []int
+	err = json.Unmarshal(wrapped.Data, &ints)
+	if err != nil {
+		return err
+	}
+	*buf = make([]byte, len(ints))
+	for i, val := range ints {
+		if val < 0 || val > 255 {
+			return fmt.Errorf("invalid byte value %d in buffer", val)
+		}
+		(*buf)[i] = byte(val)
+	}
+	return nil
+}
+
+// BaileysKeyPair is a Curve25519 key pair in Baileys' credentials.
+type BaileysKeyPair struct {
+	Private BaileysBuffer `json:"private"`
+	Public  BaileysBuffer `json:"public"`
+}
+
+// BaileysCreds contains the fields of Baileys' creds.json (AuthenticationCreds) that are needed to log in.
+type BaileysCreds struct {
+	NoiseKey          BaileysKeyPair `json:"noiseKey"`
+	SignedIdentityKey BaileysKeyPair `json:"signedIdentityKey"`
+	SignedPreKey      struct {
+		KeyPair   BaileysKeyPair `json:"keyPair"`
+		Signature BaileysBuffer  `json:"signature"`
+		KeyID     uint32         `json:"keyId"`
+	} `json:"signedPreKey"`
+	RegistrationID uint32        `json:"registrationId"`
+	AdvSecretKey   BaileysBuffer `json:"advSecretKey"`
+	Me             *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"me"`
+	Account *struct {
+		Details             BaileysBuffer `json:"details"`
+		AccountSignatureKey BaileysBuffer `json:"accountSignatureKey"`
+		AccountSignature    BaileysBuffer `json:"accountSignature"`
+		DeviceSignature     BaileysBuffer `json:"deviceSignature"`
+	} `json:"account"`
+	Platform string `json:"platform"`
+
+	FirstUnuploadedPreKeyID uint32 `json:"firstUnuploadedPreKeyId"`
+}
+
+// BaileysLong is a 64-bit integer in Baileys' JSON files. Depending on how it was serialized, it may be stored as
+// a number, a string or a Long.js object ({"low": ..., "high": ..., "unsigned": ...}).
+type BaileysLong int64
+
+func (val *BaileysLong) UnmarshalJSON(data []byte) error {
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err == nil {
+		parsed, err := num.Int64()
+		*val = BaileysLong(parsed)
+		return err
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		parsed, err := strconv.ParseInt(str, 10, 64)
+		*val = BaileysLong(parsed)
+		return err
+	}
+	var long struct {
+		Low  int32 `json:"low"`
+		High int32 `json:"high"`
+	}
+	err := json.Unmarshal(data, &long)
+	if err != nil {
+		return err
+	}
+	*val = BaileysLong(int64(long.High)<<32 | int64(uint32(long.Low)))
+	return nil
+}
+
+// BaileysAppStateSyncKey is an app state sync key in Baileys' key store (AppStateSyncKeyData).
+type BaileysAppStateSyncKey struct {
+	KeyData     BaileysBuffer `json:"keyData"`
+	Fingerprint struct {
+		RawID         uint32   `json:"rawId"`
+		CurrentIndex  uint32   `json:"currentIndex"`
+		DeviceIndexes []uint32 `json:"deviceIndexes"`
+	} `json:"fingerprint"`
+	Timestamp BaileysLong `json:"timestamp"`
+}
+
+// BaileysKeys contains the parts of Baileys' key store that whatsmeow can import.
+//
+// Prekeys are keyed by their numeric ID and app state sync keys by their base64-encoded key ID.
+type BaileysKeys struct {
+	PreKeys          map[string]BaileysKeyPair         `json:"pre-key"`
+	AppStateSyncKeys map[string]BaileysAppStateSyncKey `json:"app-state-sync-key"`
+}
+
+// BaileysAuthState is a Baileys auth state, i.e. the credentials and the key store.
+type BaileysAuthState struct {
+	Creds BaileysCreds `json:"creds"`
+	Keys  BaileysKeys  `json:"keys"`
+}
+
+// parseBaileysJID parses a JID in the user:device@s.whatsapp.net format used by Baileys.
+func parseBaileysJID(jid string) (types.JID, error) {
+	parts := strings.Split(jid, "@")
+	if len(parts) != 2 || parts[1] != types.DefaultUserServer {
+		return types.EmptyJID, fmt.Errorf("%w %q", ErrInvalidBaileysJID, jid)
+	}
+	user := parts[0]
+	var device uint8
+	if colonIndex := strings.IndexRune(user, ':'); colonIndex >= 0 {
+		deviceInt, err := strconv.ParseUint(user[colonIndex+1:], 10, 8)
+		if err != nil {
+			return types.EmptyJID, fmt.Errorf("%w %q: %v", ErrInvalidBaileysJID, jid, err)
+		}
+		user, device = user[:colonIndex], uint8(deviceInt)
+	}
+	// Some versions include the agent as user.agent:device
+	if dotIndex := strings.IndexRune(user, '.'); dotIndex >= 0 {
+		user = user[:dotIndex]
+	}
+	return types.NewADJID(user, 0, device), nil
+}
+
+func toKey(buf BaileysBuffer, name string) (*[32]byte, error) {
+	if len(buf) != 32 {
+		return nil, fmt.Errorf("%w: %s must be 32 bytes, got %d", ErrInvalidKeyLength, name, len(buf))
+	}
+	return (*[32]byte)(buf), nil
+}
+
+// ToDevice converts the Baileys credentials into a whatsmeow device.
+//
+// The returned device isn't connected to any database, use sqlstore.Container.ImportDevice to store it.
+func (creds *BaileysCreds) ToDevice() (*store.Device, error) {
+	if creds.Me == nil || creds.Account == nil {
+		return nil, ErrBaileysNotLoggedIn
+	}
+	jid, err := parseBaileysJID(creds.Me.ID)
+	if err != nil {
+		return nil, err
+	}
+	noiseKey, err := toKey(creds.NoiseKey.Private, "noiseKey")
+	if err != nil {
+		return nil, err
+	}
+	identityKey, err := toKey(creds.SignedIdentityKey.Private, "signedIdentityKey")
+	if err != nil {
+		return nil, err
+	}
+	preKey, err := toKey(creds.SignedPreKey.KeyPair.Private, "signedPreKey")
+	if err != nil {
+		return nil, err
+	} else if len(creds.SignedPreKey.Signature) != 64 {
+		return nil, fmt.Errorf("%w: signedPreKey signature must be 64 bytes, got %d", ErrInvalidKeyLength, len(creds.SignedPreKey.Signature))
+	} else if len(creds.AdvSecretKey) != 32 {
+		return nil, fmt.Errorf("%w: advSecretKey must be 32 bytes, got %d", ErrInvalidKeyLength, len(creds.AdvSecretKey))
+	}
+	return &store.Device{
+		ID:             &jid,
+		RegistrationID: creds.RegistrationID,
+		NoiseKey:       keys.NewKeyPairFromPrivateKey(*noiseKey),
+		IdentityKey:    keys.NewKeyPairFromPrivateKey(*identityKey),
+		SignedPreKey: &keys.PreKey{
+			KeyPair:   *keys.NewKeyPairFromPrivateKey(*preKey),
+			KeyID:     creds.SignedPreKey.KeyID,
+			Signature: (*[64]byte)(creds.SignedPreKey.Signature),
+		},
+		AdvSecretKey: creds.AdvSecretKey,
+		// The account signature key is left out like in pair.go, it's only needed for verifying the pairing.
+		Account: &waProto.ADVSignedDeviceIdentity{
+			Details:          creds.Account.Details,
+			AccountSignature: creds.Account.AccountSignature,
+			DeviceSignature:  creds.Account.DeviceSignature,
+		},
+		Platform: creds.Platform,
+		PushName: creds.Me.Name,
+	}, nil
+}
+
+// ParseBaileysCreds parses the contents of a Baileys creds.json file (from useMultiFileAuthState)
+// or the creds field of a single-file auth state into a whatsmeow device.
+func ParseBaileysCreds(data []byte) (*store.Device, error) {
+	var creds BaileysCreds
+	err := json.Unmarshal(data, &creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baileys credentials: %w", err)
+	}
+	return creds.ToDevice()
+}
+
+// ParseBaileysAuthState parses a Baileys single-file auth state ({"creds": ..., "keys": ...}).
+//
+// Use the ToDevice method of the creds to get a device, and ImportKeys to import the keys after storing it.
+func ParseBaileysAuthState(data []byte) (*BaileysAuthState, error) {
+	var state struct {
+		Creds json.RawMessage `json:"creds"`
+		Keys  BaileysKeys     `json:"keys"`
+	}
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baileys auth state: %w", err)
+	} else if len(state.Creds) == 0 {
+		return nil, fmt.Errorf("failed to parse baileys auth state: creds field missing")
+	}
+	parsed := &BaileysAuthState{Keys: state.Keys}
+	err = json.Unmarshal(state.Creds, &parsed.Creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baileys credentials: %w", err)
+	}
+	return parsed, nil
+}
+
+// ReadBaileysAuthStateDir reads a Baileys auth state directory created by useMultiFileAuthState.
+//
+// Files other than creds.json, pre-key-*.json and app-state-sync-key-*.json are ignored.
+func ReadBaileysAuthStateDir(dir string) (*BaileysAuthState, error) {
+	state := &BaileysAuthState{
+		Keys: BaileysKeys{
+			PreKeys:          make(map[string]BaileysKeyPair),
+			AppStateSyncKeys: make(map[string]BaileysAppStateSyncKey),
+		},
+	}
+	err := readBaileysFile(filepath.Join(dir, "creds.json"), &state.Creds)
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list baileys auth state directory: %w", err)
+	}
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		// useMultiFileAuthState replaces slashes in the key ID with __ to make the file name valid.
+		id := strings.ReplaceAll(strings.TrimSuffix(name, ".json"), "__", "/")
+		path := filepath.Join(dir, name)
+		if strings.HasPrefix(id, "pre-key-") {
+			var key BaileysKeyPair
+			if err = readBaileysFile(path, &key); err != nil {
+				return nil, err
+			}
+			state.Keys.PreKeys[strings.TrimPrefix(id, "pre-key-")] = key
+		} else if strings.HasPrefix(id, "app-state-sync-key-") {
+			var key BaileysAppStateSyncKey
+			if err = readBaileysFile(path, &key); err != nil {
+				return nil, err
+			}
+			state.Keys.AppStateSyncKeys[strings.TrimPrefix(id, "app-state-sync-key-")] = key
+		}
+	}
+	return state, nil
+}
+
+func readBaileysFile(path string, into interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	err = json.Unmarshal(data, into)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// ImportKeys stores the prekeys and app state sync keys of the auth state in the given device's stores.
+//
+// The device must already be stored (e.g. with sqlstore.Container.ImportDevice), and its prekey store must implement
+// store.PreKeyImportStore if there are any prekeys. Prekeys below the creds' firstUnuploadedPreKeyId are marked
+// as uploaded, so whatsmeow will keep generating new prekeys after them.
+func (state *BaileysAuthState) ImportKeys(device *store.Device) error {
+	var uploaded, unuploaded []*keys.PreKey
+	for id, pair := range state.Keys.PreKeys {
+		keyID, err := strconv.ParseUint(id, 10, 24)
+		if err != nil {
+			return fmt.Errorf("invalid prekey ID %q: %w", id, err)
+		}
+		priv, err := toKey(pair.Private, "pre-key-"+id)
+		if err != nil {
+			return err
+		}
+		key := &keys.PreKey{
+			KeyPair: *keys.NewKeyPairFromPrivateKey(*priv),
+			KeyID:   uint32(keyID),
+		}
+		// Older auth states don't have firstUnuploadedPreKeyId, assume all keys were uploaded in that case.
+		if state.Creds.FirstUnuploadedPreKeyID == 0 || key.KeyID < state.Creds.FirstUnuploadedPreKeyID {
+			uploaded = append(uploaded, key)
+		} else {
+			unuploaded = append(unuploaded, key)
+		}
+	}
+	if len(uploaded) > 0 || len(unuploaded) > 0 {
+		importer, ok := device.PreKeys.(store.PreKeyImportStore)
+		if !ok {
+			return ErrPreKeyImportNotSupported
+		}
+		err := importer.ImportPreKeys(uploaded, true)
+		if err != nil {
+			return fmt.Errorf("failed to import uploaded prekeys: %w", err)
+		}
+		err = importer.ImportPreKeys(unuploaded, false)
+		if err != nil {
+			return fmt.Errorf("failed to import unuploaded prekeys: %w", err)
+		}
+	}
+	for id, key := range state.Keys.AppStateSyncKeys {
+		keyID, err := base64.StdEncoding.DecodeString(id)
+		if err != nil {
+			return fmt.Errorf("invalid app state sync key ID %q: %w", id, err)
+		}
+		fingerprint, err := proto.Marshal(&waProto.AppStateSyncKeyFingerprint{
+			RawId:         proto.Uint32(key.Fingerprint.RawID),
+			CurrentIndex:  proto.Uint32(key.Fingerprint.CurrentIndex),
+			DeviceIndexes: key.Fingerprint.DeviceIndexes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal fingerprint of app state sync key %s: %w", id, err)
+		}
+		err = device.AppStateKeys.PutAppStateSyncKey(keyID, store.AppStateSyncKey{
+			Data:        key.KeyData,
+			Fingerprint: fingerprint,
+			Timestamp:   int64(key.Timestamp),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to store app state sync key %s: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package compat
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+func bufferJSON(data []byte) string {
+	return fmt.Sprintf(`{"type": "Buffer", "data": %q}`, base64.StdEncoding.EncodeToString(data))
+}
+
+func filledBytes(val byte, length int) []byte {
+	return bytes.Repeat([]byte{val}, length)
+}
+
+func TestParseBaileysCreds(t *testing.T) {
+	creds := fmt.Sprintf(`{
+		"noiseKey": {"private": %s, "public": %s},
+		"signedIdentityKey": {"private": %s, "public": %s},
+		"signedPreKey": {"keyPair": {"private": %s, "public": %s}, "signature": %s, "keyId": 1},
+		"registrationId": 1234,
+		"advSecretKey": %q,
+		"me": {"id": "1234567890:12@s.whatsapp.net", "name": "Tester"},
+		"account": {"details": {"type": "Buffer", "data": [1, 2, 3]}, "accountSignatureKey": %s, "accountSignature": %s, "deviceSignature": %s},
+		"platform": "android"
+	}`,
+		bufferJSON(filledBytes(1, 32)), bufferJSON(filledBytes(0, 32)),
+		bufferJSON(filledBytes(2, 32)), bufferJSON(filledBytes(0, 32)),
+		bufferJSON(filledBytes(3, 32)), bufferJSON(filledBytes(0, 32)), bufferJSON(filledBytes(4, 64)),
+		base64.StdEncoding.EncodeToString(filledBytes(5, 32)),
+		bufferJSON(filledBytes(6, 32)), bufferJSON(filledBytes(7, 64)), bufferJSON(filledBytes(8, 64)),
+	)
+	state, err := ParseBaileysAuthState([]byte(fmt.Sprintf(`{"creds": %s, "keys": {}}`, creds)))
+	if err != nil {
+		t.Fatalf("Failed to parse auth state: %v", err)
+	}
+	device, err := state.Creds.ToDevice()
+	if err != nil {
+		t.Fatalf("Failed to convert credentials: %v", err)
+	}
+	if *device.ID != types.NewADJID("1234567890", 0, 12) {
+		t.Errorf("Unexpected JID %s", device.ID)
+	}
+	if device.RegistrationID != 1234 || device.PushName != "Tester" || device.Platform != "android" {
+		t.Errorf("Unexpected device metadata")
+	}
+	if !bytes.Equal(device.NoiseKey.Priv[:], filledBytes(1, 32)) || !bytes.Equal(device.IdentityKey.Priv[:], filledBytes(2, 32)) {
+		t.Errorf("Unexpected key pairs")
+	}
+	if device.SignedPreKey.KeyID != 1 || !bytes.Equal(device.SignedPreKey.Signature[:], filledBytes(4, 64)) {
+		t.Errorf("Unexpected signed prekey")
+	}
+	if !bytes.Equal(device.AdvSecretKey, filledBytes(5, 32)) || !bytes.Equal(device.Account.Details, []byte{1, 2, 3}) {
+		t.Errorf("Unexpected ADV data")
+	}
+	if device.Account.AccountSignatureKey != nil {
+		t.Errorf("Account signature key should not be imported")
+	}
+
+	_, err = ParseBaileysCreds([]byte(`{"registrationId": 1}`))
+	if err != ErrBaileysNotLoggedIn {
+		t.Errorf("Expected ErrBaileysNotLoggedIn for creds without account, got %v", err)
+	}
+}
+
+type memoryKeyStore struct {
+	store.PreKeyStore
+	preKeys      map[uint32]*keys.PreKey
+	uploaded     map[uint32]bool
+	appStateKeys map[string]store.AppStateSyncKey
+}
+
+func (mks *memoryKeyStore) ImportPreKeys(preKeys []*keys.PreKey, uploaded bool) error {
+	for _, key := range preKeys {
+		mks.preKeys[key.KeyID] = key
+		mks.uploaded[key.KeyID] = uploaded
+	}
+	return nil
+}
+
+func (mks *memoryKeyStore) PutAppStateSyncKey(id []byte, key store.AppStateSyncKey) error {
+	mks.appStateKeys[string(id)] = key
+	return nil
+}
+
+func (mks *memoryKeyStore) GetAppStateSyncKey(id []byte) (*store.AppStateSyncKey, error) {
+	key, ok := mks.appStateKeys[string(id)]
+	if !ok {
+		return nil, nil
+	}
+	return &key, nil
+}
+
+func TestReadBaileysAuthStateDir(t *testing.T) {
+	dir := t.TempDir()
+	keyID := []byte{0xfb, 0xff, 0x01}
+	files := map[string]string{
+		"creds.json":     `{"firstUnuploadedPreKeyId": 2}`,
+		"pre-key-1.json": fmt.Sprintf(`{"private": %s, "public": %s}`, bufferJSON(filledBytes(1, 32)), bufferJSON(filledBytes(0, 32))),
+		"pre-key-2.json": fmt.Sprintf(`{"private": %s, "public": %s}`, bufferJSON(filledBytes(2, 32)), bufferJSON(filledBytes(0, 32))),
+		// The key ID is +/8B, which useMultiFileAuthState stores as +__8B
+		"app-state-sync-key-+__8B.json": fmt.Sprintf(
+			`{"keyData": %s, "fingerprint": {"rawId": 5, "currentIndex": 1, "deviceIndexes": [0, 1]}, "timestamp": {"low": 1234, "high": 1, "unsigned": false}}`,
+			bufferJSON(filledBytes(3, 32)),
+		),
+		"session-1234567890.0.json": `{}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	state, err := ReadBaileysAuthStateDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read auth state: %v", err)
+	} else if len(state.Keys.PreKeys) != 2 || len(state.Keys.AppStateSyncKeys) != 1 {
+		t.Fatalf("Unexpected keys %+v", state.Keys)
+	}
+
+	keyStore := &memoryKeyStore{
+		preKeys:      make(map[uint32]*keys.PreKey),
+		uploaded:     make(map[uint32]bool),
+		appStateKeys: make(map[string]store.AppStateSyncKey),
+	}
+	err = state.ImportKeys(&store.Device{PreKeys: keyStore, AppStateKeys: keyStore})
+	if err != nil {
+		t.Fatalf("Failed to import keys: %v", err)
+	}
+	if key := keyStore.preKeys[1]; key == nil || !bytes.Equal(key.Priv[:], filledBytes(1, 32)) || !keyStore.uploaded[1] {
+		t.Errorf("Unexpected prekey 1: %+v (uploaded: %t)", key, keyStore.uploaded[1])
+	}
+	if key := keyStore.preKeys[2]; key == nil || keyStore.uploaded[2] {
+		t.Errorf("Prekey 2 should be imported as unuploaded")
+	}
+	appStateKey, ok := keyStore.appStateKeys[string(keyID)]
+	if !ok {
+		t.Fatalf("App state sync key wasn't imported")
+	}
+	var fingerprint waProto.AppStateSyncKeyFingerprint
+	if err = proto.Unmarshal(appStateKey.Fingerprint, &fingerprint); err != nil {
+		t.Fatalf("Failed to unmarshal fingerprint: %v", err)
+	}
+	if !bytes.Equal(appStateKey.Data, filledBytes(3, 32)) || appStateKey.Timestamp != 1<<32+1234 ||
+		fingerprint.GetRawId() != 5 || fingerprint.GetCurrentIndex() != 1 || len(fingerprint.GetDeviceIndexes()) != 2 {
+		t.Errorf("Unexpected app state sync key %+v (fingerprint: %+v)", appStateKey, &fingerprint)
+	}
+
+	err = state.ImportKeys(&store.Device{PreKeys: struct{ store.PreKeyStore }{}, AppStateKeys: keyStore})
+	if err != ErrPreKeyImportNotSupported {
+		t.Errorf("Expected ErrPreKeyImportNotSupported, got %v", err)
+	}
+}
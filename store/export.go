@@ -0,0 +1,134 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// ExportVersion is the current version of the format produced by Device.Export.
+const ExportVersion = 1
+
+var (
+	ErrExportNoID           = errors.New("can't export device that isn't logged in")
+	ErrUnsupportedExport    = errors.New("unsupported device export version")
+	ErrInvalidExportedField = errors.New("invalid field in device export")
+)
+
+// ExportedDevice is the portable representation of a device produced by Device.Export.
+//
+// It only contains the long-term keys and account info that are needed to log in. Signal sessions, prekeys,
+// sender keys and app state are not included: sessions will be re-established through retry receipts,
+// and the client uploads new prekeys automatically when it notices the database doesn't have enough.
+type ExportedDevice struct {
+	Version int `json:"version"`
+
+	ID             string `json:"id"`
+	RegistrationID uint32 `json:"registration_id"`
+	NoiseKey       []byte `json:"noise_key"`
+	IdentityKey    []byte `json:"identity_key"`
+
+	SignedPreKey          []byte `json:"signed_pre_key"`
+	SignedPreKeyID        uint32 `json:"signed_pre_key_id"`
+	SignedPreKeySignature []byte `json:"signed_pre_key_sig"`
+
+	AdvSecretKey        []byte `json:"adv_key"`
+	AdvDetails          []byte `json:"adv_details"`
+	AdvAccountSignature []byte `json:"adv_account_sig"`
+	AdvDeviceSignature  []byte `json:"adv_device_sig"`
+
+	Platform     string `json:"platform,omitempty"`
+	BusinessName string `json:"business_name,omitempty"`
+	PushName     string `json:"push_name,omitempty"`
+}
+
+// Export serializes the keys and account info of this device into a versioned JSON blob,
+// which can be loaded into another database with the Import method of the container.
+//
+// The blob contains private keys, so it must be stored as securely as the database itself.
+func (device *Device) Export() ([]byte, error) {
+	if device.ID == nil || device.Account == nil {
+		return nil, ErrExportNoID
+	}
+	return json.Marshal(&ExportedDevice{
+		Version: ExportVersion,
+
+		ID:             device.ID.String(),
+		RegistrationID: device.RegistrationID,
+		NoiseKey:       device.NoiseKey.Priv[:],
+		IdentityKey:    device.IdentityKey.Priv[:],
+
+		SignedPreKey:          device.SignedPreKey.Priv[:],
+		SignedPreKeyID:        device.SignedPreKey.KeyID,
+		SignedPreKeySignature: device.SignedPreKey.Signature[:],
+
+		AdvSecretKey:        device.AdvSecretKey,
+		AdvDetails:          device.Account.Details,
+		AdvAccountSignature: device.Account.AccountSignature,
+		AdvDeviceSignature:  device.Account.DeviceSignature,
+
+		Platform:     device.Platform,
+		BusinessName: device.BusinessName,
+		PushName:     device.PushName,
+	})
+}
+
+// ParseExport parses a blob produced by Device.Export into a new Device.
+//
+// The returned device doesn't have any stores set, so it's only useful for passing to a container.
+// Most users should use the Import method of the container instead.
+func ParseExport(data []byte) (*Device, error) {
+	var exp ExportedDevice
+	err := json.Unmarshal(data, &exp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse device export: %w", err)
+	} else if exp.Version != ExportVersion {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedExport, exp.Version)
+	}
+	jid, err := types.ParseJID(exp.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: id: %v", ErrInvalidExportedField, err)
+	}
+	if len(exp.NoiseKey) != 32 {
+		return nil, fmt.Errorf("%w: noise_key must be 32 bytes", ErrInvalidExportedField)
+	} else if len(exp.IdentityKey) != 32 {
+		return nil, fmt.Errorf("%w: identity_key must be 32 bytes", ErrInvalidExportedField)
+	} else if len(exp.SignedPreKey) != 32 {
+		return nil, fmt.Errorf("%w: signed_pre_key must be 32 bytes", ErrInvalidExportedField)
+	} else if len(exp.SignedPreKeySignature) != 64 {
+		return nil, fmt.Errorf("%w: signed_pre_key_sig must be 64 bytes", ErrInvalidExportedField)
+	} else if len(exp.AdvSecretKey) != 32 {
+		return nil, fmt.Errorf("%w: adv_key must be 32 bytes", ErrInvalidExportedField)
+	}
+	device := &Device{
+		ID:             &jid,
+		RegistrationID: exp.RegistrationID,
+		NoiseKey:       keys.NewKeyPairFromPrivateKey(*(*[32]byte)(exp.NoiseKey)),
+		IdentityKey:    keys.NewKeyPairFromPrivateKey(*(*[32]byte)(exp.IdentityKey)),
+		SignedPreKey: &keys.PreKey{
+			KeyPair:   *keys.NewKeyPairFromPrivateKey(*(*[32]byte)(exp.SignedPreKey)),
+			KeyID:     exp.SignedPreKeyID,
+			Signature: (*[64]byte)(exp.SignedPreKeySignature),
+		},
+		AdvSecretKey: exp.AdvSecretKey,
+		Account: &waProto.ADVSignedDeviceIdentity{
+			Details:          exp.AdvDetails,
+			AccountSignature: exp.AdvAccountSignature,
+			DeviceSignature:  exp.AdvDeviceSignature,
+		},
+		Platform:     exp.Platform,
+		BusinessName: exp.BusinessName,
+		PushName:     exp.PushName,
+	}
+	return device, nil
+}
@@ -25,6 +25,8 @@ type Container struct {
 	db      *sql.DB
 	dialect string
 	log     waLog.Logger
+
+	busyRetries int
 }
 
 var _ store.DeviceContainer = (*Container)(nil)
@@ -37,6 +39,8 @@ var _ store.DeviceContainer = (*Container)(nil)
 //
 // When using SQLite, it's strongly recommended to enable foreign keys by adding `?_foreign_keys=true`:
 //   container, err := sqlstore.New("sqlite3", "file:yoursqlitefile.db?_foreign_keys=on", nil)
+//
+// To tune the journal mode and busy timeout of SQLite databases, use NewSQLite instead.
 func New(dialect, address string, log waLog.Logger) (*Container, error) {
 	db, err := sql.Open(dialect, address)
 	if err != nil {
@@ -66,11 +70,15 @@ func NewWithDB(db *sql.DB, dialect string, log waLog.Logger) *Container {
 	if log == nil {
 		log = waLog.Noop
 	}
-	return &Container{
+	container := &Container{
 		db:      db,
 		dialect: dialect,
 		log:     log,
 	}
+	if isSQLiteDialect(dialect) {
+		container.busyRetries = DefaultSQLiteConfig.BusyRetries
+	}
+	return container
 }
 
 const getAllDevicesQuery = `
@@ -120,6 +128,9 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	device.AppState = innerStore
 	device.Contacts = innerStore
 	device.ChatSettings = innerStore
+	device.MsgSecrets = innerStore
+	device.Outbox = innerStore
+	device.SentMessages = innerStore
 	device.Container = c
 	device.Initialized = true
 
@@ -208,6 +219,9 @@ func (c *Container) NewDevice() *store.Device {
 // ErrDeviceIDMustBeSet is the error returned by PutDevice if you try to save a device before knowing its JID.
 var ErrDeviceIDMustBeSet = errors.New("device JID must be known before accessing database")
 
+// ErrDeviceAlreadyExists is the error returned by Import if the database already contains a device with the same JID.
+var ErrDeviceAlreadyExists = errors.New("device with the same JID already exists in the database")
+
 // PutDevice stores the given device in this database. This should be called through Device.Save()
 // (which usually doesn't need to be called manually, as the library does that automatically when relevant).
 func (c *Container) PutDevice(device *store.Device) error {
@@ -230,11 +244,53 @@ func (c *Container) PutDevice(device *store.Device) error {
 		device.AppState = innerStore
 		device.Contacts = innerStore
 		device.ChatSettings = innerStore
+		device.MsgSecrets = innerStore
+		device.Outbox = innerStore
+		device.SentMessages = innerStore
 		device.Initialized = true
 	}
 	return err
 }
 
+// Import stores a device exported with store.Device.Export in this database and returns it.
+//
+// If the database already contains a device with the same JID, ErrDeviceAlreadyExists is returned.
+func (c *Container) Import(data []byte) (*store.Device, error) {
+	device, err := store.ParseExport(data)
+	if err != nil {
+		return nil, err
+	}
+	err = c.ImportDevice(device)
+	if err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// ImportDevice stores a device that was created outside of this container (e.g. with store.ParseExport or
+// the converters in the store/compat package) in this database and connects it to the database.
+//
+// If the database already contains a device with the same JID, ErrDeviceAlreadyExists is returned.
+func (c *Container) ImportDevice(device *store.Device) error {
+	if device.ID == nil {
+		return ErrDeviceIDMustBeSet
+	}
+	existing, err := c.GetDevice(*device.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check if device exists: %w", err)
+	} else if existing != nil {
+		return ErrDeviceAlreadyExists
+	}
+	device.Log = c.log
+	device.Container = c
+	device.Initialized = false
+	err = c.PutDevice(device)
+	if err != nil {
+		return fmt.Errorf("failed to save imported device: %w", err)
+	}
+	return nil
+}
+
 // DeleteDevice deletes the given device from this database. This should be called through Device.Delete()
 func (c *Container) DeleteDevice(store *store.Device) error {
 	if store.ID == nil {
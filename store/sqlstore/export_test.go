@@ -0,0 +1,63 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestExportImportDevice(t *testing.T) {
+	src := newTestSQLiteDevice(t, DefaultSQLiteConfig)
+	original, err := src.Container.GetDevice(types.NewADJID("1234567890", 0, 1))
+	if err != nil || original == nil {
+		t.Fatalf("Failed to get device: %v", err)
+	}
+	original.PushName = "Tester"
+	exported, err := original.Export()
+	if err != nil {
+		t.Fatalf("Failed to export device: %v", err)
+	}
+
+	address := fmt.Sprintf("file:%s?_foreign_keys=on", filepath.Join(t.TempDir(), "imported.db"))
+	dst, err := New("sqlite3", address, nil)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	imported, err := dst.Import(exported)
+	if err != nil {
+		t.Fatalf("Failed to import device: %v", err)
+	}
+	loaded, err := dst.GetDevice(*imported.ID)
+	if err != nil || loaded == nil {
+		t.Fatalf("Failed to load imported device: %v", err)
+	}
+	if *loaded.ID != *original.ID || loaded.RegistrationID != original.RegistrationID || loaded.PushName != "Tester" {
+		t.Errorf("Imported device metadata doesn't match original")
+	}
+	if *loaded.NoiseKey.Pub != *original.NoiseKey.Pub || *loaded.IdentityKey.Pub != *original.IdentityKey.Pub {
+		t.Errorf("Imported device keys don't match original")
+	}
+	if loaded.SignedPreKey.KeyID != original.SignedPreKey.KeyID || *loaded.SignedPreKey.Signature != *original.SignedPreKey.Signature {
+		t.Errorf("Imported signed prekey doesn't match original")
+	}
+	if !bytes.Equal(loaded.AdvSecretKey, original.AdvSecretKey) || !bytes.Equal(loaded.Account.AccountSignature, original.Account.AccountSignature) {
+		t.Errorf("Imported ADV data doesn't match original")
+	}
+
+	if _, err = dst.Import(exported); !errors.Is(err, ErrDeviceAlreadyExists) {
+		t.Errorf("Expected ErrDeviceAlreadyExists when importing twice, got %v", err)
+	}
+	if _, err = dst.Import([]byte(`{"version": 999}`)); err == nil {
+		t.Errorf("Expected error when importing unsupported version")
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	mathRand "math/rand"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// SQLiteConfig contains settings for tuning the behavior of SQLite databases under concurrent load.
+//
+// Trade-offs of the different options:
+//
+//   - JournalMode "WAL" allows readers and a writer to work concurrently, which removes most SQLITE_BUSY
+//     errors, but creates -wal and -shm files next to the database and doesn't work on network filesystems.
+//   - BusyTimeout makes SQLite wait for locks instead of failing immediately. Higher values mean fewer
+//     errors, but a stuck writer can block message handling for up to that long.
+//   - Synchronous "NORMAL" is safe with WAL and much faster than "FULL", but the last transactions may
+//     be rolled back after a power loss (not after an application crash).
+//   - MaxOpenConns limits the size of the connection pool. Connection-scoped settings (busy timeout and
+//     synchronous level) are applied to every connection in the pool, so the pool is kept at a fixed size.
+//     In-memory databases only exist within a single connection, so they always use one connection.
+type SQLiteConfig struct {
+	JournalMode  string        // The journal mode, e.g. "WAL" or "DELETE". Empty means don't change.
+	BusyTimeout  time.Duration // How long SQLite should wait for locks. Zero means don't change.
+	Synchronous  string        // The synchronous level, e.g. "NORMAL" or "FULL". Empty means don't change.
+	MaxOpenConns int           // Number of connections to keep in the pool. Defaults to 4.
+
+	// How many times hot-path writes (sessions, identities, sender keys and prekeys)
+	// should be retried if the database is still busy after the busy timeout.
+	BusyRetries int
+}
+
+// DefaultSQLiteConfig is a recommended SQLiteConfig for bots and bridges that handle lots of messages.
+var DefaultSQLiteConfig = SQLiteConfig{
+	JournalMode:  "WAL",
+	BusyTimeout:  5 * time.Second,
+	Synchronous:  "NORMAL",
+	MaxOpenConns: 4,
+	BusyRetries:  5,
+}
+
+// NewSQLite connects to the given SQLite database, applies the given config and wraps it in a Container.
+//
+// The dialect is the name of the database/sql driver, e.g. "sqlite3" for github.com/mattn/go-sqlite3.
+//
+//   container, err := sqlstore.NewSQLite("sqlite3", "file:yoursqlitefile.db?_foreign_keys=on", sqlstore.DefaultSQLiteConfig, nil)
+//
+// If the address is an in-memory database, the pool is limited to a single connection (as each connection would
+// otherwise get its own empty database) and the journal mode isn't changed, as WAL isn't supported in memory.
+func NewSQLite(dialect, address string, config SQLiteConfig, log waLog.Logger) (*Container, error) {
+	if isSQLiteMemoryAddress(address) {
+		config.MaxOpenConns = 1
+		config.JournalMode = ""
+	}
+	db, err := sql.Open(dialect, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	container := NewWithDB(db, dialect, log)
+	err = container.ApplySQLiteConfig(config)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to apply sqlite config: %w", err)
+	}
+	err = container.Upgrade()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade database: %w", err)
+	}
+	return container, nil
+}
+
+// ApplySQLiteConfig applies the given config to the database using PRAGMA statements and verifies that they were applied.
+//
+// This should be called right after opening the database, before the container is used for anything else.
+// For in-memory databases, MaxOpenConns must be 1 (see NewSQLite).
+func (c *Container) ApplySQLiteConfig(config SQLiteConfig) error {
+	maxConns := config.MaxOpenConns
+	if maxConns <= 0 {
+		maxConns = DefaultSQLiteConfig.MaxOpenConns
+	}
+	// Busy timeout and synchronous level are per-connection settings, so keep a fixed set of
+	// connections in the pool and apply the settings to each of them.
+	c.db.SetMaxOpenConns(maxConns)
+	c.db.SetMaxIdleConns(maxConns)
+	c.db.SetConnMaxLifetime(0)
+	c.db.SetConnMaxIdleTime(0)
+
+	ctx := context.Background()
+	conns := make([]*sql.Conn, 0, maxConns)
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+	for i := 0; i < maxConns; i++ {
+		conn, err := c.db.Conn(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get connection #%d: %w", i+1, err)
+		}
+		conns = append(conns, conn)
+		if err = applySQLitePragmas(ctx, conn, config); err != nil {
+			return err
+		}
+	}
+	c.busyRetries = config.BusyRetries
+	return nil
+}
+
+func applySQLitePragmas(ctx context.Context, conn *sql.Conn, config SQLiteConfig) error {
+	if len(config.JournalMode) > 0 {
+		var mode string
+		err := conn.QueryRowContext(ctx, fmt.Sprintf("PRAGMA journal_mode=%s", config.JournalMode)).Scan(&mode)
+		if err != nil {
+			return fmt.Errorf("failed to set journal mode: %w", err)
+		} else if !strings.EqualFold(mode, config.JournalMode) {
+			return fmt.Errorf("failed to set journal mode: database is in %s mode after setting %s", mode, config.JournalMode)
+		}
+	}
+	if config.BusyTimeout > 0 {
+		timeout := config.BusyTimeout.Milliseconds()
+		var appliedTimeout int64
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("PRAGMA busy_timeout=%d", timeout)); err != nil {
+			return fmt.Errorf("failed to set busy timeout: %w", err)
+		} else if err = conn.QueryRowContext(ctx, "PRAGMA busy_timeout").Scan(&appliedTimeout); err != nil {
+			return fmt.Errorf("failed to verify busy timeout: %w", err)
+		} else if appliedTimeout != timeout {
+			return fmt.Errorf("failed to set busy timeout: got %d after setting %d", appliedTimeout, timeout)
+		}
+	}
+	if len(config.Synchronous) > 0 {
+		var level int
+		expectedLevel, ok := sqliteSynchronousLevels[strings.ToUpper(config.Synchronous)]
+		if !ok {
+			return fmt.Errorf("unknown synchronous level %s", config.Synchronous)
+		} else if _, err := conn.ExecContext(ctx, fmt.Sprintf("PRAGMA synchronous=%s", config.Synchronous)); err != nil {
+			return fmt.Errorf("failed to set synchronous level: %w", err)
+		} else if err = conn.QueryRowContext(ctx, "PRAGMA synchronous").Scan(&level); err != nil {
+			return fmt.Errorf("failed to verify synchronous level: %w", err)
+		} else if level != expectedLevel {
+			return fmt.Errorf("failed to set synchronous level: got %d after setting %s", level, config.Synchronous)
+		}
+	}
+	return nil
+}
+
+var sqliteSynchronousLevels = map[string]int{
+	"OFF":    0,
+	"NORMAL": 1,
+	"FULL":   2,
+	"EXTRA":  3,
+}
+
+// isSQLiteMemoryAddress returns true if the given SQLite address refers to an in-memory database.
+func isSQLiteMemoryAddress(address string) bool {
+	if address == ":memory:" || strings.HasPrefix(address, "file::memory:") {
+		return true
+	}
+	if queryStart := strings.IndexByte(address, '?'); queryStart >= 0 {
+		query, err := url.ParseQuery(address[queryStart+1:])
+		return err == nil && query.Get("mode") == "memory"
+	}
+	return false
+}
+
+// Primary SQLite result codes that mean the database or a table is locked by another connection.
+const (
+	sqliteBusy   = 5
+	sqliteLocked = 6
+)
+
+// sqliteErrorWithCode is implemented by errors of SQLite drivers that expose the result code as a method,
+// like modernc.org/sqlite.
+type sqliteErrorWithCode interface {
+	error
+	Code() int
+}
+
+// sqliteErrorCode returns the SQLite result code of the given error without depending on a specific driver.
+// Besides the Code method, integer Code fields of error structs (like sqlite3.Error of github.com/mattn/go-sqlite3)
+// are supported.
+func sqliteErrorCode(err error) (int, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if codeErr, ok := err.(sqliteErrorWithCode); ok {
+			return codeErr.Code(), true
+		}
+		val := reflect.ValueOf(err)
+		if val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				continue
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			continue
+		}
+		switch field := val.FieldByName("Code"); field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return int(field.Int()), true
+		}
+	}
+	return 0, false
+}
+
+// isBusyError returns true if the given error is an SQLITE_BUSY or SQLITE_LOCKED error from the driver.
+func isBusyError(err error) bool {
+	code, ok := sqliteErrorCode(err)
+	if !ok {
+		return false
+	}
+	// Extended result codes have the primary code in the lowest 8 bits
+	code &= 0xff
+	return code == sqliteBusy || code == sqliteLocked
+}
+
+// isSQLiteDialect returns true if the given dialect is the name of an SQLite driver.
+func isSQLiteDialect(dialect string) bool {
+	return dialect == "sqlite3" || dialect == "sqlite"
+}
+
+// execWithRetry executes the given query, retrying with a small random delay if SQLite reports that the database is busy.
+func (c *Container) execWithRetry(query string, args ...interface{}) (res sql.Result, err error) {
+	for i := 0; ; i++ {
+		res, err = c.db.Exec(query, args...)
+		if i >= c.busyRetries || !isBusyError(err) {
+			return
+		}
+		delay := time.Duration(10*(i+1))*time.Millisecond + time.Duration(mathRand.Intn(20))*time.Millisecond
+		c.log.Debugf("Database is busy, retrying query in %s (attempt #%d)", delay, i+1)
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// sqliteNoBusyTimeoutQuery disables the busy timeout that github.com/mattn/go-sqlite3 sets by default,
+// which is what other drivers do unless they're configured otherwise.
+const sqliteNoBusyTimeoutQuery = "_foreign_keys=on&_busy_timeout=0"
+
+func newTestSQLiteAddress(t *testing.T) string {
+	return fmt.Sprintf("file:%s?%s", filepath.Join(t.TempDir(), "whatsmeow.db"), sqliteNoBusyTimeoutQuery)
+}
+
+func newTestSQLiteDevice(t *testing.T, config SQLiteConfig) *SQLStore {
+	container, err := NewSQLite("sqlite3", newTestSQLiteAddress(t), config, nil)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	return newTestContainerDevice(t, container)
+}
+
+func newTestContainerDevice(t *testing.T, container *Container) *SQLStore {
+	t.Cleanup(func() {
+		_ = container.db.Close()
+	})
+	device := container.NewDevice()
+	jid := types.NewADJID("1234567890", 0, 1)
+	device.ID = &jid
+	device.Account = &waProto.ADVSignedDeviceIdentity{
+		Details:          []byte{},
+		AccountSignature: make([]byte, 64),
+		DeviceSignature:  make([]byte, 64),
+	}
+	if err := container.PutDevice(device); err != nil {
+		t.Fatalf("Failed to save device: %v", err)
+	}
+	return device.Sessions.(*SQLStore)
+}
+
+func TestApplySQLiteConfig(t *testing.T) {
+	s := newTestSQLiteDevice(t, DefaultSQLiteConfig)
+	var journalMode string
+	if err := s.db.QueryRow("PRAGMA journal_mode").Scan(&journalMode); err != nil {
+		t.Fatalf("Failed to get journal mode: %v", err)
+	} else if journalMode != "wal" {
+		t.Errorf("Expected journal mode wal, got %s", journalMode)
+	}
+	_, err := NewSQLite("sqlite3", "file::memory:", SQLiteConfig{Synchronous: "SOMETIMES"}, nil)
+	if err == nil {
+		t.Errorf("Expected error with invalid synchronous level")
+	}
+}
+
+// hammerSessions writes and reads sessions from many goroutines and returns all errors that occurred.
+func hammerSessions(s *SQLStore) []error {
+	const goroutines = 32
+	const iterations = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*iterations)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			address := fmt.Sprintf("1987654321.%d:%d", i, 0)
+			for j := 0; j < iterations; j++ {
+				session := []byte(fmt.Sprintf("session %d/%d", i, j))
+				if err := s.PutSession(address, session); err != nil {
+					errs <- fmt.Errorf("failed to put session %s #%d: %w", address, j, err)
+				} else if stored, err := s.GetSession(address); err != nil {
+					errs <- fmt.Errorf("failed to get session %s #%d: %w", address, j, err)
+				} else if !bytes.Equal(stored, session) {
+					errs <- fmt.Errorf("unexpected session data for %s #%d: %q", address, j, stored)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	var errList []error
+	for err := range errs {
+		errList = append(errList, err)
+	}
+	return errList
+}
+
+// TestSQLiteConcurrentSessions hammers the session store from many goroutines. Without a busy timeout,
+// the old settings (rollback journal, unlimited pool and no retries) fail with "database is locked" errors,
+// while DefaultSQLiteConfig handles the same load without errors.
+func TestSQLiteConcurrentSessions(t *testing.T) {
+	t.Run("OldSettings", func(t *testing.T) {
+		container, err := New("sqlite3", newTestSQLiteAddress(t), nil)
+		if err != nil {
+			t.Fatalf("Failed to open database: %v", err)
+		}
+		container.busyRetries = 0
+		errs := hammerSessions(newTestContainerDevice(t, container))
+		if len(errs) == 0 {
+			t.Fatalf("Expected database is locked errors without a busy timeout")
+		}
+		for _, err := range errs {
+			if !isBusyError(err) {
+				t.Errorf("Unexpected non-busy error: %v", err)
+			}
+		}
+	})
+	t.Run("DefaultSQLiteConfig", func(t *testing.T) {
+		for _, err := range hammerSessions(newTestSQLiteDevice(t, DefaultSQLiteConfig)) {
+			t.Error(err)
+		}
+	})
+}
+
+func TestSQLiteInMemory(t *testing.T) {
+	for _, address := range []string{":memory:", "file::memory:?_foreign_keys=on", "file:test.db?mode=memory&_foreign_keys=on"} {
+		t.Run(address, func(t *testing.T) {
+			if !isSQLiteMemoryAddress(address) {
+				t.Fatalf("Address wasn't detected as in-memory")
+			}
+			container, err := NewSQLite("sqlite3", address, DefaultSQLiteConfig, nil)
+			if err != nil {
+				t.Fatalf("Failed to open database: %v", err)
+			}
+			defer container.db.Close()
+			if maxConns := container.db.Stats().MaxOpenConnections; maxConns != 1 {
+				t.Errorf("Expected a single connection, got %d", maxConns)
+			}
+			// The tables created by the upgrade must be visible to the connection used for queries
+			if _, err = container.GetAllDevices(); err != nil {
+				t.Errorf("Failed to query devices: %v", err)
+			}
+		})
+	}
+	if isSQLiteMemoryAddress("file:whatsmeow.db?_foreign_keys=on") {
+		t.Errorf("File address was detected as in-memory")
+	}
+}
+
+type codeError int
+
+type structCodeError struct{ Code int8 }
+
+func (sce *structCodeError) Error() string { return fmt.Sprintf("sqlite error %d", sce.Code) }
+
+type stringCodeError struct{ Code string }
+
+func (sce stringCodeError) Error() string { return "pq: " + sce.Code }
+
+func (ce codeError) Error() string { return fmt.Sprintf("sqlite error %d", int(ce)) }
+func (ce codeError) Code() int     { return int(ce) }
+
+func TestIsBusyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"mattn busy", sqlite3.Error{Code: sqlite3.ErrBusy}, true},
+		{"mattn locked", fmt.Errorf("wrapped: %w", sqlite3.Error{Code: sqlite3.ErrLocked}), true},
+		{"mattn constraint", sqlite3.Error{Code: sqlite3.ErrConstraint}, false},
+		{"code method busy snapshot", codeError(5 | 2<<8), true},
+		{"code method readonly", codeError(8), false},
+		{"pointer with code field", fmt.Errorf("wrapped: %w", &structCodeError{Code: 6}), true},
+		{"string code field", stringCodeError{Code: "40001"}, false},
+		{"message only", errors.New("database is locked"), false},
+		{"nil", nil, false},
+	}
+	for _, test := range tests {
+		if got := isBusyError(test.err); got != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestBusyRetriesOnlyForSQLite(t *testing.T) {
+	if retries := NewWithDB(nil, "sqlite3", nil).busyRetries; retries != DefaultSQLiteConfig.BusyRetries {
+		t.Errorf("Expected %d busy retries for sqlite3, got %d", DefaultSQLiteConfig.BusyRetries, retries)
+	}
+	if retries := NewWithDB(nil, "postgres", nil).busyRetries; retries != 0 {
+		t.Errorf("Expected no busy retries for postgres, got %d", retries)
+	}
+}
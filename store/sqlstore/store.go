@@ -59,10 +59,20 @@ func NewSQLStore(c *Container, jid types.JID) *SQLStore {
 var _ store.IdentityStore = (*SQLStore)(nil)
 var _ store.SessionStore = (*SQLStore)(nil)
 var _ store.PreKeyStore = (*SQLStore)(nil)
+var _ store.PreKeyImportStore = (*SQLStore)(nil)
 var _ store.SenderKeyStore = (*SQLStore)(nil)
 var _ store.AppStateSyncKeyStore = (*SQLStore)(nil)
 var _ store.AppStateStore = (*SQLStore)(nil)
 var _ store.ContactStore = (*SQLStore)(nil)
+var _ store.ChatSettingsStore = (*SQLStore)(nil)
+var _ store.UnreadCountStore = (*SQLStore)(nil)
+var _ store.UnreadCountBatchStore = (*SQLStore)(nil)
+var _ store.EphemeralExpirationStore = (*SQLStore)(nil)
+var _ store.MsgSecretStore = (*SQLStore)(nil)
+var _ store.MsgSecretPruneStore = (*SQLStore)(nil)
+var _ store.OutboxStore = (*SQLStore)(nil)
+var _ store.SentMessageStore = (*SQLStore)(nil)
+var _ store.AllStores = (*SQLStore)(nil)
 
 const (
 	putIdentityQuery = `
@@ -75,7 +85,7 @@ const (
 )
 
 func (s *SQLStore) PutIdentity(address string, key [32]byte) error {
-	_, err := s.db.Exec(putIdentityQuery, s.JID, address, key[:])
+	_, err := s.execWithRetry(putIdentityQuery, s.JID, address, key[:])
 	return err
 }
 
@@ -131,7 +141,7 @@ func (s *SQLStore) HasSession(address string) (has bool, err error) {
 }
 
 func (s *SQLStore) PutSession(address string, session []byte) error {
-	_, err := s.db.Exec(putSessionQuery, s.JID, address, session)
+	_, err := s.execWithRetry(putSessionQuery, s.JID, address, session)
 	return err
 }
 
@@ -153,11 +163,15 @@ const (
 	deletePreKeyQuery           = `DELETE FROM whatsmeow_pre_keys WHERE jid=$1 AND key_id=$2`
 	markPreKeysAsUploadedQuery  = `UPDATE whatsmeow_pre_keys SET uploaded=true WHERE jid=$1 AND key_id<=$2`
 	getUploadedPreKeyCountQuery = `SELECT COUNT(*) FROM whatsmeow_pre_keys WHERE jid=$1 AND uploaded=true`
+	importPreKeyQuery           = `
+		INSERT INTO whatsmeow_pre_keys (jid, key_id, key, uploaded) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (jid, key_id) DO UPDATE SET key=$3, uploaded=$4
+	`
 )
 
 func (s *SQLStore) genOnePreKey(id uint32, markUploaded bool) (*keys.PreKey, error) {
 	key := keys.NewPreKey(id)
-	_, err := s.db.Exec(insertPreKeyQuery, s.JID, key.KeyID, key.Priv[:], markUploaded)
+	_, err := s.execWithRetry(insertPreKeyQuery, s.JID, key.KeyID, key.Priv[:], markUploaded)
 	return key, err
 }
 
@@ -241,10 +255,31 @@ func (s *SQLStore) GetPreKey(id uint32) (*keys.PreKey, error) {
 }
 
 func (s *SQLStore) RemovePreKey(id uint32) error {
-	_, err := s.db.Exec(deletePreKeyQuery, s.JID, id)
+	_, err := s.execWithRetry(deletePreKeyQuery, s.JID, id)
 	return err
 }
 
+func (s *SQLStore) ImportPreKeys(preKeys []*keys.PreKey, uploaded bool) error {
+	s.preKeyLock.Lock()
+	defer s.preKeyLock.Unlock()
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, key := range preKeys {
+		_, err = tx.Exec(importPreKeyQuery, s.JID, key.KeyID, key.Priv[:], uploaded)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to save prekey %d: %w", key.KeyID, err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 func (s *SQLStore) MarkPreKeysAsUploaded(upToID uint32) error {
 	_, err := s.db.Exec(markPreKeysAsUploadedQuery, s.JID, upToID)
 	return err
@@ -264,7 +299,7 @@ const (
 )
 
 func (s *SQLStore) PutSenderKey(group, user string, session []byte) error {
-	_, err := s.db.Exec(putSenderKeyQuery, s.JID, group, user, session)
+	_, err := s.execWithRetry(putSenderKeyQuery, s.JID, group, user, session)
 	return err
 }
 
@@ -293,9 +328,11 @@ func (s *SQLStore) GetAppStateSyncKey(id []byte) (*store.AppStateSyncKey, error)
 	var key store.AppStateSyncKey
 	err := s.db.QueryRow(getAppStateSyncKeyQuery, s.JID, id).Scan(&key.Data, &key.Timestamp, &key.Fingerprint)
 	if errors.Is(err, sql.ErrNoRows) {
-		err = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, err
 	}
-	return &key, err
+	return &key, nil
 }
 
 const (
@@ -566,7 +603,10 @@ const (
 		ON CONFLICT (our_jid, chat_jid) DO UPDATE SET %[1]s=$3
 	`
 	getChatSettingsQuery = `
-		SELECT muted_until, pinned, archived FROM whatsmeow_chat_settings WHERE our_jid=$1 AND chat_jid=$2
+		SELECT muted_until, pinned, archived, unread_count, ephemeral_expiration FROM whatsmeow_chat_settings WHERE our_jid=$1 AND chat_jid=$2
+	`
+	getUnreadCountsQuery = `
+		SELECT chat_jid, unread_count FROM whatsmeow_chat_settings WHERE our_jid=$1 AND unread_count<>0
 	`
 )
 
@@ -589,9 +629,39 @@ func (s *SQLStore) PutArchived(chat types.JID, archived bool) error {
 	return err
 }
 
+func (s *SQLStore) PutUnreadCount(chat types.JID, count int) error {
+	_, err := s.db.Exec(fmt.Sprintf(putChatSettingQuery, "unread_count"), s.JID, chat, count)
+	return err
+}
+
+func (s *SQLStore) PutUnreadCounts(counts map[types.JID]int) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	query := fmt.Sprintf(putChatSettingQuery, "unread_count")
+	for chat, count := range counts {
+		_, err = tx.Exec(query, s.JID, chat, count)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to save unread count of %s: %w", chat, err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) PutEphemeralExpiration(chat types.JID, expiration uint32) error {
+	_, err := s.db.Exec(fmt.Sprintf(putChatSettingQuery, "ephemeral_expiration"), s.JID, chat, expiration)
+	return err
+}
+
 func (s *SQLStore) GetChatSettings(chat types.JID) (settings types.LocalChatSettings, err error) {
 	var mutedUntil int64
-	err = s.db.QueryRow(getChatSettingsQuery, s.JID, chat).Scan(&mutedUntil, &settings.Pinned, &settings.Archived)
+	err = s.db.QueryRow(getChatSettingsQuery, s.JID, chat).Scan(&mutedUntil, &settings.Pinned, &settings.Archived, &settings.UnreadCount, &settings.EphemeralExpiration)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	} else if err != nil {
@@ -604,3 +674,133 @@ func (s *SQLStore) GetChatSettings(chat types.JID) (settings types.LocalChatSett
 	}
 	return
 }
+
+func (s *SQLStore) GetUnreadCounts() (map[types.JID]int, error) {
+	rows, err := s.db.Query(getUnreadCountsQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	output := make(map[types.JID]int)
+	for rows.Next() {
+		var jid types.JID
+		var count int
+		err = rows.Scan(&jid, &count)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		output[jid] = count
+	}
+	return output, rows.Err()
+}
+
+const (
+	putMsgSecret = `
+		INSERT INTO whatsmeow_message_secrets (our_jid, chat_jid, sender_jid, message_id, key, stored_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, chat_jid, sender_jid, message_id) DO NOTHING
+	`
+	getMsgSecret = `
+		SELECT key FROM whatsmeow_message_secrets WHERE our_jid=$1 AND chat_jid=$2 AND sender_jid=$3 AND message_id=$4
+	`
+	deleteOldMsgSecrets = `DELETE FROM whatsmeow_message_secrets WHERE our_jid=$1 AND stored_at<$2`
+)
+
+func (s *SQLStore) PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error {
+	_, err := s.db.Exec(putMsgSecret, s.JID, chat.ToNonAD(), sender.ToNonAD(), id, secret, time.Now().UnixMilli())
+	return err
+}
+
+func (s *SQLStore) GetMessageSecret(chat, sender types.JID, id types.MessageID) (secret []byte, err error) {
+	err = s.db.QueryRow(getMsgSecret, s.JID, chat.ToNonAD(), sender.ToNonAD(), id).Scan(&secret)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+func (s *SQLStore) DeleteOldMessageSecrets(before time.Time) error {
+	_, err := s.db.Exec(deleteOldMsgSecrets, s.JID, before.UnixMilli())
+	return err
+}
+
+const (
+	putOutboxMessageQuery = `
+		INSERT INTO whatsmeow_outbox (our_jid, message_id, chat_jid, message, queued_at, attempts, next_attempt, send_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (our_jid, message_id) DO UPDATE
+			SET attempts=excluded.attempts, next_attempt=excluded.next_attempt, send_at=excluded.send_at
+	`
+	getOutboxMessagesQuery = `
+		SELECT message_id, chat_jid, message, queued_at, attempts, next_attempt, send_at FROM whatsmeow_outbox
+		WHERE our_jid=$1 ORDER BY queued_at, message_id
+	`
+	deleteOutboxMessageQuery = `DELETE FROM whatsmeow_outbox WHERE our_jid=$1 AND message_id=$2`
+)
+
+func (s *SQLStore) PutOutboxMessage(msg *store.OutboxMessage) error {
+	var sendAt int64
+	if !msg.SendAt.IsZero() {
+		sendAt = msg.SendAt.UnixMilli()
+	}
+	_, err := s.db.Exec(putOutboxMessageQuery, s.JID, msg.ID, msg.Chat, msg.Message, msg.QueuedAt.UnixMilli(), msg.Attempts, msg.NextAttempt.UnixMilli(), sendAt)
+	return err
+}
+
+func (s *SQLStore) GetOutboxMessages() ([]*store.OutboxMessage, error) {
+	rows, err := s.db.Query(getOutboxMessagesQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var output []*store.OutboxMessage
+	for rows.Next() {
+		var msg store.OutboxMessage
+		var queuedAt, nextAttempt, sendAt int64
+		err = rows.Scan(&msg.ID, &msg.Chat, &msg.Message, &queuedAt, &msg.Attempts, &nextAttempt, &sendAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning row: %w", err)
+		}
+		msg.QueuedAt = time.UnixMilli(queuedAt)
+		msg.NextAttempt = time.UnixMilli(nextAttempt)
+		if sendAt != 0 {
+			msg.SendAt = time.UnixMilli(sendAt)
+		}
+		output = append(output, &msg)
+	}
+	return output, rows.Err()
+}
+
+func (s *SQLStore) DeleteOutboxMessage(id types.MessageID) error {
+	_, err := s.db.Exec(deleteOutboxMessageQuery, s.JID, id)
+	return err
+}
+
+const (
+	putSentMessageQuery = `
+		INSERT INTO whatsmeow_sent_messages (our_jid, chat_jid, message_id, plaintext, sent_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, chat_jid, message_id) DO UPDATE SET plaintext=excluded.plaintext, sent_at=excluded.sent_at
+	`
+	getSentMessageQuery = `
+		SELECT plaintext FROM whatsmeow_sent_messages WHERE our_jid=$1 AND chat_jid=$2 AND message_id=$3
+	`
+	deleteOldSentMessagesQuery = `DELETE FROM whatsmeow_sent_messages WHERE our_jid=$1 AND sent_at<$2`
+)
+
+func (s *SQLStore) PutSentMessage(chat types.JID, id types.MessageID, plaintext []byte) error {
+	_, err := s.db.Exec(putSentMessageQuery, s.JID, chat, id, plaintext, time.Now().UnixMilli())
+	return err
+}
+
+func (s *SQLStore) GetSentMessage(chat types.JID, id types.MessageID) (plaintext []byte, err error) {
+	err = s.db.QueryRow(getSentMessageQuery, s.JID, chat, id).Scan(&plaintext)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+func (s *SQLStore) DeleteOldSentMessages(before time.Time) error {
+	_, err := s.db.Exec(deleteOldSentMessagesQuery, s.JID, before.UnixMilli())
+	return err
+}
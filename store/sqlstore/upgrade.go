@@ -8,6 +8,7 @@ package sqlstore
 
 import (
 	"database/sql"
+	"time"
 )
 
 type upgradeFunc func(*sql.Tx, *Container) error
@@ -16,7 +17,7 @@ type upgradeFunc func(*sql.Tx, *Container) error
 //
 // This may be of use if you want to manage the database fully manually, but in most cases you
 // should just call Container.Upgrade to let the library handle everything.
-var Upgrades = [...]upgradeFunc{upgradeV1}
+var Upgrades = [...]upgradeFunc{upgradeV1, upgradeV2, upgradeV3, upgradeV4, upgradeV5, upgradeV6, upgradeV7, upgradeV8}
 
 func (c *Container) getVersion() (int, error) {
 	_, err := c.db.Exec("CREATE TABLE IF NOT EXISTS whatsmeow_version (version INTEGER)")
@@ -212,3 +213,72 @@ func upgradeV1(tx *sql.Tx, _ *Container) error {
 	}
 	return nil
 }
+
+func upgradeV2(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_chat_settings ADD COLUMN unread_count INTEGER NOT NULL DEFAULT 0")
+	return err
+}
+
+func upgradeV3(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_message_secrets (
+		our_jid    TEXT,
+		chat_jid   TEXT,
+		sender_jid TEXT,
+		message_id TEXT,
+		key        bytea NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid, sender_jid, message_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV4(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_outbox (
+		our_jid      TEXT,
+		message_id   TEXT,
+		chat_jid     TEXT   NOT NULL,
+		message      bytea  NOT NULL,
+		queued_at    BIGINT NOT NULL,
+		attempts     INTEGER NOT NULL DEFAULT 0,
+		next_attempt BIGINT NOT NULL,
+
+		PRIMARY KEY (our_jid, message_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV5(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_chat_settings ADD COLUMN ephemeral_expiration INTEGER NOT NULL DEFAULT 0")
+	return err
+}
+
+func upgradeV6(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_outbox ADD COLUMN send_at BIGINT NOT NULL DEFAULT 0")
+	return err
+}
+
+func upgradeV7(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_sent_messages (
+		our_jid    TEXT,
+		chat_jid   TEXT,
+		message_id TEXT,
+		plaintext  bytea  NOT NULL,
+		sent_at    BIGINT NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid, message_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV8(tx *sql.Tx, _ *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_message_secrets ADD COLUMN stored_at BIGINT NOT NULL DEFAULT 0")
+	if err != nil {
+		return err
+	}
+	// Treat existing secrets as stored now, so they aren't all pruned right after upgrading.
+	_, err = tx.Exec("UPDATE whatsmeow_message_secrets SET stored_at=$1", time.Now().UnixMilli())
+	return err
+}
@@ -40,6 +40,13 @@ type PreKeyStore interface {
 	UploadedPreKeyCount() (int, error)
 }
 
+// PreKeyImportStore can optionally be implemented by PreKeyStores to store prekeys that were generated elsewhere,
+// e.g. when importing a session from another library with the store/compat package.
+type PreKeyImportStore interface {
+	// ImportPreKeys stores the given prekeys, replacing any existing prekeys with the same IDs.
+	ImportPreKeys(preKeys []*keys.PreKey, uploaded bool) error
+}
+
 type SenderKeyStore interface {
 	PutSenderKey(group, user string, session []byte) error
 	GetSenderKey(group, user string) ([]byte, error)
@@ -74,7 +81,7 @@ type AppStateStore interface {
 type ContactStore interface {
 	PutPushName(user types.JID, pushName string) (bool, string, error)
 	PutBusinessName(user types.JID, businessName string) error
-	PutContactName(user types.JID, fullName, firstName string) error
+	PutContactName(user types.JID, firstName, fullName string) error
 	GetContact(user types.JID) (types.ContactInfo, error)
 	GetAllContacts() (map[types.JID]types.ContactInfo, error)
 }
@@ -86,6 +93,86 @@ type ChatSettingsStore interface {
 	GetChatSettings(chat types.JID) (types.LocalChatSettings, error)
 }
 
+// UnreadCountStore can optionally be implemented by ChatSettingsStores to store the unread message counts
+// maintained by Client.EnableUnreadTracking. The count is returned in LocalChatSettings.UnreadCount.
+type UnreadCountStore interface {
+	PutUnreadCount(chat types.JID, count int) error
+	GetUnreadCounts() (map[types.JID]int, error)
+}
+
+// UnreadCountBatchStore can optionally be implemented by UnreadCountStores to save many unread counts at once,
+// which is used after full app state syncs. If it's not implemented, PutUnreadCount is called for each chat.
+type UnreadCountBatchStore interface {
+	PutUnreadCounts(counts map[types.JID]int) error
+}
+
+// EphemeralExpirationStore can optionally be implemented by ChatSettingsStores to remember the disappearing
+// message timers of private chats. The timer is returned in LocalChatSettings.EphemeralExpiration.
+type EphemeralExpirationStore interface {
+	PutEphemeralExpiration(chat types.JID, expiration uint32) error
+}
+
+type MsgSecretStore interface {
+	PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error
+	GetMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error)
+}
+
+// MsgSecretPruneStore can optionally be implemented by MsgSecretStores to remove old message secrets,
+// which is done after connecting if Client.MessageSecretTTL is set.
+type MsgSecretPruneStore interface {
+	// DeleteOldMessageSecrets removes all secrets that were stored before the given time.
+	DeleteOldMessageSecrets(before time.Time) error
+}
+
+// OutboxMessage is a message waiting to be sent in the outbox, see Client.QueueMessage.
+type OutboxMessage struct {
+	ID          types.MessageID
+	Chat        types.JID
+	Message     []byte // The marshaled waProto.Message
+	QueuedAt    time.Time
+	Attempts    int
+	NextAttempt time.Time
+	// SendAt is the time the message was scheduled to be sent at with Client.ScheduleMessage.
+	// It's zero for messages that were queued to be sent immediately.
+	SendAt time.Time
+}
+
+type OutboxStore interface {
+	// PutOutboxMessage inserts a new message into the outbox, or updates the attempt info and send time of
+	// an existing one.
+	PutOutboxMessage(msg *OutboxMessage) error
+	// GetOutboxMessages returns all messages in the outbox in the order they were queued.
+	GetOutboxMessages() ([]*OutboxMessage, error)
+	DeleteOutboxMessage(id types.MessageID) error
+}
+
+// SentMessageStore persists the plaintext of recently sent messages, so that retry receipts can be handled
+// even after the client is restarted.
+type SentMessageStore interface {
+	PutSentMessage(chat types.JID, id types.MessageID, plaintext []byte) error
+	// GetSentMessage returns the plaintext of a sent message, or nil if it's not found.
+	GetSentMessage(chat types.JID, id types.MessageID) ([]byte, error)
+	// DeleteOldSentMessages removes all messages that were stored before the given time.
+	DeleteOldSentMessages(before time.Time) error
+}
+
+// AllStores contains all the store interfaces that a Device needs.
+// It's mostly useful for testing store implementations, see the storetest package.
+//
+// The MsgSecretStore, OutboxStore and SentMessageStore interfaces are optional: the corresponding Device
+// fields may be nil, in which case the features that need them are disabled. The storetest package tests
+// them (as well as the optional extensions of ChatSettingsStore) only if they're implemented.
+type AllStores interface {
+	IdentityStore
+	SessionStore
+	PreKeyStore
+	SenderKeyStore
+	AppStateSyncKeyStore
+	AppStateStore
+	ContactStore
+	ChatSettingsStore
+}
+
 type DeviceContainer interface {
 	PutDevice(store *Device) error
 	DeleteDevice(store *Device) error
@@ -106,6 +193,10 @@ type Device struct {
 	BusinessName string
 	PushName     string
 
+	// Props overrides the global CompanionProps for this device. The props are only sent when pairing,
+	// so they don't affect devices that are already logged in. They're also not persisted in the database.
+	Props *waProto.CompanionProps
+
 	Initialized  bool
 	Identities   IdentityStore
 	Sessions     SessionStore
@@ -115,6 +206,10 @@ type Device struct {
 	AppState     AppStateStore
 	Contacts     ContactStore
 	ChatSettings ChatSettingsStore
+	// The following stores are optional and may be nil.
+	MsgSecrets   MsgSecretStore
+	Outbox       OutboxStore
+	SentMessages SentMessageStore
 	Container    DeviceContainer
 }
 
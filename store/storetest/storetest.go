@@ -0,0 +1,665 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package storetest contains a conformance test suite for implementations of the interfaces in the store package.
+//
+// Custom store implementations should run the suite in their own tests:
+//   func TestConformance(t *testing.T) {
+//       storetest.RunConformanceTests(t, func(t *testing.T) store.AllStores {
+//           return newEmptyStore(t)
+//       })
+//   }
+//
+// The failure messages name the contract that was violated, so the suite doubles as a specification of
+// how the library expects stores to behave.
+package storetest
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// RunConformanceTests runs the whole conformance suite.
+//
+// The factory must return a new empty store every time it's called. It receives the *testing.T of the subtest that
+// the store is used in, so any cleanup registered with t.Cleanup runs when that subtest finishes.
+// All the stores returned by a single call must share the same underlying data.
+func RunConformanceTests(t *testing.T, factory func(t *testing.T) store.AllStores) {
+	t.Run("IdentityStore", func(t *testing.T) { testIdentityStore(t, factory(t)) })
+	t.Run("SessionStore", func(t *testing.T) { testSessionStore(t, factory(t)) })
+	t.Run("PreKeyStore", func(t *testing.T) { testPreKeyStore(t, factory(t)) })
+	t.Run("SenderKeyStore", func(t *testing.T) { testSenderKeyStore(t, factory(t)) })
+	t.Run("AppStateSyncKeyStore", func(t *testing.T) { testAppStateSyncKeyStore(t, factory(t)) })
+	t.Run("AppStateStore", func(t *testing.T) { testAppStateStore(t, factory(t)) })
+	t.Run("ContactStore", func(t *testing.T) { testContactStore(t, factory(t)) })
+	t.Run("ChatSettingsStore", func(t *testing.T) { testChatSettingsStore(t, factory(t)) })
+	// Optional stores are only tested if they're implemented.
+	probe := factory(t)
+	if _, ok := probe.(store.PreKeyImportStore); ok {
+		t.Run("PreKeyImportStore", func(t *testing.T) { testPreKeyImportStore(t, factory(t)) })
+	}
+	if _, ok := probe.(store.MsgSecretStore); ok {
+		t.Run("MsgSecretStore", func(t *testing.T) { testMsgSecretStore(t, factory(t).(store.MsgSecretStore)) })
+	}
+	if _, ok := probe.(store.OutboxStore); ok {
+		t.Run("OutboxStore", func(t *testing.T) { testOutboxStore(t, factory(t).(store.OutboxStore)) })
+	}
+	if _, ok := probe.(store.SentMessageStore); ok {
+		t.Run("SentMessageStore", func(t *testing.T) { testSentMessageStore(t, factory(t).(store.SentMessageStore)) })
+	}
+}
+
+func must(t *testing.T, method string, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("%s returned unexpected error: %v", method, err)
+	}
+}
+
+func violated(t *testing.T, contract, format string, args ...interface{}) {
+	t.Helper()
+	t.Errorf("contract violated: %s: %s", contract, fmt.Sprintf(format, args...))
+}
+
+func key32(b byte) (out [32]byte) {
+	for i := range out {
+		out[i] = b
+	}
+	return
+}
+
+// testIdentityStore tests the trust-on-first-use semantics of IdentityStore.
+func testIdentityStore(t *testing.T, s store.IdentityStore) {
+	const addr = "1234567890:1"
+	keyA, keyB := key32(1), key32(2)
+
+	trusted, err := s.IsTrustedIdentity(addr, keyA)
+	must(t, "IsTrustedIdentity", err)
+	if !trusted {
+		violated(t, "IsTrustedIdentity must trust unknown addresses", "got false for %s", addr)
+	}
+	must(t, "PutIdentity", s.PutIdentity(addr, keyA))
+	if trusted, err = s.IsTrustedIdentity(addr, keyA); err != nil {
+		must(t, "IsTrustedIdentity", err)
+	} else if !trusted {
+		violated(t, "IsTrustedIdentity must trust the stored key", "got false for %s", addr)
+	}
+	if trusted, err = s.IsTrustedIdentity(addr, keyB); err != nil {
+		must(t, "IsTrustedIdentity", err)
+	} else if trusted {
+		violated(t, "IsTrustedIdentity must not trust a key different from the stored one", "got true for %s", addr)
+	}
+	must(t, "PutIdentity", s.PutIdentity(addr, keyB))
+	if trusted, err = s.IsTrustedIdentity(addr, keyB); err != nil {
+		must(t, "IsTrustedIdentity", err)
+	} else if !trusted {
+		violated(t, "PutIdentity must replace the existing key (upsert)", "new key not trusted for %s", addr)
+	}
+
+	must(t, "DeleteIdentity", s.DeleteIdentity(addr))
+	if trusted, err = s.IsTrustedIdentity(addr, keyA); err != nil {
+		must(t, "IsTrustedIdentity", err)
+	} else if !trusted {
+		violated(t, "DeleteIdentity must forget the key", "old key still distrusted for %s", addr)
+	}
+
+	must(t, "PutIdentity", s.PutIdentity("1234567890:1", keyA))
+	must(t, "PutIdentity", s.PutIdentity("1234567890:2", keyA))
+	must(t, "PutIdentity", s.PutIdentity("1987654321:1", keyA))
+	must(t, "DeleteAllIdentities", s.DeleteAllIdentities("1234567890"))
+	for _, deleted := range []string{"1234567890:1", "1234567890:2"} {
+		if trusted, err = s.IsTrustedIdentity(deleted, keyB); err != nil {
+			must(t, "IsTrustedIdentity", err)
+		} else if !trusted {
+			violated(t, "DeleteAllIdentities must delete the identities of all devices of the user", "%s wasn't deleted", deleted)
+		}
+	}
+	if trusted, err = s.IsTrustedIdentity("1987654321:1", keyB); err != nil {
+		must(t, "IsTrustedIdentity", err)
+	} else if trusted {
+		violated(t, "DeleteAllIdentities must not delete identities of other users", "1987654321:1 was deleted")
+	}
+}
+
+// testSessionStore tests that sessions are upserted, deleted and safe for concurrent use.
+func testSessionStore(t *testing.T, s store.SessionStore) {
+	const addr = "1234567890:1"
+	sess, err := s.GetSession(addr)
+	must(t, "GetSession", err)
+	if sess != nil {
+		violated(t, "GetSession must return nil for unknown addresses", "got %d bytes", len(sess))
+	}
+	has, err := s.HasSession(addr)
+	must(t, "HasSession", err)
+	if has {
+		violated(t, "HasSession must return false for unknown addresses", "got true")
+	}
+
+	must(t, "PutSession", s.PutSession(addr, []byte("first")))
+	must(t, "PutSession", s.PutSession(addr, []byte("second")))
+	if sess, err = s.GetSession(addr); err != nil {
+		must(t, "GetSession", err)
+	} else if !bytes.Equal(sess, []byte("second")) {
+		violated(t, "PutSession must replace the existing session (upsert)", "got %q", sess)
+	}
+	if has, err = s.HasSession(addr); err != nil {
+		must(t, "HasSession", err)
+	} else if !has {
+		violated(t, "HasSession must return true after PutSession", "got false")
+	}
+
+	must(t, "DeleteSession", s.DeleteSession(addr))
+	if has, err = s.HasSession(addr); err != nil {
+		must(t, "HasSession", err)
+	} else if has {
+		violated(t, "DeleteSession must delete the session", "HasSession still returns true")
+	}
+
+	must(t, "PutSession", s.PutSession("1234567890:1", []byte("a")))
+	must(t, "PutSession", s.PutSession("1234567890:2", []byte("b")))
+	must(t, "PutSession", s.PutSession("1987654321:1", []byte("c")))
+	must(t, "DeleteAllSessions", s.DeleteAllSessions("1234567890"))
+	for _, deleted := range []string{"1234567890:1", "1234567890:2"} {
+		if has, err = s.HasSession(deleted); err != nil {
+			must(t, "HasSession", err)
+		} else if has {
+			violated(t, "DeleteAllSessions must delete the sessions of all devices of the user", "%s wasn't deleted", deleted)
+		}
+	}
+	if has, err = s.HasSession("1987654321:1"); err != nil {
+		must(t, "HasSession", err)
+	} else if !has {
+		violated(t, "DeleteAllSessions must not delete sessions of other users", "1987654321:1 was deleted")
+	}
+
+	const goroutines = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			addr := fmt.Sprintf("1555000%03d:1", i)
+			for j := 0; j < 10; j++ {
+				if err := s.PutSession(addr, []byte(fmt.Sprintf("%d/%d", i, j))); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err = range errs {
+		violated(t, "SessionStore must be safe for concurrent use", "PutSession returned %v", err)
+	}
+	for i := 0; i < goroutines; i++ {
+		addr := fmt.Sprintf("1555000%03d:1", i)
+		expected := []byte(fmt.Sprintf("%d/%d", i, 9))
+		if sess, err = s.GetSession(addr); err != nil {
+			must(t, "GetSession", err)
+		} else if !bytes.Equal(sess, expected) {
+			violated(t, "SessionStore must be safe for concurrent use", "expected %q for %s, got %q", expected, addr, sess)
+		}
+	}
+}
+
+// testPreKeyStore tests prekey generation, consumption and the uploaded flag.
+func testPreKeyStore(t *testing.T, s store.PreKeyStore) {
+	count, err := s.UploadedPreKeyCount()
+	must(t, "UploadedPreKeyCount", err)
+	if count != 0 {
+		violated(t, "UploadedPreKeyCount must be zero for empty stores", "got %d", count)
+	}
+
+	preKeys, err := s.GetOrGenPreKeys(10)
+	must(t, "GetOrGenPreKeys", err)
+	if len(preKeys) != 10 {
+		t.Fatalf("contract violated: GetOrGenPreKeys must return exactly the requested number of keys: got %d", len(preKeys))
+	}
+	seen := make(map[uint32]bool)
+	var maxID uint32
+	for _, key := range preKeys {
+		if key == nil {
+			t.Fatalf("contract violated: GetOrGenPreKeys must not return nil keys")
+		} else if seen[key.KeyID] {
+			violated(t, "GetOrGenPreKeys must return unique key IDs", "%d returned twice", key.KeyID)
+		} else if key.KeyID == 0 {
+			violated(t, "Prekey IDs must start from 1", "got ID 0")
+		}
+		seen[key.KeyID] = true
+		if key.KeyID > maxID {
+			maxID = key.KeyID
+		}
+	}
+	if count, err = s.UploadedPreKeyCount(); err != nil {
+		must(t, "UploadedPreKeyCount", err)
+	} else if count != 0 {
+		violated(t, "GetOrGenPreKeys must not mark keys as uploaded", "UploadedPreKeyCount is %d", count)
+	}
+
+	again, err := s.GetOrGenPreKeys(10)
+	must(t, "GetOrGenPreKeys", err)
+	for _, key := range again {
+		if !seen[key.KeyID] {
+			violated(t, "GetOrGenPreKeys must return existing unuploaded keys before generating new ones", "got new key %d", key.KeyID)
+		}
+	}
+
+	must(t, "MarkPreKeysAsUploaded", s.MarkPreKeysAsUploaded(maxID))
+	if count, err = s.UploadedPreKeyCount(); err != nil {
+		must(t, "UploadedPreKeyCount", err)
+	} else if count != 10 {
+		violated(t, "MarkPreKeysAsUploaded must mark all keys up to the given ID as uploaded", "UploadedPreKeyCount is %d, expected 10", count)
+	}
+	newKeys, err := s.GetOrGenPreKeys(5)
+	must(t, "GetOrGenPreKeys", err)
+	for _, key := range newKeys {
+		if seen[key.KeyID] {
+			violated(t, "GetOrGenPreKeys must not return uploaded keys", "got old key %d", key.KeyID)
+		} else if key.KeyID <= maxID {
+			violated(t, "New prekey IDs must be greater than all previous IDs", "got %d after %d", key.KeyID, maxID)
+		}
+	}
+
+	stored, err := s.GetPreKey(preKeys[0].KeyID)
+	must(t, "GetPreKey", err)
+	if stored == nil {
+		violated(t, "GetPreKey must return stored keys", "key %d not found", preKeys[0].KeyID)
+	} else if *stored.Priv != *preKeys[0].Priv || *stored.Pub != *preKeys[0].Pub {
+		violated(t, "GetPreKey must return the same key pair that was generated", "key %d differs", preKeys[0].KeyID)
+	}
+	must(t, "RemovePreKey", s.RemovePreKey(preKeys[0].KeyID))
+	if stored, err = s.GetPreKey(preKeys[0].KeyID); err != nil {
+		must(t, "GetPreKey", err)
+	} else if stored != nil {
+		violated(t, "RemovePreKey must consume the key", "key %d still returned by GetPreKey", preKeys[0].KeyID)
+	}
+	if stored, err = s.GetPreKey(1 << 23); err != nil {
+		must(t, "GetPreKey", err)
+	} else if stored != nil {
+		violated(t, "GetPreKey must return nil for unknown IDs", "got key %d", stored.KeyID)
+	}
+
+	if count, err = s.UploadedPreKeyCount(); err != nil {
+		must(t, "UploadedPreKeyCount", err)
+	}
+	oneKey, err := s.GenOnePreKey()
+	must(t, "GenOnePreKey", err)
+	for _, key := range append(preKeys, newKeys...) {
+		if key.KeyID == oneKey.KeyID {
+			violated(t, "GenOnePreKey must generate a new key ID", "reused ID %d", key.KeyID)
+		}
+	}
+	if newCount, err := s.UploadedPreKeyCount(); err != nil {
+		must(t, "UploadedPreKeyCount", err)
+	} else if newCount != count+1 {
+		violated(t, "GenOnePreKey must mark the key as uploaded", "UploadedPreKeyCount went from %d to %d", count, newCount)
+	}
+}
+
+// testPreKeyImportStore tests that imported prekeys are stored with the given IDs and uploaded flag.
+func testPreKeyImportStore(t *testing.T, s store.AllStores) {
+	importer := s.(store.PreKeyImportStore)
+	uploaded := []*keys.PreKey{keys.NewPreKey(1), keys.NewPreKey(2)}
+	unuploaded := []*keys.PreKey{keys.NewPreKey(3)}
+	must(t, "ImportPreKeys", importer.ImportPreKeys(uploaded, true))
+	must(t, "ImportPreKeys", importer.ImportPreKeys(unuploaded, false))
+
+	for _, key := range append(uploaded, unuploaded...) {
+		stored, err := s.GetPreKey(key.KeyID)
+		must(t, "GetPreKey", err)
+		if stored == nil {
+			violated(t, "ImportPreKeys must store the keys", "key %d not found", key.KeyID)
+		} else if *stored.Priv != *key.Priv {
+			violated(t, "ImportPreKeys must store the given key pairs", "key %d differs", key.KeyID)
+		}
+	}
+	if count, err := s.UploadedPreKeyCount(); err != nil {
+		must(t, "UploadedPreKeyCount", err)
+	} else if count != len(uploaded) {
+		violated(t, "ImportPreKeys must store the uploaded flag", "UploadedPreKeyCount is %d, expected %d", count, len(uploaded))
+	}
+	next, err := s.GetOrGenPreKeys(2)
+	must(t, "GetOrGenPreKeys", err)
+	if len(next) != 2 || next[0].KeyID != 3 || next[1].KeyID <= 3 {
+		violated(t, "GetOrGenPreKeys must return imported unuploaded keys and generate new IDs after imported ones", "got %v", next)
+	}
+}
+
+// testSenderKeyStore tests sender key round-trips.
+func testSenderKeyStore(t *testing.T, s store.SenderKeyStore) {
+	const group, user = "123456789-123456@g.us", "1234567890:1"
+	key, err := s.GetSenderKey(group, user)
+	must(t, "GetSenderKey", err)
+	if key != nil {
+		violated(t, "GetSenderKey must return nil for unknown keys", "got %d bytes", len(key))
+	}
+	must(t, "PutSenderKey", s.PutSenderKey(group, user, []byte("first")))
+	must(t, "PutSenderKey", s.PutSenderKey(group, user, []byte("second")))
+	if key, err = s.GetSenderKey(group, user); err != nil {
+		must(t, "GetSenderKey", err)
+	} else if !bytes.Equal(key, []byte("second")) {
+		violated(t, "PutSenderKey must replace the existing key (upsert)", "got %q", key)
+	}
+	if key, err = s.GetSenderKey("987654321-987654@g.us", user); err != nil {
+		must(t, "GetSenderKey", err)
+	} else if key != nil {
+		violated(t, "Sender keys must be stored per group", "got key from another group")
+	}
+}
+
+// testAppStateSyncKeyStore tests app state sync key round-trips.
+func testAppStateSyncKeyStore(t *testing.T, s store.AppStateSyncKeyStore) {
+	keyID := []byte{0, 0, 1, 2}
+	key, err := s.GetAppStateSyncKey(keyID)
+	must(t, "GetAppStateSyncKey", err)
+	if key != nil {
+		violated(t, "GetAppStateSyncKey must return nil for unknown keys", "got %+v", key)
+	}
+	expected := store.AppStateSyncKey{Data: []byte("data"), Fingerprint: []byte("fingerprint"), Timestamp: 1640000000}
+	must(t, "PutAppStateSyncKey", s.PutAppStateSyncKey(keyID, store.AppStateSyncKey{Data: []byte("old"), Fingerprint: []byte("old")}))
+	must(t, "PutAppStateSyncKey", s.PutAppStateSyncKey(keyID, expected))
+	if key, err = s.GetAppStateSyncKey(keyID); err != nil {
+		must(t, "GetAppStateSyncKey", err)
+	} else if key == nil {
+		violated(t, "GetAppStateSyncKey must return stored keys", "key not found")
+	} else if !bytes.Equal(key.Data, expected.Data) || !bytes.Equal(key.Fingerprint, expected.Fingerprint) || key.Timestamp != expected.Timestamp {
+		violated(t, "PutAppStateSyncKey must replace the existing key (upsert)", "got %+v", key)
+	}
+}
+
+// testAppStateStore tests app state version, hash and mutation MAC persistence.
+func testAppStateStore(t *testing.T, s store.AppStateStore) {
+	const name = "regular_high"
+	version, hash, err := s.GetAppStateVersion(name)
+	must(t, "GetAppStateVersion", err)
+	if version != 0 || hash != [128]byte{} {
+		violated(t, "GetAppStateVersion must return version 0 and an empty hash for unknown states", "got version %d", version)
+	}
+	var expectedHash [128]byte
+	for i := range expectedHash {
+		expectedHash[i] = byte(i)
+	}
+	must(t, "PutAppStateVersion", s.PutAppStateVersion(name, 1, [128]byte{1}))
+	must(t, "PutAppStateVersion", s.PutAppStateVersion(name, 5, expectedHash))
+	if version, hash, err = s.GetAppStateVersion(name); err != nil {
+		must(t, "GetAppStateVersion", err)
+	} else if version != 5 || hash != expectedHash {
+		violated(t, "PutAppStateVersion must replace the existing version and hash (upsert)", "got version %d", version)
+	}
+
+	indexA, indexB := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	valueA1, valueA2, valueB := bytes.Repeat([]byte{3}, 32), bytes.Repeat([]byte{4}, 32), bytes.Repeat([]byte{5}, 32)
+	must(t, "PutAppStateMutationMACs", s.PutAppStateMutationMACs(name, 4, []store.AppStateMutationMAC{{IndexMAC: indexA, ValueMAC: valueA1}}))
+	must(t, "PutAppStateMutationMACs", s.PutAppStateMutationMACs(name, 5, []store.AppStateMutationMAC{
+		{IndexMAC: indexA, ValueMAC: valueA2},
+		{IndexMAC: indexB, ValueMAC: valueB},
+	}))
+	if valueMAC, err := s.GetAppStateMutationMAC(name, indexA); err != nil {
+		must(t, "GetAppStateMutationMAC", err)
+	} else if !bytes.Equal(valueMAC, valueA2) {
+		violated(t, "GetAppStateMutationMAC must return the value MAC from the latest version", "got %x", valueMAC)
+	}
+	must(t, "DeleteAppStateMutationMACs", s.DeleteAppStateMutationMACs(name, [][]byte{indexA}))
+	if valueMAC, err := s.GetAppStateMutationMAC(name, indexA); err != nil {
+		must(t, "GetAppStateMutationMAC", err)
+	} else if valueMAC != nil {
+		violated(t, "DeleteAppStateMutationMACs must delete all versions of the given index MACs", "got %x", valueMAC)
+	}
+	if valueMAC, err := s.GetAppStateMutationMAC(name, indexB); err != nil {
+		must(t, "GetAppStateMutationMAC", err)
+	} else if !bytes.Equal(valueMAC, valueB) {
+		violated(t, "DeleteAppStateMutationMACs must not delete other index MACs", "got %x", valueMAC)
+	}
+
+	must(t, "DeleteAppStateVersion", s.DeleteAppStateVersion(name))
+	if version, _, err = s.GetAppStateVersion(name); err != nil {
+		must(t, "GetAppStateVersion", err)
+	} else if version != 0 {
+		violated(t, "DeleteAppStateVersion must reset the version", "got version %d", version)
+	}
+}
+
+// testContactStore tests push name change detection and contact info merging.
+func testContactStore(t *testing.T, s store.ContactStore) {
+	user := types.NewJID("1234567890", types.DefaultUserServer)
+	contact, err := s.GetContact(user)
+	must(t, "GetContact", err)
+	if contact.Found {
+		violated(t, "GetContact must set Found to false for unknown contacts", "got %+v", contact)
+	}
+
+	changed, prev, err := s.PutPushName(user, "Alice")
+	must(t, "PutPushName", err)
+	if !changed || prev != "" {
+		violated(t, "PutPushName must report the first push name as a change", "got changed=%t, previous=%q", changed, prev)
+	}
+	if changed, _, err = s.PutPushName(user, "Alice"); err != nil {
+		must(t, "PutPushName", err)
+	} else if changed {
+		violated(t, "PutPushName must not report unchanged names as a change", "got changed=true")
+	}
+	if changed, prev, err = s.PutPushName(user, "Alicia"); err != nil {
+		must(t, "PutPushName", err)
+	} else if !changed || prev != "Alice" {
+		violated(t, "PutPushName must return the previous name when it changes", "got changed=%t, previous=%q", changed, prev)
+	}
+
+	must(t, "PutContactName", s.PutContactName(user, "Alice", "Alice Liddell"))
+	must(t, "PutBusinessName", s.PutBusinessName(user, "Wonderland Ltd"))
+	expected := types.ContactInfo{Found: true, FirstName: "Alice", FullName: "Alice Liddell", PushName: "Alicia", BusinessName: "Wonderland Ltd"}
+	if contact, err = s.GetContact(user); err != nil {
+		must(t, "GetContact", err)
+	} else if contact != expected {
+		violated(t, "Contact name setters must only update their own fields", "expected %+v, got %+v", expected, contact)
+	}
+
+	other := types.NewJID("1987654321", types.DefaultUserServer)
+	must(t, "PutContactName", s.PutContactName(other, "Bob", "Bob Builder"))
+	all, err := s.GetAllContacts()
+	must(t, "GetAllContacts", err)
+	if len(all) != 2 {
+		violated(t, "GetAllContacts must return all stored contacts", "expected 2 contacts, got %d", len(all))
+	} else if all[user] != expected {
+		violated(t, "GetAllContacts must return the same info as GetContact", "expected %+v, got %+v", expected, all[user])
+	}
+}
+
+// testChatSettingsStore tests that chat settings are stored independently of each other.
+func testChatSettingsStore(t *testing.T, s store.ChatSettingsStore) {
+	chat := types.NewJID("123456789-123456", types.GroupServer)
+	settings, err := s.GetChatSettings(chat)
+	must(t, "GetChatSettings", err)
+	if settings.Found {
+		violated(t, "GetChatSettings must set Found to false for unknown chats", "got %+v", settings)
+	}
+
+	mutedUntil := time.Unix(1640000000, 0)
+	must(t, "PutMutedUntil", s.PutMutedUntil(chat, mutedUntil))
+	must(t, "PutPinned", s.PutPinned(chat, true))
+	must(t, "PutArchived", s.PutArchived(chat, true))
+	must(t, "PutArchived", s.PutArchived(chat, false))
+	if settings, err = s.GetChatSettings(chat); err != nil {
+		must(t, "GetChatSettings", err)
+	} else if !settings.Found || !settings.MutedUntil.Equal(mutedUntil) || !settings.Pinned || settings.Archived {
+		violated(t, "Chat setting setters must only update their own fields", "got %+v", settings)
+	}
+
+	must(t, "PutMutedUntil", s.PutMutedUntil(chat, time.Time{}))
+	if settings, err = s.GetChatSettings(chat); err != nil {
+		must(t, "GetChatSettings", err)
+	} else if !settings.MutedUntil.IsZero() {
+		violated(t, "PutMutedUntil with a zero time must unmute the chat", "got %s", settings.MutedUntil)
+	}
+
+	if expirationStore, ok := s.(store.EphemeralExpirationStore); ok {
+		must(t, "PutEphemeralExpiration", expirationStore.PutEphemeralExpiration(chat, 86400))
+		if settings, err = s.GetChatSettings(chat); err != nil {
+			must(t, "GetChatSettings", err)
+		} else if settings.EphemeralExpiration != 86400 || !settings.Pinned {
+			violated(t, "PutEphemeralExpiration must only update the ephemeral expiration", "got %+v", settings)
+		}
+	}
+	if unreadStore, ok := s.(store.UnreadCountStore); ok {
+		testUnreadCountStore(t, s, unreadStore)
+	}
+}
+
+// testUnreadCountStore tests that unread counts are stored separately from other chat settings.
+func testUnreadCountStore(t *testing.T, s store.ChatSettingsStore, unreadStore store.UnreadCountStore) {
+	chat := types.NewJID("123456789-123456", types.GroupServer)
+	otherChat := types.NewJID("1234567890", types.DefaultUserServer)
+	must(t, "PutUnreadCount", unreadStore.PutUnreadCount(chat, 3))
+	must(t, "PutUnreadCount", unreadStore.PutUnreadCount(otherChat, -1))
+	settings, err := s.GetChatSettings(chat)
+	if err != nil {
+		must(t, "GetChatSettings", err)
+	} else if settings.UnreadCount != 3 || !settings.Pinned {
+		violated(t, "PutUnreadCount must only update the unread count", "got %+v", settings)
+	}
+	counts, err := unreadStore.GetUnreadCounts()
+	must(t, "GetUnreadCounts", err)
+	if len(counts) != 2 || counts[chat] != 3 || counts[otherChat] != -1 {
+		violated(t, "GetUnreadCounts must return all chats with a non-zero unread count", "got %v", counts)
+	}
+	must(t, "PutUnreadCount", unreadStore.PutUnreadCount(chat, 0))
+	if counts, err = unreadStore.GetUnreadCounts(); err != nil {
+		must(t, "GetUnreadCounts", err)
+	} else if _, ok := counts[chat]; ok || len(counts) != 1 {
+		violated(t, "GetUnreadCounts must not return chats with no unread messages", "got %v", counts)
+	}
+
+	if batchStore, ok := s.(store.UnreadCountBatchStore); ok {
+		must(t, "PutUnreadCounts", batchStore.PutUnreadCounts(map[types.JID]int{chat: 5, otherChat: 0}))
+		if counts, err = unreadStore.GetUnreadCounts(); err != nil {
+			must(t, "GetUnreadCounts", err)
+		} else if len(counts) != 1 || counts[chat] != 5 {
+			violated(t, "PutUnreadCounts must save all given unread counts", "got %v", counts)
+		}
+		if settings, err = s.GetChatSettings(chat); err != nil {
+			must(t, "GetChatSettings", err)
+		} else if !settings.Pinned || settings.UnreadCount != 5 {
+			violated(t, "PutUnreadCounts must only update the unread counts", "got %+v", settings)
+		}
+	}
+}
+
+// testMsgSecretStore tests that message secrets are keyed by chat, sender and message ID and never overwritten,
+// and that old secrets can be pruned if the store implements MsgSecretPruneStore.
+func testMsgSecretStore(t *testing.T, s store.MsgSecretStore) {
+	chat := types.NewJID("123456789-123456", types.GroupServer)
+	sender := types.NewJID("1234567890", types.DefaultUserServer)
+	secret, err := s.GetMessageSecret(chat, sender, "AAAA")
+	must(t, "GetMessageSecret", err)
+	if secret != nil {
+		violated(t, "GetMessageSecret must return nil for unknown messages", "got %x", secret)
+	}
+
+	secretA, secretB := key32(1), key32(2)
+	must(t, "PutMessageSecret", s.PutMessageSecret(chat, sender, "AAAA", secretA[:]))
+	must(t, "PutMessageSecret", s.PutMessageSecret(chat, sender, "AAAA", secretB[:]))
+	if secret, err = s.GetMessageSecret(chat, sender, "AAAA"); err != nil {
+		must(t, "GetMessageSecret", err)
+	} else if !bytes.Equal(secret, secretA[:]) {
+		violated(t, "PutMessageSecret must not overwrite existing secrets", "expected %x, got %x", secretA, secret)
+	}
+
+	otherSender := types.NewJID("1987654321", types.DefaultUserServer)
+	if secret, err = s.GetMessageSecret(chat, otherSender, "AAAA"); err != nil {
+		must(t, "GetMessageSecret", err)
+	} else if secret != nil {
+		violated(t, "GetMessageSecret must only return secrets of the given sender", "got %x", secret)
+	}
+
+	pruner, ok := s.(store.MsgSecretPruneStore)
+	if !ok {
+		return
+	}
+	must(t, "DeleteOldMessageSecrets", pruner.DeleteOldMessageSecrets(time.Now().Add(-time.Hour)))
+	if secret, err = s.GetMessageSecret(chat, sender, "AAAA"); err != nil {
+		must(t, "GetMessageSecret", err)
+	} else if secret == nil {
+		violated(t, "DeleteOldMessageSecrets must not remove newer secrets", "secret was removed")
+	}
+	must(t, "DeleteOldMessageSecrets", pruner.DeleteOldMessageSecrets(time.Now().Add(time.Hour)))
+	if secret, err = s.GetMessageSecret(chat, sender, "AAAA"); err != nil {
+		must(t, "GetMessageSecret", err)
+	} else if secret != nil {
+		violated(t, "DeleteOldMessageSecrets must remove older secrets", "got %x", secret)
+	}
+}
+
+// testOutboxStore tests that outbox messages are returned in queue order and that only the attempt info is updated.
+func testOutboxStore(t *testing.T, s store.OutboxStore) {
+	chat := types.NewJID("1234567890", types.DefaultUserServer)
+	queuedAt := time.Unix(1700000000, 0)
+	second := &store.OutboxMessage{ID: "BBBB", Chat: chat, Message: []byte{2}, QueuedAt: queuedAt.Add(time.Second), NextAttempt: queuedAt}
+	first := &store.OutboxMessage{ID: "AAAA", Chat: chat, Message: []byte{1}, QueuedAt: queuedAt, NextAttempt: queuedAt}
+	must(t, "PutOutboxMessage", s.PutOutboxMessage(second))
+	must(t, "PutOutboxMessage", s.PutOutboxMessage(first))
+	msgs, err := s.GetOutboxMessages()
+	must(t, "GetOutboxMessages", err)
+	if len(msgs) != 2 || msgs[0].ID != "AAAA" || msgs[1].ID != "BBBB" {
+		violated(t, "GetOutboxMessages must return messages in the order they were queued", "got %v", msgs)
+	} else if msgs[0].Chat != chat || !bytes.Equal(msgs[0].Message, []byte{1}) || !msgs[0].QueuedAt.Equal(queuedAt) {
+		violated(t, "GetOutboxMessages must return the stored fields", "got %+v", msgs[0])
+	}
+
+	if !msgs[0].SendAt.IsZero() {
+		violated(t, "GetOutboxMessages must return a zero SendAt for messages that weren't scheduled", "got %s", msgs[0].SendAt)
+	}
+
+	first.Attempts = 2
+	first.NextAttempt = queuedAt.Add(time.Minute)
+	first.SendAt = queuedAt.Add(time.Hour)
+	must(t, "PutOutboxMessage", s.PutOutboxMessage(first))
+	must(t, "DeleteOutboxMessage", s.DeleteOutboxMessage("BBBB"))
+	if msgs, err = s.GetOutboxMessages(); err != nil {
+		must(t, "GetOutboxMessages", err)
+	} else if len(msgs) != 1 {
+		violated(t, "DeleteOutboxMessage must remove the message", "got %d messages", len(msgs))
+	} else if msgs[0].Attempts != 2 || !msgs[0].NextAttempt.Equal(first.NextAttempt) || !msgs[0].SendAt.Equal(first.SendAt) {
+		violated(t, "PutOutboxMessage must update the attempt info and send time of existing messages", "got %+v", msgs[0])
+	}
+}
+
+// testSentMessageStore tests that sent messages are stored per chat and that old messages can be pruned.
+func testSentMessageStore(t *testing.T, s store.SentMessageStore) {
+	chat := types.NewJID("1234567890", types.DefaultUserServer)
+	otherChat := types.NewJID("0987654321", types.DefaultUserServer)
+	must(t, "PutSentMessage", s.PutSentMessage(chat, "AAAA", []byte{1, 2, 3}))
+	plaintext, err := s.GetSentMessage(chat, "AAAA")
+	must(t, "GetSentMessage", err)
+	if !bytes.Equal(plaintext, []byte{1, 2, 3}) {
+		violated(t, "GetSentMessage must return the stored plaintext", "got %v", plaintext)
+	}
+	plaintext, err = s.GetSentMessage(otherChat, "AAAA")
+	must(t, "GetSentMessage", err)
+	if plaintext != nil {
+		violated(t, "GetSentMessage must return nil for messages in other chats", "got %v", plaintext)
+	}
+
+	must(t, "DeleteOldSentMessages", s.DeleteOldSentMessages(time.Now().Add(-time.Hour)))
+	if plaintext, err = s.GetSentMessage(chat, "AAAA"); err != nil {
+		must(t, "GetSentMessage", err)
+	} else if plaintext == nil {
+		violated(t, "DeleteOldSentMessages must not remove newer messages", "message was removed")
+	}
+	must(t, "DeleteOldSentMessages", s.DeleteOldSentMessages(time.Now().Add(time.Hour)))
+	if plaintext, err = s.GetSentMessage(chat, "AAAA"); err != nil {
+		must(t, "GetSentMessage", err)
+	} else if plaintext != nil {
+		violated(t, "DeleteOldSentMessages must remove older messages", "got %v", plaintext)
+	}
+}
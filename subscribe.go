@@ -0,0 +1,82 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import "sync"
+
+// On registers an event handler that is only called for events of type *T. It returns the handler ID,
+// which can be passed to Client.RemoveEventHandler like the IDs returned by Client.AddEventHandler.
+//
+// All events are dispatched as pointers, so T should be the plain event struct type:
+//
+//	whatsmeow.On(cli, func(evt *events.Message) {
+//		fmt.Println("Received a message:", evt.Message.GetConversation())
+//	})
+func On[T any](cli *Client, handler func(*T)) uint32 {
+	return cli.AddEventHandler(func(evt interface{}) {
+		if typedEvt, ok := evt.(*T); ok {
+			handler(typedEvt)
+		}
+	})
+}
+
+// EventChannel returns a channel that receives all events, as an alternative to registering a callback
+// with AddEventHandler. The buffer parameter is the capacity of the channel.
+//
+// Events are sent to the channel from the event handler, so if the buffer is full, the handler blocks until
+// the application reads from the channel. When events are dispatched synchronously (the default), this also
+// blocks all other event handlers and incoming data handling, so applications should read from the channel
+// continuously, or use a large enough buffer to cover bursts like the offline message sync after connecting.
+//
+// Calling the returned cancel function stops sending events and closes the channel. Any events that were
+// already buffered can still be read after canceling. The cancel function must not be called from an event
+// handler for the same reasons as RemoveEventHandler. Like other handlers, the channel is not closed if
+// the handler is removed with RemoveEventHandlers.
+//
+//	evts, cancel := cli.EventChannel(100)
+//	defer cancel()
+//	for {
+//		select {
+//		case evt := <-evts:
+//			switch v := evt.(type) {
+//			case *events.Message:
+//				fmt.Println("Received a message from", v.Info.Sender)
+//			}
+//		case <-ctx.Done():
+//			return
+//		}
+//	}
+func (cli *Client) EventChannel(buffer int) (<-chan interface{}, func()) {
+	ch := make(chan interface{}, buffer)
+	done := make(chan struct{})
+	var lock sync.RWMutex
+	var closed bool
+	id := cli.AddEventHandler(func(evt interface{}) {
+		lock.RLock()
+		defer lock.RUnlock()
+		if closed {
+			return
+		}
+		select {
+		case ch <- evt:
+		case <-done:
+		}
+	})
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			// Unblock handlers waiting for buffer space before removing the handler, as
+			// RemoveEventHandler waits for synchronous dispatches to finish.
+			close(done)
+			cli.RemoveEventHandler(id)
+			lock.Lock()
+			closed = true
+			close(ch)
+			lock.Unlock()
+		})
+	}
+}
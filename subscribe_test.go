@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+func TestOn(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	var messages []*events.Message
+	id := On(cli, func(evt *events.Message) {
+		messages = append(messages, evt)
+	})
+	msg := &events.Message{}
+	cli.dispatchEvent(&events.Receipt{})
+	cli.dispatchEvent(msg)
+	// Non-pointer events must not match
+	cli.dispatchEvent(events.Message{})
+	if len(messages) != 1 || messages[0] != msg {
+		t.Errorf("Expected only the message event, got %v", messages)
+	}
+	if !cli.RemoveEventHandler(id) {
+		t.Errorf("Failed to remove handler registered with On")
+	}
+	cli.dispatchEvent(msg)
+	if len(messages) != 1 {
+		t.Errorf("Handler was called after removing it")
+	}
+}
+
+func TestEventChannel(t *testing.T) {
+	cli := &Client{Log: waLog.Noop}
+	evts, cancel := cli.EventChannel(1)
+	msg := &events.Message{}
+	cli.dispatchEvent(msg)
+	if evt := <-evts; evt != msg {
+		t.Errorf("Expected %v from channel, got %v", msg, evt)
+	}
+
+	// Fill the buffer and block the next dispatch, then make sure canceling unblocks it
+	cli.dispatchEvent(msg)
+	dispatched := make(chan struct{})
+	go func() {
+		cli.dispatchEvent(&events.Receipt{})
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+		t.Fatalf("Dispatch didn't block with full buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	<-dispatched
+	cancel()
+
+	if evt, ok := <-evts; !ok || evt != msg {
+		t.Errorf("Expected buffered event after canceling, got %v", evt)
+	}
+	if evt, ok := <-evts; ok {
+		t.Errorf("Expected channel to be closed, got %v", evt)
+	}
+	if len(cli.eventHandlers) != 0 {
+		t.Errorf("Expected handler to be removed after canceling")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+type stubParamKind int
+
+const (
+	stubParamsNone stubParamKind = iota
+	// All parameters are user JIDs.
+	stubParamsParticipants
+	// The first parameter is a plain value like a group name.
+	stubParamsValue
+)
+
+type systemMessageStub struct {
+	typ    events.SystemMessageType
+	params stubParamKind
+}
+
+var systemMessageStubs = map[waProto.WebMessageInfo_WebMessageInfoStubType]systemMessageStub{
+	waProto.WebMessageInfo_REVOKE: {events.SystemMessageRevoke, stubParamsNone},
+
+	waProto.WebMessageInfo_GROUP_CREATE:                    {events.SystemMessageGroupCreate, stubParamsValue},
+	waProto.WebMessageInfo_GROUP_DELETE:                    {events.SystemMessageGroupDelete, stubParamsNone},
+	waProto.WebMessageInfo_GROUP_CHANGE_SUBJECT:            {events.SystemMessageGroupChangeSubject, stubParamsValue},
+	waProto.WebMessageInfo_GROUP_CHANGE_ICON:               {events.SystemMessageGroupChangeIcon, stubParamsNone},
+	waProto.WebMessageInfo_GROUP_CHANGE_INVITE_LINK:        {events.SystemMessageGroupChangeInviteLink, stubParamsNone},
+	waProto.WebMessageInfo_GROUP_CHANGE_DESCRIPTION:        {events.SystemMessageGroupChangeDescription, stubParamsValue},
+	waProto.WebMessageInfo_GROUP_CHANGE_RESTRICT:           {events.SystemMessageGroupChangeRestrict, stubParamsValue},
+	waProto.WebMessageInfo_GROUP_CHANGE_ANNOUNCE:           {events.SystemMessageGroupChangeAnnounce, stubParamsValue},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_ADD:           {events.SystemMessageParticipantAdd, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_REMOVE:        {events.SystemMessageParticipantRemove, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_PROMOTE:       {events.SystemMessageParticipantPromote, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_DEMOTE:        {events.SystemMessageParticipantDemote, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_INVITE:        {events.SystemMessageParticipantInvite, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_LEAVE:         {events.SystemMessageParticipantLeave, stubParamsParticipants},
+	waProto.WebMessageInfo_GROUP_PARTICIPANT_CHANGE_NUMBER: {events.SystemMessageParticipantChangeNumber, stubParamsParticipants},
+	waProto.WebMessageInfo_INDIVIDUAL_CHANGE_NUMBER:        {events.SystemMessageIndividualChangeNumber, stubParamsParticipants},
+
+	waProto.WebMessageInfo_CALL_MISSED_VOICE:       {events.SystemMessageCallMissedVoice, stubParamsNone},
+	waProto.WebMessageInfo_CALL_MISSED_VIDEO:       {events.SystemMessageCallMissedVideo, stubParamsNone},
+	waProto.WebMessageInfo_CALL_MISSED_GROUP_VOICE: {events.SystemMessageCallMissedGroupVoice, stubParamsNone},
+	waProto.WebMessageInfo_CALL_MISSED_GROUP_VIDEO: {events.SystemMessageCallMissedGroupVideo, stubParamsNone},
+
+	waProto.WebMessageInfo_E2E_ENCRYPTED:        {events.SystemMessageE2EEncrypted, stubParamsNone},
+	waProto.WebMessageInfo_E2E_IDENTITY_CHANGED: {events.SystemMessageE2EIdentityChanged, stubParamsParticipants},
+	waProto.WebMessageInfo_E2E_DEVICE_CHANGED:   {events.SystemMessageE2EDeviceChanged, stubParamsParticipants},
+
+	waProto.WebMessageInfo_CHANGE_EPHEMERAL_SETTING: {events.SystemMessageChangeEphemeralSetting, stubParamsValue},
+	waProto.WebMessageInfo_BLOCK_CONTACT:            {events.SystemMessageBlockContact, stubParamsValue},
+}
+
+// ParseSystemMessage parses a message stub (e.g. from a history sync) into a SystemMessage event.
+//
+// This returns nil if the message isn't a stub. Stub types that aren't parsed are returned with the
+// type SystemMessageUnknown, and the raw stub type and parameters can be used to handle them manually.
+func (cli *Client) ParseSystemMessage(chat types.JID, webMsg *waProto.WebMessageInfo) *events.SystemMessage {
+	if webMsg.GetMessageStubType() == waProto.WebMessageInfo_UNKNOWN {
+		return nil
+	}
+	evt := &events.SystemMessage{
+		Chat:      chat,
+		ID:        webMsg.GetKey().GetId(),
+		Timestamp: time.Unix(int64(webMsg.GetMessageTimestamp()), 0),
+		Type:      events.SystemMessageUnknown,
+		RawType:   webMsg.GetMessageStubType(),
+		Params:    webMsg.GetMessageStubParameters(),
+	}
+	if webMsg.GetKey().GetFromMe() && cli.Store.ID != nil {
+		evt.Sender = cli.Store.ID.ToNonAD()
+	} else if len(webMsg.GetParticipant()) > 0 {
+		evt.Sender, _ = types.ParseJID(webMsg.GetParticipant())
+	} else if len(webMsg.GetKey().GetParticipant()) > 0 {
+		evt.Sender, _ = types.ParseJID(webMsg.GetKey().GetParticipant())
+	} else if chat.Server == types.DefaultUserServer {
+		evt.Sender = chat
+	}
+	stub, ok := systemMessageStubs[evt.RawType]
+	if !ok {
+		return evt
+	}
+	evt.Type = stub.typ
+	switch stub.params {
+	case stubParamsParticipants:
+		evt.Participants = make([]types.JID, 0, len(evt.Params))
+		for _, param := range evt.Params {
+			jid, err := types.ParseJID(param)
+			if err != nil {
+				cli.Log.Debugf("Failed to parse participant %q in %s system message %s: %v", param, evt.Type, evt.ID, err)
+				continue
+			}
+			evt.Participants = append(evt.Participants, jid)
+		}
+	case stubParamsValue:
+		if len(evt.Params) > 0 {
+			evt.Value = evt.Params[0]
+		}
+	}
+	return evt
+}
+
+// dispatchHistorySyncSystemMessages emits SystemMessage events for all message stubs in a history sync.
+func (cli *Client) dispatchHistorySyncSystemMessages(historySync *waProto.HistorySync) {
+	for _, conv := range historySync.GetConversations() {
+		chat, err := types.ParseJID(conv.GetId())
+		if err != nil {
+			cli.Log.Debugf("Failed to parse chat ID %q in history sync: %v", conv.GetId(), err)
+			continue
+		}
+		for _, msg := range conv.GetMessages() {
+			evt := cli.ParseSystemMessage(chat, msg.GetMessage())
+			if evt != nil {
+				cli.dispatchEvent(evt)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestParseSystemMessage(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 1)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+	group := types.NewJID("123456789-123456", types.GroupServer)
+	alice := types.NewJID("1111111111", types.DefaultUserServer)
+	bob := types.NewJID("2222222222", types.DefaultUserServer)
+	stub := func(stubType waProto.WebMessageInfo_WebMessageInfoStubType, fromMe bool, participant string, params ...string) *waProto.WebMessageInfo {
+		return &waProto.WebMessageInfo{
+			Key:                   &waProto.MessageKey{RemoteJid: proto.String(group.String()), FromMe: proto.Bool(fromMe), Id: proto.String("STUB")},
+			MessageTimestamp:      proto.Uint64(1640000000),
+			Participant:           proto.String(participant),
+			MessageStubType:       stubType.Enum(),
+			MessageStubParameters: params,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		msg          *waProto.WebMessageInfo
+		typ          events.SystemMessageType
+		sender       types.JID
+		participants []types.JID
+		value        string
+	}{
+		{"participant add", stub(waProto.WebMessageInfo_GROUP_PARTICIPANT_ADD, false, alice.String(), bob.String()), events.SystemMessageParticipantAdd, alice, []types.JID{bob}, ""},
+		{"own participant remove", stub(waProto.WebMessageInfo_GROUP_PARTICIPANT_REMOVE, true, "", alice.String(), bob.String()), events.SystemMessageParticipantRemove, ownID.ToNonAD(), []types.JID{alice, bob}, ""},
+		{"subject change", stub(waProto.WebMessageInfo_GROUP_CHANGE_SUBJECT, false, alice.String(), "New name"), events.SystemMessageGroupChangeSubject, alice, nil, "New name"},
+		{"missed call", stub(waProto.WebMessageInfo_CALL_MISSED_GROUP_VOICE, false, bob.String()), events.SystemMessageCallMissedGroupVoice, bob, nil, ""},
+		{"unknown type", stub(waProto.WebMessageInfo_BIZ_NAME_CHANGE, false, bob.String(), "raw"), events.SystemMessageUnknown, bob, nil, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			evt := cli.ParseSystemMessage(group, test.msg)
+			if evt == nil {
+				t.Fatalf("Stub wasn't parsed")
+			}
+			if evt.Type != test.typ || evt.RawType != test.msg.GetMessageStubType() {
+				t.Errorf("Expected type %s, got %s (raw %s)", test.typ, evt.Type, evt.RawType)
+			}
+			if evt.Sender != test.sender {
+				t.Errorf("Expected sender %s, got %s", test.sender, evt.Sender)
+			}
+			if !reflect.DeepEqual(evt.Participants, test.participants) && (len(evt.Participants) > 0 || len(test.participants) > 0) {
+				t.Errorf("Expected participants %v, got %v", test.participants, evt.Participants)
+			}
+			if evt.Value != test.value {
+				t.Errorf("Expected value %q, got %q", test.value, evt.Value)
+			}
+			if !reflect.DeepEqual(evt.Params, test.msg.GetMessageStubParameters()) {
+				t.Errorf("Raw params weren't passed through: %v", evt.Params)
+			}
+		})
+	}
+
+	if evt := cli.ParseSystemMessage(group, &waProto.WebMessageInfo{Key: &waProto.MessageKey{Id: proto.String("MSG")}}); evt != nil {
+		t.Errorf("Expected normal message not to be parsed as a stub, got %+v", evt)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+// ConnectionState is the state of the connection to the WhatsApp servers, as returned by Client.GetConnectionState.
+type ConnectionState string
+
+const (
+	// ConnectionStateDisconnected means there's no connection and no automatic reconnection is pending.
+	ConnectionStateDisconnected ConnectionState = "disconnected"
+	// ConnectionStateConnecting means the websocket is being opened.
+	ConnectionStateConnecting ConnectionState = "connecting"
+	// ConnectionStateHandshaking means the websocket is open and the noise handshake is in progress.
+	ConnectionStateHandshaking ConnectionState = "handshaking"
+	// ConnectionStateAuthenticating means the handshake is done and the client is waiting for the server to
+	// accept the login (or for the QR code to be scanned, if the device isn't paired yet).
+	ConnectionStateAuthenticating ConnectionState = "authenticating"
+	// ConnectionStateConnected means the client is connected and logged in.
+	ConnectionStateConnected ConnectionState = "connected"
+	// ConnectionStateReconnecting means the connection was lost and the client is waiting to reconnect automatically.
+	ConnectionStateReconnecting ConnectionState = "reconnecting"
+)
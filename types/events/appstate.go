@@ -76,6 +76,30 @@ type Archive struct {
 	Action *waProto.ArchiveChatAction // The current archival status of the chat.
 }
 
+// MarkChatAsRead is emitted when a whole chat is marked as read or unread from another device.
+type MarkChatAsRead struct {
+	JID       types.JID // The chat which was marked as read or unread.
+	Timestamp time.Time // The time when the marking happened.
+
+	Action *waProto.MarkChatAsReadAction // Whether the chat was marked as read or unread, and the message range.
+}
+
+// ClearChat is emitted when a chat is cleared on another device.
+type ClearChat struct {
+	JID       types.JID // The chat which was cleared.
+	Timestamp time.Time // The time when the clear happened.
+
+	Action *waProto.ClearChatAction // Information about the clear.
+}
+
+// DeleteChat is emitted when a chat is deleted on another device.
+type DeleteChat struct {
+	JID       types.JID // The chat which was deleted.
+	Timestamp time.Time // The time when the deletion happened.
+
+	Action *waProto.DeleteChatAction // Information about the deletion.
+}
+
 // PushNameSetting is emitted when the user's push name is changed from another device.
 type PushNameSetting struct {
 	Timestamp time.Time // The time when the push name was changed.
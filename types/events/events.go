@@ -13,6 +13,7 @@ import (
 
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/internal/ctxinfoutil"
 	"go.mau.fi/whatsmeow/types"
 )
 
@@ -31,13 +32,25 @@ type QR struct {
 
 // PairSuccess is emitted after the QR code has been scanned with the phone and the handshake has
 // been completed. Note that this is generally followed by a websocket reconnection, so you should
-// wait for the Connected before trying to send anything.
+// wait for the Connected or PairComplete event before trying to send anything.
 type PairSuccess struct {
 	ID           types.JID
 	BusinessName string
 	Platform     string
 }
 
+// PairComplete is emitted after PairSuccess, once the client has reconnected with the newly paired session and
+// the Connected event has been dispatched. After this event, the session is ready for use.
+//
+// For business accounts, VerifiedName contains the verified name certificate of the account, and BusinessName
+// is updated to the verified name if it's different from the name in the pair success message.
+type PairComplete struct {
+	ID           types.JID
+	BusinessName string
+	Platform     string
+	VerifiedName *types.VerifiedName
+}
+
 // PairError is emitted when a pair-success event is received from the server, but finishing the pairing locally fails.
 type PairError struct {
 	ID           types.JID
@@ -46,14 +59,23 @@ type PairError struct {
 	Error        error
 }
 
+// ManualLoginReconnect is emitted when the server asks the client to reconnect (stream error 515, which
+// usually happens right after pairing), but automatic reconnection is disabled (Client.EnableAutoReconnect
+// or Client.AutoReconnectAfterPair is false). The client has already disconnected when this is emitted,
+// and Connect() must be called manually to continue.
+type ManualLoginReconnect struct{}
+
 // QRScannedWithoutMultidevice is emitted when the pairing QR code is scanned, but the phone didn't have multidevice enabled.
 // The same QR code can still be scanned after this event, which means the user can just be told to enable multidevice and re-scan the code.
 type QRScannedWithoutMultidevice struct{}
 
 // Connected is emitted when the client has successfully connected to the WhatsApp servers
 // and is authenticated. The user who the client is authenticated as will be in the device store
-// at this point, which is why this event doesn't contain any data.
-type Connected struct{}
+// at this point, which is why this event doesn't contain any user data.
+type Connected struct {
+	// The websocket URL that the client connected to.
+	Endpoint string
+}
 
 // LoggedOut is emitted when the client has been unpaired from the phone.
 //
@@ -62,6 +84,9 @@ type LoggedOut struct {
 	// OnConnect is true if the event was triggered by a connect failure message.
 	// If it's false, the event was triggered by a stream:error message.
 	OnConnect bool
+	// Reason is the reason code of the connect failure (e.g. "401") or the conflict type of the stream error
+	// (e.g. "device_removed").
+	Reason string
 }
 
 // StreamReplaced is emitted when the client is disconnected by another client connecting with the same keys.
@@ -72,12 +97,62 @@ type StreamReplaced struct{}
 
 // ConnectFailure is emitted when the WhatsApp server sends a <failure> node with an unknown reason.
 //
-// Known reasons are handled internally and emitted as different events (e.g. LoggedOut).
+// Known reasons are handled internally and emitted as different events (e.g. LoggedOut, TemporaryBan or ClientOutdated).
 type ConnectFailure struct {
 	Reason string
 	Raw    *waBinary.Node
 }
 
+// TempBanReason is the reason code of a temporary ban.
+type TempBanReason int
+
+const (
+	TempBanSentToTooManyPeople    TempBanReason = 101
+	TempBanBlockedByUsers         TempBanReason = 102
+	TempBanCreatedTooManyGroups   TempBanReason = 103
+	TempBanSentTooManySameMessage TempBanReason = 104
+	TempBanBroadcastList          TempBanReason = 106
+)
+
+var tempBanReasonMessage = map[TempBanReason]string{
+	TempBanSentToTooManyPeople:    "you sent too many messages to people who don't have you in their address books",
+	TempBanBlockedByUsers:         "too many people blocked you",
+	TempBanCreatedTooManyGroups:   "you created too many groups with people who don't have you in their address books",
+	TempBanSentTooManySameMessage: "you sent the same message to too many people",
+	TempBanBroadcastList:          "you sent too many messages to a broadcast list",
+}
+
+// String returns the reason code and a human-readable description of the ban reason.
+func (tbr TempBanReason) String() string {
+	msg, ok := tempBanReasonMessage[tbr]
+	if !ok {
+		msg = "you may have violated the terms of service (unknown error)"
+	}
+	return fmt.Sprintf("%d: %s", int(tbr), msg)
+}
+
+// TemporaryBan is emitted when there's a connection failure with the 402 or 403 reason code,
+// which means the account has been banned. The client will not reconnect automatically.
+type TemporaryBan struct {
+	// The failure reason code from the server, i.e. 402 or 403.
+	Reason string
+	// The ban reason code, if the server sent one.
+	Code TempBanReason
+	// How long the ban lasts from when the event was received. Zero means the server didn't say.
+	Expire time.Duration
+}
+
+func (tb *TemporaryBan) String() string {
+	if tb.Expire == 0 {
+		return fmt.Sprintf("You've been banned (reason %s)", tb.Code)
+	}
+	return fmt.Sprintf("You've been temporarily banned (reason %s), the ban expires in %s", tb.Code, tb.Expire)
+}
+
+// ClientOutdated is emitted when there's a connection failure with the 405 reason code,
+// which means the WhatsApp web version used by the library is too old. Updating whatsmeow usually fixes it.
+type ClientOutdated struct{}
+
 // StreamError is emitted when the WhatsApp server sends a <stream:error> node with an unknown code.
 //
 // Known codes are handled internally and emitted as different events (e.g. LoggedOut).
@@ -89,6 +164,48 @@ type StreamError struct {
 // Disconnected is emitted when the websocket is closed by the server.
 type Disconnected struct{}
 
+// ConnectionStateChanged is emitted when the state of the connection changes, see Client.GetConnectionState.
+//
+// The events are always emitted in order, but the state may have already changed again by the time a handler is called.
+type ConnectionStateChanged struct {
+	Previous types.ConnectionState
+	State    types.ConnectionState
+}
+
+// HandlerQueueOverflow is emitted when the queue of incoming nodes waiting to be handled is full, which usually means
+// that event handlers are too slow. It's only emitted again after the queue has had free space in between.
+type HandlerQueueOverflow struct {
+	// The capacity of the queue.
+	QueueSize int
+	// The overflow policy that is being applied (see whatsmeow.HandlerQueueOverflowPolicy).
+	Policy string
+}
+
+// Reconnecting is emitted before each automatic reconnection attempt, after the PreReconnectHook has allowed it.
+type Reconnecting struct {
+	// The number of the attempt, starting from 1. This is reset after a successful connection.
+	Attempt int
+	// How long the client will wait before connecting.
+	Delay time.Duration
+	// The error returned by the previous attempt, or nil if this is the first attempt.
+	LastError error
+}
+
+// KeepAliveTimeout is emitted when the keepalive ping request to WhatsApp web servers times out.
+//
+// The connection may start working again on its own, in which case KeepAliveRestored is emitted. If
+// Client.KeepAliveMaxMissed is set, the websocket is closed and reconnected after that many timeouts in a row.
+// Otherwise, clients may use this event to decide to force a disconnect+reconnect faster.
+type KeepAliveTimeout struct {
+	// The number of keepalive pings that have timed out in a row.
+	ErrorCount int
+	// The time when the last keepalive ping succeeded (or when the connection was opened).
+	LastSuccess time.Time
+}
+
+// KeepAliveRestored is emitted if the keepalive pings start working again after some KeepAliveTimeout events.
+type KeepAliveRestored struct{}
+
 // HistorySync is emitted when the phone has sent a blob of historical messages.
 type HistorySync struct {
 	Data *waProto.HistorySync
@@ -106,6 +223,44 @@ type UndecryptableMessage struct {
 	// IsUnavailable is true if the recipient device didn't send a ciphertext to this device at all
 	// (as opposed to sending a ciphertext, but the ciphertext not being decryptable).
 	IsUnavailable bool
+
+	// The reason why decrypting failed. This is empty for unavailable messages.
+	FailMode DecryptFailMode
+	// The error returned by the decryption. This is nil for unavailable messages.
+	Error error
+	// The type of the ciphertext that couldn't be decrypted (pkmsg, msg or skmsg).
+	CiphertextType string
+	// RetryReceiptSent is true if a retry receipt is being sent to ask the sender to re-send the message.
+	// It's false if the retry limit (Client.MaxRetryReceipts) has been reached.
+	RetryReceiptSent bool
+}
+
+// DecryptFailMode describes why an incoming message couldn't be decrypted.
+type DecryptFailMode string
+
+const (
+	// DecryptFailNoSession means there's no Signal session or sender key for the sender, e.g. because the sender
+	// key distribution message was never received.
+	DecryptFailNoSession DecryptFailMode = "no_session"
+	// DecryptFailBadMAC means the ciphertext didn't match any existing session, which usually means that the
+	// session is out of sync with the sender.
+	DecryptFailBadMAC DecryptFailMode = "bad_mac"
+	// DecryptFailDuplicate means the message counter was already used, which usually means that the message
+	// has already been decrypted once.
+	DecryptFailDuplicate DecryptFailMode = "duplicate"
+	// DecryptFailUnsupportedVersion means the message was encrypted with an unsupported Signal protocol version.
+	DecryptFailUnsupportedVersion DecryptFailMode = "unsupported_version"
+	// DecryptFailUnknown is used for all other errors.
+	DecryptFailUnknown DecryptFailMode = "unknown"
+)
+
+// RetryReceiptLimitReached is emitted when a message still can't be decrypted after sending the maximum number of
+// retry receipts (Client.MaxRetryReceipts). No more retry receipts are sent for the message after this.
+type RetryReceiptLimitReached struct {
+	Info types.MessageInfo
+
+	// The number of retry receipts that were sent for the message.
+	RetryCount int
 }
 
 // Message is emitted when receiving a new message.
@@ -116,9 +271,76 @@ type Message struct {
 	IsEphemeral bool // True if the message was unwrapped from an EphemeralMessage
 	IsViewOnce  bool // True if the message was unwrapped from a ViewOnceMessage
 
+	IsDocumentWithCaption bool // True if the message was unwrapped from a DocumentWithCaptionMessage
+
 	// The raw message struct. This is the raw unmodified data, which means the actual message might
-	// be wrapped in DeviceSentMessage, EphemeralMessage or ViewOnceMessage.
+	// be wrapped in DeviceSentMessage, EphemeralMessage, ViewOnceMessage or DocumentWithCaptionMessage.
 	RawMessage *waProto.Message
+
+	// If the message was resent by the primary device in response to a request built with
+	// Client.BuildUnavailableMessageRequest, this is the ID of the request message.
+	UnavailableRequestID types.MessageID
+}
+
+// StickerPack contains the parsed contents of a sticker pack message.
+type StickerPack struct {
+	ID          string
+	Name        string
+	Publisher   string
+	Description string
+	Stickers    []StickerPackItem
+}
+
+// StickerPackItem is a single sticker in a StickerPack.
+type StickerPackItem struct {
+	// The name of the sticker file in the pack archive, which is the key in the map returned by
+	// Client.DownloadStickerPack.
+	FileName           string
+	Mimetype           string
+	IsAnimated         bool
+	IsLottie           bool
+	Emojis             []string
+	AccessibilityLabel string
+}
+
+// GetStickerPack returns the parsed contents of the sticker pack in the message, or nil if the message isn't a sticker pack.
+// The sticker files themselves are in an archive that can be downloaded with Client.DownloadStickerPack.
+func (evt *Message) GetStickerPack() *StickerPack {
+	packMsg := evt.Message.GetStickerPackMessage()
+	if packMsg == nil {
+		return nil
+	}
+	pack := &StickerPack{
+		ID:          packMsg.GetStickerPackId(),
+		Name:        packMsg.GetName(),
+		Publisher:   packMsg.GetPublisher(),
+		Description: packMsg.GetPackDescription(),
+		Stickers:    make([]StickerPackItem, len(packMsg.GetStickers())),
+	}
+	for i, sticker := range packMsg.GetStickers() {
+		pack.Stickers[i] = StickerPackItem{
+			FileName:           sticker.GetFileName(),
+			Mimetype:           sticker.GetMimetype(),
+			IsAnimated:         sticker.GetIsAnimated(),
+			IsLottie:           sticker.GetIsLottie(),
+			Emojis:             sticker.GetEmojis(),
+			AccessibilityLabel: sticker.GetAccessibilityLabel(),
+		}
+	}
+	return pack
+}
+
+// GetMentionedJIDs returns the users mentioned in the message.
+func (evt *Message) GetMentionedJIDs() []types.JID {
+	ctxInfo := ctxinfoutil.Find(evt.Message, false)
+	mentions := make([]types.JID, 0, len(ctxInfo.GetMentionedJid()))
+	for _, jidStr := range ctxInfo.GetMentionedJid() {
+		jid, err := types.ParseJID(jidStr)
+		if err == nil {
+			mentions = append(mentions, jid)
+		}
+	}
+	return mentions
 }
 
 // ReceiptType represents the type of a Receipt event.
@@ -127,12 +349,26 @@ type ReceiptType string
 const (
 	// ReceiptTypeDelivered means the message was delivered to the device (but the user might not have noticed).
 	ReceiptTypeDelivered ReceiptType = ""
+	// ReceiptTypeSender means a message that the current user sent from a different device was delivered to
+	// another one of the user's devices. In private chats, Chat is the original recipient of the message.
+	ReceiptTypeSender ReceiptType = "sender"
+	// ReceiptTypeInactive means the message was delivered to the device, but the user wasn't active at the time,
+	// e.g. because the app was running in the background.
+	ReceiptTypeInactive ReceiptType = "inactive"
 	// ReceiptTypeRetry means the message was delivered to the device, but decrypting the message failed.
 	ReceiptTypeRetry ReceiptType = "retry"
 	// ReceiptTypeRead means the user opened the chat and saw the message.
 	ReceiptTypeRead ReceiptType = "read"
 	// ReceiptTypeReadSelf means the current user read a message from a different device, and has read receipts disabled in privacy settings.
 	ReceiptTypeReadSelf ReceiptType = "read-self"
+	// ReceiptTypePlayed means the user played a voice message or opened a view-once media message.
+	//
+	// The official clients show played voice messages with a blue microphone icon. A read receipt for a voice
+	// message only turns the checkmarks blue, the microphone stays grey until a played receipt is received.
+	ReceiptTypePlayed ReceiptType = "played"
+	// ReceiptTypePlayedSelf means the current user played a voice message or opened a view-once media message
+	// from a different device, and has read receipts disabled in privacy settings.
+	ReceiptTypePlayedSelf ReceiptType = "played-self"
 )
 
 // GoString returns the name of the Go constant for the ReceiptType value.
@@ -142,8 +378,18 @@ func (rt ReceiptType) GoString() string {
 		return "events.ReceiptTypeRead"
 	case ReceiptTypeReadSelf:
 		return "events.ReceiptTypeReadSelf"
+	case ReceiptTypePlayed:
+		return "events.ReceiptTypePlayed"
+	case ReceiptTypePlayedSelf:
+		return "events.ReceiptTypePlayedSelf"
 	case ReceiptTypeDelivered:
 		return "events.ReceiptTypeDelivered"
+	case ReceiptTypeSender:
+		return "events.ReceiptTypeSender"
+	case ReceiptTypeInactive:
+		return "events.ReceiptTypeInactive"
+	case ReceiptTypeRetry:
+		return "events.ReceiptTypeRetry"
 	default:
 		return fmt.Sprintf("events.ReceiptType(%#v)", string(rt))
 	}
@@ -151,6 +397,9 @@ func (rt ReceiptType) GoString() string {
 
 // Receipt is emitted when an outgoing message is delivered to or read by another user, or when another device reads an incoming message.
 //
+// In group chats, Sender is the participant whose device sent the receipt. The server may combine the receipts of
+// multiple participants into one stanza, in which case a separate Receipt event is emitted for each participant.
+//
 // N.B. WhatsApp on Android sends message IDs from newest message to oldest, but WhatsApp on iOS sends them in the opposite order (oldest first).
 type Receipt struct {
 	types.MessageSource
@@ -159,6 +408,239 @@ type Receipt struct {
 	Type       ReceiptType
 }
 
+// SystemMessageType is the type of a SystemMessage event.
+type SystemMessageType string
+
+// The system message types that are parsed into structured events. Other stub types are emitted with SystemMessageUnknown.
+const (
+	SystemMessageUnknown SystemMessageType = "unknown"
+
+	SystemMessageRevoke SystemMessageType = "revoke"
+
+	SystemMessageGroupCreate             SystemMessageType = "group_create"
+	SystemMessageGroupDelete             SystemMessageType = "group_delete"
+	SystemMessageGroupChangeSubject      SystemMessageType = "group_change_subject"
+	SystemMessageGroupChangeIcon         SystemMessageType = "group_change_icon"
+	SystemMessageGroupChangeInviteLink   SystemMessageType = "group_change_invite_link"
+	SystemMessageGroupChangeDescription  SystemMessageType = "group_change_description"
+	SystemMessageGroupChangeRestrict     SystemMessageType = "group_change_restrict"
+	SystemMessageGroupChangeAnnounce     SystemMessageType = "group_change_announce"
+	SystemMessageParticipantAdd          SystemMessageType = "participant_add"
+	SystemMessageParticipantRemove       SystemMessageType = "participant_remove"
+	SystemMessageParticipantPromote      SystemMessageType = "participant_promote"
+	SystemMessageParticipantDemote       SystemMessageType = "participant_demote"
+	SystemMessageParticipantInvite       SystemMessageType = "participant_invite"
+	SystemMessageParticipantLeave        SystemMessageType = "participant_leave"
+	SystemMessageParticipantChangeNumber SystemMessageType = "participant_change_number"
+	SystemMessageIndividualChangeNumber  SystemMessageType = "individual_change_number"
+
+	SystemMessageCallMissedVoice      SystemMessageType = "call_missed_voice"
+	SystemMessageCallMissedVideo      SystemMessageType = "call_missed_video"
+	SystemMessageCallMissedGroupVoice SystemMessageType = "call_missed_group_voice"
+	SystemMessageCallMissedGroupVideo SystemMessageType = "call_missed_group_video"
+
+	SystemMessageE2EEncrypted       SystemMessageType = "e2e_encrypted"
+	SystemMessageE2EIdentityChanged SystemMessageType = "e2e_identity_changed"
+	SystemMessageE2EDeviceChanged   SystemMessageType = "e2e_device_changed"
+
+	SystemMessageChangeEphemeralSetting SystemMessageType = "change_ephemeral_setting"
+	SystemMessageBlockContact           SystemMessageType = "block_contact"
+)
+
+// SystemMessage is emitted for message stubs, i.e. system messages like "X added Y" or "Missed voice call".
+//
+// Currently these are only found in history syncs. The same messages are also included in the HistorySync event.
+type SystemMessage struct {
+	Chat      types.JID       // The chat where the system message is.
+	Sender    types.JID       // The user who caused the system message, e.g. the admin who added someone.
+	ID        types.MessageID // The ID of the stub message.
+	Timestamp time.Time       // The time of the system message.
+
+	Type    SystemMessageType                             // The parsed type of the stub.
+	RawType waProto.WebMessageInfo_WebMessageInfoStubType // The raw stub type, useful for types that aren't parsed.
+
+	// The users the system message is about, e.g. the added participants, or the old and new JID for number changes.
+	Participants []types.JID
+	// The new value for changes like subject/description changes, or the new timer (in seconds) for ephemeral setting changes.
+	Value string
+	// The raw parameters of the stub. These are always included, even for parsed types.
+	Params []string
+}
+
+// UnreadCountChanged is emitted when the unread message count of a chat changes.
+// This is only emitted if Client.EnableUnreadTracking is set.
+type UnreadCountChanged struct {
+	Chat  types.JID // The chat whose unread count changed.
+	Count int       // The new unread count. -1 means the chat was manually marked as unread.
+}
+
+// ViewOnceOpened is emitted when another device of the current user opens a view-once media message.
+//
+// The event is only emitted for view-once messages that were received while the client was running,
+// as played receipts of other messages (like voice messages) can't be told apart from view-once ones.
+//
+// After this event, the media of the message can no longer be downloaded, and Client.DownloadAny will return ErrViewOnceConsumed for it.
+type ViewOnceOpened struct {
+	Chat      types.JID       // The chat where the view-once message was received.
+	Sender    types.JID       // The user who sent the view-once message.
+	MessageID types.MessageID // The ID of the view-once message.
+	Timestamp time.Time       // The time when the message was opened.
+}
+
+// MediaRetry is emitted when the phone sends a response to a media retry request.
+//
+// The response is encrypted with the media key of the message, use whatsmeow.DecryptMediaRetryNotification to decrypt it.
+type MediaRetry struct {
+	Ciphertext []byte
+	IV         []byte
+
+	Timestamp time.Time // The time of the response.
+
+	MessageID types.MessageID // The ID of the message.
+	ChatID    types.JID       // The chat ID where the message was sent.
+	SenderID  types.JID       // The user who sent the message. Only present in groups.
+	FromMe    bool            // Whether the message was sent by the current user or someone else.
+}
+
+// MessageRevoke is emitted when someone deletes a message for everyone.
+//
+// A normal Message event containing the revoke protocol message is emitted too.
+type MessageRevoke struct {
+	Info   types.MessageInfo  // Info about the revoke message itself.
+	Target types.RevokeTarget // The message that was deleted and who deleted it.
+}
+
+// Reaction is emitted when someone reacts to a message or removes their reaction.
+//
+// A normal Message event containing the reaction message is emitted too.
+type Reaction struct {
+	Info types.MessageInfo // Info about the reaction message. Info.Sender is the user who reacted.
+
+	TargetID     types.MessageID // The ID of the message that was reacted to.
+	TargetSender types.JID       // The sender of the message that was reacted to.
+
+	Emoji  string // The reaction emoji. Empty if the reaction was removed.
+	Remove bool   // True if the user removed their previous reaction.
+}
+
+// PollResultsUpdated is emitted when someone votes in a poll or changes their vote, if Client.EnablePollTracking is set.
+//
+// A normal Message event containing the encrypted vote is emitted too.
+type PollResultsUpdated struct {
+	Chat       types.JID       // The chat where the poll is.
+	PollSender types.JID       // The user who created the poll.
+	PollID     types.MessageID // The ID of the poll creation message.
+
+	Voter      types.JID          // The user whose vote caused this update.
+	Options    []PollOptionResult // The current results of each option.
+	VoterCount int                // The number of users who currently have a vote in the poll.
+}
+
+// PollOptionResult contains the current votes for a single option in a poll.
+type PollOptionResult struct {
+	// The name of the option. This is empty if the poll creation message wasn't seen while poll tracking was enabled.
+	Name string
+	// The SHA-256 hash of the option name, which is what votes contain.
+	Hash   []byte
+	Count  int
+	Voters []types.JID
+}
+
+// Album is emitted instead of separate Message events for media albums if Client.EnableAlbumGrouping is set.
+type Album struct {
+	Info  types.MessageInfo     // Info about the album message.
+	Album *waProto.AlbumMessage // The album message, which contains the number of expected images and videos.
+
+	// The images and videos in the album, in the order the sender added them.
+	Media []*Message
+	// True if some of the expected media didn't arrive in time. The missing media will be emitted as normal
+	// Message events if they arrive later.
+	Incomplete bool
+}
+
+// SendThrottled is emitted when sending a message is delayed because of Client.SendRateLimiter.
+type SendThrottled struct {
+	Chat       types.JID
+	MessageID  types.MessageID
+	Delay      time.Duration // How long the message will wait before it's sent.
+	QueueDepth int           // The number of messages currently waiting due to the rate limit, including this one.
+}
+
+// OutboxDelivered is emitted when a message queued with Client.QueueMessage or Client.ScheduleMessage has been
+// acknowledged by the server.
+type OutboxDelivered struct {
+	ID        types.MessageID
+	Chat      types.JID
+	Timestamp time.Time // The server timestamp of the message.
+	Attempts  int       // The number of attempts it took to send the message.
+	SendAt    time.Time // The time the message was scheduled to be sent at, or zero if it wasn't scheduled.
+}
+
+// OutboxFailed is emitted when the outbox gives up on sending a message, either because the error can't be
+// fixed by retrying, because Client.OutboxMaxAttempts was reached, or because a scheduled message couldn't be sent
+// within Client.ScheduledMessageMaxDelay. The message is removed from the outbox.
+type OutboxFailed struct {
+	ID       types.MessageID
+	Chat     types.JID
+	Error    error // The error from the last attempt.
+	Attempts int
+	SendAt   time.Time // The time the message was scheduled to be sent at, or zero if it wasn't scheduled.
+}
+
+// ButtonResponse is emitted when someone taps a quick reply button in a buttons message.
+// A normal Message event containing the ButtonsResponseMessage is emitted too.
+type ButtonResponse struct {
+	Info types.MessageInfo // Info about the response message.
+
+	TargetID    types.MessageID // The ID of the buttons message.
+	SelectedID  string          // The ID of the button that was tapped.
+	DisplayText string          // The text of the button that was tapped.
+}
+
+// ListResponse is emitted when someone selects a row in a list message.
+// A normal Message event containing the ListResponseMessage is emitted too.
+type ListResponse struct {
+	Info types.MessageInfo // Info about the response message.
+
+	TargetID      types.MessageID // The ID of the list message.
+	SelectedRowID string          // The ID of the row that was selected.
+	Title         string          // The title of the row that was selected.
+	Description   string          // The description of the row that was selected.
+}
+
+// TemplateButtonResponse is emitted when someone taps a quick reply button in a template message.
+// A normal Message event containing the TemplateButtonReplyMessage is emitted too.
+type TemplateButtonResponse struct {
+	Info types.MessageInfo // Info about the response message.
+
+	TargetID      types.MessageID // The ID of the template message.
+	SelectedID    string          // The ID of the button that was tapped.
+	DisplayText   string          // The text of the button that was tapped.
+	SelectedIndex int             // The index of the button that was tapped.
+}
+
+// LiveLocation is emitted when someone starts sharing their live location, sends an update to a share or stops sharing.
+//
+// All updates of the same share have the same ShareID, which is the ID of the message that started the share.
+// Updates that arrive out of order (i.e. are older than an already-emitted update of the same share) are not emitted.
+// A normal Message event is emitted for each live location message too.
+//
+// Linking updates to the share and the stop sequence number are whatsmeow-specific conventions
+// (see whatsmeow.Client.BuildLiveLocationUpdate), so updates from official WhatsApp clients
+// will be emitted as separate shares that never stop.
+type LiveLocation struct {
+	Info    types.MessageInfo // Info about the message containing this update.
+	ShareID types.MessageID   // The ID of the message that started the share.
+
+	Coordinates    types.Coordinates
+	Caption        string
+	SequenceNumber int64
+	TimeOffset     time.Duration // How long after the start of the share this update was sent.
+
+	IsStart bool // True if this is the first message of the share.
+	IsStop  bool // True if the sender stopped sharing. No more updates will be emitted for this share.
+}
+
 // ChatPresence is emitted when a chat state update (also known as typing notification) is received.
 //
 // Note that WhatsApp won't send you these updates unless you mark yourself as online:
@@ -194,10 +676,14 @@ type GroupInfo struct {
 	Sender    *types.JID // The user who made the change. Doesn't seem to be present when notify=invite
 	Timestamp time.Time  // The time when the change occurred
 
-	Name     *types.GroupName     // Group name change
-	Topic    *types.GroupTopic    // Group topic (description) change
-	Locked   *types.GroupLocked   // Group locked status change (can only admins edit group info?)
-	Announce *types.GroupAnnounce // Group announce status change (can only admins send messages?)
+	Name      *types.GroupName      // Group name change
+	Topic     *types.GroupTopic     // Group topic (description) change
+	Locked    *types.GroupLocked    // Group locked status change (can only admins edit group info?)
+	Announce  *types.GroupAnnounce  // Group announce status change (can only admins send messages?)
+	Ephemeral *types.GroupEphemeral // Group disappearing messages timer change
+
+	MembershipApprovalMode *types.GroupMembershipApprovalMode // Group join approval setting change
+	MemberAddMode          *types.GroupMemberAddMode          // Group member add mode change (can only admins add members?)
 
 	NewInviteLink *string // Group invite link change
 
@@ -212,6 +698,9 @@ type GroupInfo struct {
 	Promote []types.JID // Users who were promoted to admins
 	Demote  []types.JID // Users who were demoted to normal users
 
+	JoinRequestsCreated []types.JID // Users who requested to join the group (only sent to admins when join approval is required)
+	JoinRequestsRevoked []types.JID // Users who canceled their request to join the group
+
 	UnknownChanges []*waBinary.Node
 }
 
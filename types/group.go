@@ -19,6 +19,10 @@ type GroupInfo struct {
 	GroupTopic
 	GroupLocked
 	GroupAnnounce
+	GroupEphemeral
+	GroupMembershipApprovalMode
+
+	MemberAddMode GroupMemberAddMode
 
 	GroupCreated time.Time
 
@@ -52,6 +56,31 @@ type GroupAnnounce struct {
 	AnnounceVersionID string
 }
 
+// GroupEphemeral contains the group's disappearing messages settings.
+type GroupEphemeral struct {
+	IsEphemeral       bool
+	DisappearingTimer uint32 // The disappearing message timer in seconds.
+}
+
+// GroupMemberAddMode specifies who is allowed to add new members to a group.
+type GroupMemberAddMode string
+
+const (
+	GroupMemberAddModeAdmin     GroupMemberAddMode = "admin_add"
+	GroupMemberAddModeAllMember GroupMemberAddMode = "all_member_add"
+)
+
+// GroupMembershipApprovalMode specifies whether new members need to be approved by admins before joining the group.
+type GroupMembershipApprovalMode struct {
+	IsJoinApprovalRequired bool
+}
+
+// GroupParticipantRequest contains info about a user who has requested to join a group.
+type GroupParticipantRequest struct {
+	JID         JID
+	RequestedAt time.Time
+}
+
 // GroupParticipant contains info about a participant of a WhatsApp group chat.
 type GroupParticipant struct {
 	JID          JID
@@ -0,0 +1,16 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+// Coordinates contains a single location fix, as used in live location messages.
+type Coordinates struct {
+	Latitude         float64
+	Longitude        float64
+	AccuracyInMeters uint32  // Optional, 0 means unknown.
+	SpeedInMps       float32 // Optional, 0 means unknown or stationary.
+	Heading          uint32  // Degrees clockwise from magnetic north. Optional.
+}
@@ -33,8 +33,30 @@ type MessageInfo struct {
 	PushName  string
 	Timestamp time.Time
 	Category  string
+	Edit      EditAttribute
 
 	DeviceSentMeta *DeviceSentMeta // Metadata for direct messages sent from another one of the user's own devices.
+
+	// Annotations set by Client.PreDecryptHook. This is always nil unless the hook sets something.
+	Annotations map[string]interface{}
+}
+
+// EditAttribute is the value of the edit attribute in message nodes.
+type EditAttribute string
+
+const (
+	EditAttributeEmpty        EditAttribute = ""
+	EditAttributeSenderRevoke EditAttribute = "7"
+	EditAttributeAdminRevoke  EditAttribute = "8"
+)
+
+// RevokeTarget contains information about the message that a revoke (delete for everyone) protocol message deletes.
+type RevokeTarget struct {
+	MessageSource           // The chat and original sender of the revoked message.
+	ID            MessageID // The ID of the revoked message.
+
+	Actor   JID  // The user who revoked the message.
+	ByAdmin bool // True if the message was deleted by a group admin rather than the original sender.
 }
 
 // SourceString returns a log-friendly representation of who sent the message and where.
@@ -18,6 +18,14 @@ type VerifiedName struct {
 	Details     *waProto.VerifiedNameDetails
 }
 
+// GetName returns the verified name, or an empty string if the VerifiedName is nil.
+func (vn *VerifiedName) GetName() string {
+	if vn == nil {
+		return ""
+	}
+	return vn.Details.GetVerifiedName()
+}
+
 // UserInfo contains info about a WhatsApp user.
 type UserInfo struct {
 	VerifiedName *VerifiedName
@@ -52,6 +60,12 @@ type LocalChatSettings struct {
 	MutedUntil time.Time
 	Pinned     bool
 	Archived   bool
+
+	// The number of unread messages, only maintained if Client.EnableUnreadTracking is set.
+	// -1 means the chat was manually marked as unread.
+	UnreadCount int
+	// The disappearing message timer of the chat in seconds, or 0 if disappearing messages are disabled.
+	EphemeralExpiration uint32
 }
 
 // IsOnWhatsAppResponse contains information received in response to checking if a phone number is on WhatsApp.
@@ -94,3 +108,23 @@ type PrivacySettings struct {
 	Profile      PrivacySetting
 	ReadReceipts PrivacySetting
 }
+
+// StatusPrivacyType is the type of list in StatusPrivacy.
+type StatusPrivacyType string
+
+const (
+	// StatusPrivacyTypeContacts means statuses are sent to all contacts.
+	StatusPrivacyTypeContacts StatusPrivacyType = "contacts"
+	// StatusPrivacyTypeBlacklist means statuses are sent to all contacts, except the ones on the list.
+	StatusPrivacyTypeBlacklist StatusPrivacyType = "blacklist"
+	// StatusPrivacyTypeWhitelist means statuses are only sent to users on the list.
+	StatusPrivacyTypeWhitelist StatusPrivacyType = "whitelist"
+)
+
+// StatusPrivacy contains likely recipients of status broadcasts.
+type StatusPrivacy struct {
+	Type StatusPrivacyType
+	List []JID
+
+	IsDefault bool
+}
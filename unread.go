@@ -0,0 +1,193 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// UnreadCountMarkedUnread is the unread count of a chat that was manually marked as unread.
+const UnreadCountMarkedUnread = -1
+
+// GetUnreadCounts returns the unread message counts of all chats that have unread messages.
+//
+// The counts are only maintained if Client.EnableUnreadTracking is set and the chat settings store
+// implements store.UnreadCountStore.
+func (cli *Client) GetUnreadCounts() (map[types.JID]int, error) {
+	unreadStore, ok := cli.Store.ChatSettings.(store.UnreadCountStore)
+	if !ok {
+		return nil, ErrUnreadCountsNotSupported
+	}
+	return unreadStore.GetUnreadCounts()
+}
+
+// getUnreadCountStore returns the store where unread counts should be saved,
+// or nil if unread tracking is disabled or not supported by the chat settings store.
+func (cli *Client) getUnreadCountStore() store.UnreadCountStore {
+	if !cli.EnableUnreadTracking {
+		return nil
+	}
+	unreadStore, _ := cli.Store.ChatSettings.(store.UnreadCountStore)
+	return unreadStore
+}
+
+// isUnreadCountable checks if the given message should increment the unread count of the chat it was sent to.
+//
+// Like on the phone, protocol messages (e.g. revokes and disappearing timer changes) and messages
+// without any content (e.g. sender key distributions and system message stubs) don't count.
+func isUnreadCountable(msg *waProto.Message) bool {
+	if msg == nil || msg.ProtocolMessage != nil {
+		return false
+	}
+	msg = proto.Clone(msg).(*waProto.Message)
+	msg.SenderKeyDistributionMessage = nil
+	msg.MessageContextInfo = nil
+	return proto.Size(msg) > 0
+}
+
+// nextUnreadCount calculates the new unread count of a chat after the given event.
+// The second return value is false if the event doesn't affect the unread count.
+func nextUnreadCount(current int, evt interface{}) (types.JID, int, bool) {
+	switch typedEvt := evt.(type) {
+	case *events.Message:
+		if typedEvt.Info.Chat.Server == types.BroadcastServer {
+			return types.EmptyJID, 0, false
+		} else if typedEvt.Info.IsFromMe {
+			// Sending a message from another device means the user has seen the chat.
+			return typedEvt.Info.Chat, 0, true
+		} else if !isUnreadCountable(typedEvt.Message) {
+			return types.EmptyJID, 0, false
+		} else if current < 0 {
+			// A chat that was marked as unread has one unread message after a new message, not zero.
+			current = 0
+		}
+		return typedEvt.Info.Chat, current + 1, true
+	case *events.Receipt:
+		if !typedEvt.IsFromMe || (typedEvt.Type != events.ReceiptTypeRead && typedEvt.Type != events.ReceiptTypeReadSelf) {
+			return types.EmptyJID, 0, false
+		}
+		// The phone sends read receipts for all unread messages when opening a chat.
+		return typedEvt.Chat, 0, true
+	case *events.MarkChatAsRead:
+		if typedEvt.Action.GetRead() {
+			return typedEvt.JID, 0, true
+		} else if current != 0 {
+			// Marking a chat with unread messages as unread doesn't change anything.
+			return typedEvt.JID, current, true
+		}
+		return typedEvt.JID, UnreadCountMarkedUnread, true
+	case *events.ClearChat:
+		return typedEvt.JID, 0, true
+	case *events.DeleteChat:
+		return typedEvt.JID, 0, true
+	}
+	return types.EmptyJID, 0, false
+}
+
+// trackUnread updates the unread count of a chat based on the given event if unread tracking is enabled.
+func (cli *Client) trackUnread(evt interface{}) {
+	unreadStore := cli.getUnreadCountStore()
+	if unreadStore == nil {
+		return
+	}
+	cli.unreadLock.Lock()
+	changed := cli.updateUnreadCount(unreadStore, evt)
+	cli.unreadLock.Unlock()
+	if changed != nil {
+		cli.dispatchEvent(changed)
+	}
+}
+
+func (cli *Client) updateUnreadCount(unreadStore store.UnreadCountStore, evt interface{}) *events.UnreadCountChanged {
+	// The event type is checked first so that irrelevant events don't hit the database.
+	chat, _, ok := nextUnreadCount(0, evt)
+	if !ok || chat.IsEmpty() {
+		return nil
+	}
+	settings, err := cli.Store.ChatSettings.GetChatSettings(chat)
+	if err != nil {
+		cli.Log.Errorf("Failed to get unread count of %s: %v", chat, err)
+		return nil
+	}
+	_, count, _ := nextUnreadCount(settings.UnreadCount, evt)
+	if count == settings.UnreadCount {
+		return nil
+	}
+	err = unreadStore.PutUnreadCount(chat, count)
+	if err != nil {
+		cli.Log.Errorf("Failed to save unread count of %s: %v", chat, err)
+		return nil
+	}
+	return &events.UnreadCountChanged{Chat: chat, Count: count}
+}
+
+// unreadBatch collects the events affecting unread counts during a full app state sync,
+// so that the counts can be saved all at once without emitting events.UnreadCountChanged.
+type unreadBatch map[types.JID][]interface{}
+
+// add queues the given event if unread tracking is enabled and the event affects an unread count.
+func (batch unreadBatch) add(cli *Client, evt interface{}) {
+	if cli.getUnreadCountStore() == nil {
+		return
+	}
+	chat, _, ok := nextUnreadCount(0, evt)
+	if !ok || chat.IsEmpty() {
+		return
+	}
+	batch[chat] = append(batch[chat], evt)
+}
+
+// flushUnreadBatch applies the events in the batch on top of the stored unread counts and saves the results.
+//
+// The events are only replayed here (rather than when they're added) so that messages received
+// while the app state was being fetched are not overwritten by stale counts.
+func (cli *Client) flushUnreadBatch(batch unreadBatch) {
+	unreadStore := cli.getUnreadCountStore()
+	if len(batch) == 0 || unreadStore == nil {
+		return
+	}
+	cli.unreadLock.Lock()
+	defer cli.unreadLock.Unlock()
+	counts := make(map[types.JID]int, len(batch))
+	for chat, evts := range batch {
+		settings, err := cli.Store.ChatSettings.GetChatSettings(chat)
+		if err != nil {
+			cli.Log.Errorf("Failed to get unread count of %s: %v", chat, err)
+			continue
+		}
+		count := settings.UnreadCount
+		for _, evt := range evts {
+			_, count, _ = nextUnreadCount(count, evt)
+		}
+		if count != settings.UnreadCount {
+			counts[chat] = count
+		}
+	}
+	if len(counts) == 0 {
+		return
+	}
+	var err error
+	if batchStore, ok := unreadStore.(store.UnreadCountBatchStore); ok {
+		err = batchStore.PutUnreadCounts(counts)
+	} else {
+		for chat, count := range counts {
+			if err = unreadStore.PutUnreadCount(chat, count); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		cli.Log.Errorf("Failed to save %d unread counts after app state sync: %v", len(counts), err)
+	} else {
+		cli.Log.Debugf("Saved %d unread counts after app state sync", len(counts))
+	}
+}
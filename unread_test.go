@@ -0,0 +1,238 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/whatsmeow/appstate"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestNextUnreadCount(t *testing.T) {
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	incoming := func(msg *waProto.Message) *events.Message {
+		return &events.Message{Info: types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}}, Message: msg}
+	}
+	text := incoming(&waProto.Message{Conversation: proto.String("hi")})
+	fromMe := &events.Message{
+		Info:    types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, IsFromMe: true}},
+		Message: &waProto.Message{Conversation: proto.String("hello")},
+	}
+	status := &events.Message{
+		Info:    types.MessageInfo{MessageSource: types.MessageSource{Chat: types.StatusBroadcastJID, Sender: chat}},
+		Message: &waProto.Message{Conversation: proto.String("status")},
+	}
+	revoke := incoming(&waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{Type: waProto.ProtocolMessage_REVOKE.Enum()}})
+	senderKeyOnly := incoming(&waProto.Message{SenderKeyDistributionMessage: &waProto.SenderKeyDistributionMessage{GroupId: proto.String("group")}})
+	stub := incoming(nil)
+	ownRead := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, IsFromMe: true}, Type: events.ReceiptTypeRead}
+	ownReadSelf := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, IsFromMe: true}, Type: events.ReceiptTypeReadSelf}
+	otherRead := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, Sender: chat}, Type: events.ReceiptTypeRead}
+	ownDelivered := &events.Receipt{MessageSource: types.MessageSource{Chat: chat, IsFromMe: true}, Type: events.ReceiptTypeDelivered}
+	markRead := &events.MarkChatAsRead{JID: chat, Action: &waProto.MarkChatAsReadAction{Read: proto.Bool(true)}}
+	markUnread := &events.MarkChatAsRead{JID: chat, Action: &waProto.MarkChatAsReadAction{Read: proto.Bool(false)}}
+	clearChat := &events.ClearChat{JID: chat}
+
+	tests := []struct {
+		name     string
+		events   []interface{}
+		expected int
+	}{
+		{"incoming messages", []interface{}{text, text, text}, 3},
+		{"own message resets", []interface{}{text, text, fromMe}, 0},
+		{"own message then incoming", []interface{}{text, fromMe, text}, 1},
+		{"read receipt from own device", []interface{}{text, text, ownRead}, 0},
+		{"read-self receipt from own device", []interface{}{text, ownReadSelf, text}, 1},
+		{"read receipt from other user", []interface{}{text, text, otherRead}, 2},
+		{"delivery receipt from own device", []interface{}{text, ownDelivered}, 1},
+		{"protocol messages don't count", []interface{}{text, revoke}, 1},
+		{"sender key distribution doesn't count", []interface{}{senderKeyOnly, text}, 1},
+		{"stubs don't count", []interface{}{stub}, 0},
+		{"status broadcasts don't count", []interface{}{status}, 0},
+		{"mark as read", []interface{}{text, text, markRead}, 0},
+		{"mark as unread", []interface{}{markUnread}, UnreadCountMarkedUnread},
+		{"mark as unread keeps existing count", []interface{}{text, text, markUnread}, 2},
+		{"message in chat marked as unread", []interface{}{markUnread, text}, 1},
+		{"read receipt clears marked as unread", []interface{}{markUnread, ownRead}, 0},
+		{"clear chat", []interface{}{text, clearChat}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			count := 0
+			for _, evt := range test.events {
+				if evtChat, newCount, ok := nextUnreadCount(count, evt); ok {
+					if evtChat != chat {
+						t.Fatalf("Unexpected chat %s for %T", evtChat, evt)
+					}
+					count = newCount
+				}
+			}
+			if count != test.expected {
+				t.Errorf("Expected unread count %d, got %d", test.expected, count)
+			}
+		})
+	}
+}
+
+type memoryChatSettingsStore struct {
+	settings   map[types.JID]types.LocalChatSettings
+	puts       int
+	batchPuts  int
+	noBatching bool
+
+	expirationPuts int
+	gets           int
+}
+
+func (mcs *memoryChatSettingsStore) update(chat types.JID, fn func(*types.LocalChatSettings)) error {
+	settings := mcs.settings[chat]
+	settings.Found = true
+	fn(&settings)
+	mcs.settings[chat] = settings
+	return nil
+}
+
+func (mcs *memoryChatSettingsStore) PutMutedUntil(chat types.JID, mutedUntil time.Time) error {
+	return mcs.update(chat, func(s *types.LocalChatSettings) { s.MutedUntil = mutedUntil })
+}
+
+func (mcs *memoryChatSettingsStore) PutPinned(chat types.JID, pinned bool) error {
+	return mcs.update(chat, func(s *types.LocalChatSettings) { s.Pinned = pinned })
+}
+
+func (mcs *memoryChatSettingsStore) PutArchived(chat types.JID, archived bool) error {
+	return mcs.update(chat, func(s *types.LocalChatSettings) { s.Archived = archived })
+}
+
+func (mcs *memoryChatSettingsStore) PutUnreadCount(chat types.JID, count int) error {
+	mcs.puts++
+	return mcs.update(chat, func(s *types.LocalChatSettings) { s.UnreadCount = count })
+}
+
+func (mcs *memoryChatSettingsStore) PutEphemeralExpiration(chat types.JID, expiration uint32) error {
+	mcs.expirationPuts++
+	return mcs.update(chat, func(s *types.LocalChatSettings) { s.EphemeralExpiration = expiration })
+}
+
+func (mcs *memoryChatSettingsStore) GetChatSettings(chat types.JID) (types.LocalChatSettings, error) {
+	mcs.gets++
+	return mcs.settings[chat], nil
+}
+
+func (mcs *memoryChatSettingsStore) GetUnreadCounts() (map[types.JID]int, error) {
+	counts := make(map[types.JID]int)
+	for chat, settings := range mcs.settings {
+		if settings.UnreadCount != 0 {
+			counts[chat] = settings.UnreadCount
+		}
+	}
+	return counts, nil
+}
+
+type batchingChatSettingsStore struct {
+	*memoryChatSettingsStore
+}
+
+func (bcs batchingChatSettingsStore) PutUnreadCounts(counts map[types.JID]int) error {
+	bcs.batchPuts++
+	for chat, count := range counts {
+		_ = bcs.update(chat, func(s *types.LocalChatSettings) { s.UnreadCount = count })
+	}
+	return nil
+}
+
+var _ store.UnreadCountBatchStore = batchingChatSettingsStore{}
+
+func TestUnreadTrackingOptionalStore(t *testing.T) {
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	mem := &memoryChatSettingsStore{settings: make(map[types.JID]types.LocalChatSettings)}
+	cli := NewClient(&store.Device{ChatSettings: minimalChatSettingsStore{mem}}, nil)
+	cli.EnableUnreadTracking = true
+	cli.trackUnread(&events.Message{
+		Info:    types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}},
+		Message: &waProto.Message{Conversation: proto.String("hi")},
+	})
+	if mem.puts != 0 || mem.gets != 0 {
+		t.Errorf("Expected store not to be used without UnreadCountStore, got %d writes and %d reads", mem.puts, mem.gets)
+	}
+	if _, err := cli.GetUnreadCounts(); err != ErrUnreadCountsNotSupported {
+		t.Errorf("Expected ErrUnreadCountsNotSupported, got %v", err)
+	}
+}
+
+func TestFullSyncUnreadBatch(t *testing.T) {
+	chat := types.NewJID("1111111111", types.DefaultUserServer)
+	otherChat := types.NewJID("2222222222", types.DefaultUserServer)
+	markRead := func(jid types.JID, read bool) appstate.Mutation {
+		return appstate.Mutation{
+			Operation: waProto.SyncdMutation_SET,
+			Index:     []string{"markChatAsRead", jid.String()},
+			Action: &waProto.SyncActionValue{
+				Timestamp:            proto.Int64(1640000000),
+				MarkChatAsReadAction: &waProto.MarkChatAsReadAction{Read: proto.Bool(read)},
+			},
+		}
+	}
+	for _, batching := range []bool{true, false} {
+		mem := &memoryChatSettingsStore{settings: map[types.JID]types.LocalChatSettings{
+			chat: {Found: true, UnreadCount: 3, Pinned: true},
+		}}
+		var chatSettings store.ChatSettingsStore = mem
+		if batching {
+			chatSettings = batchingChatSettingsStore{mem}
+		}
+		cli := NewClient(&store.Device{ChatSettings: chatSettings}, nil)
+		cli.EnableUnreadTracking = true
+		var changes []*events.UnreadCountChanged
+		cli.AddEventHandler(func(evt interface{}) {
+			if changed, ok := evt.(*events.UnreadCountChanged); ok {
+				changes = append(changes, changed)
+			}
+		})
+
+		unread := make(unreadBatch)
+		cli.dispatchAppState(markRead(chat, true), false, unread)
+		cli.dispatchAppState(markRead(otherChat, false), false, unread)
+		cli.dispatchAppState(markRead(otherChat, true), false, unread)
+		cli.dispatchAppState(markRead(otherChat, false), false, unread)
+		if mem.puts != 0 || mem.batchPuts != 0 {
+			t.Fatalf("Expected no writes before flushing batch (batching: %t)", batching)
+		}
+		// Live messages are still tracked immediately while the sync is in progress.
+		cli.trackUnread(&events.Message{
+			Info:    types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}},
+			Message: &waProto.Message{Conversation: proto.String("hi")},
+		})
+		if len(changes) != 1 || changes[0].Count != 4 {
+			t.Fatalf("Expected live message to be counted immediately, got %v (batching: %t)", changes, batching)
+		}
+		changes = nil
+		mem.puts = 0
+		cli.flushUnreadBatch(unread)
+
+		if len(changes) != 0 {
+			t.Errorf("Expected no UnreadCountChanged events from full sync, got %d (batching: %t)", len(changes), batching)
+		}
+		if batching && (mem.batchPuts != 1 || mem.puts != 0) {
+			t.Errorf("Expected a single batch write, got %d batches and %d single writes", mem.batchPuts, mem.puts)
+		} else if !batching && mem.puts != 2 {
+			t.Errorf("Expected one write per chat without batching, got %d", mem.puts)
+		}
+		if settings := mem.settings[chat]; settings.UnreadCount != 0 || !settings.Pinned {
+			t.Errorf("Unexpected settings for chat marked as read: %+v (batching: %t)", settings, batching)
+		} else if count := mem.settings[otherChat].UnreadCount; count != UnreadCountMarkedUnread {
+			t.Errorf("Expected other chat to be marked as unread, got %d (batching: %t)", count, batching)
+		}
+	}
+}
@@ -31,6 +31,9 @@ type UploadResponse struct {
 	FileEncSHA256 []byte
 	FileSHA256    []byte
 	FileLength    uint64
+
+	// The media handle, which can be passed to SendMessage in SendRequestExtra.MediaHandle.
+	Handle string `json:"handle"`
 }
 
 // Upload uploads the given attachment to WhatsApp servers.
@@ -92,7 +95,7 @@ func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaTy
 	req.Header.Set("Referer", socket.Origin+"/")
 
 	var httpResp *http.Response
-	httpResp, err = http.DefaultClient.Do(req)
+	httpResp, err = cli.http.Do(req)
 	if err != nil {
 		err = fmt.Errorf("failed to execute request: %w", err)
 	} else if httpResp.StatusCode != http.StatusOK {
@@ -7,6 +7,7 @@
 package whatsmeow
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -77,7 +78,7 @@ func (cli *Client) IsOnWhatsApp(phones []string) ([]types.IsOnWhatsAppResponse,
 	for i := range jids {
 		jids[i] = types.NewJID(phones[i], types.LegacyUserServer)
 	}
-	list, err := cli.usync(jids, "query", "interactive", []waBinary.Node{
+	list, err := cli.usync(context.Background(), jids, "query", "interactive", []waBinary.Node{
 		{Tag: "business", Content: []waBinary.Node{{Tag: "verified_name"}}},
 		{Tag: "contact"},
 	})
@@ -108,7 +109,7 @@ func (cli *Client) IsOnWhatsApp(phones []string) ([]types.IsOnWhatsAppResponse,
 
 // GetUserInfo gets basic user info (avatar, status, verified business name, device list).
 func (cli *Client) GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo, error) {
-	list, err := cli.usync(jids, "full", "background", []waBinary.Node{
+	list, err := cli.usync(context.Background(), jids, "full", "background", []waBinary.Node{
 		{Tag: "business", Content: []waBinary.Node{{Tag: "verified_name"}}},
 		{Tag: "status"},
 		{Tag: "picture"},
@@ -147,7 +148,13 @@ func (cli *Client) GetUserInfo(jids []types.JID) (map[types.JID]types.UserInfo,
 // regular JIDs, and the output will be a list of AD JIDs. The local device will not be included in
 // the output even if the user's JID is included in the input. All other devices will be included.
 func (cli *Client) GetUserDevices(jids []types.JID) ([]types.JID, error) {
-	list, err := cli.usync(jids, "query", "message", []waBinary.Node{
+	return cli.GetUserDevicesContext(context.Background(), jids)
+}
+
+// GetUserDevicesContext gets the list of devices that the given user has like GetUserDevices,
+// but the given context can be used to cancel the request.
+func (cli *Client) GetUserDevicesContext(ctx context.Context, jids []types.JID) ([]types.JID, error) {
+	list, err := cli.usync(ctx, jids, "query", "message", []waBinary.Node{
 		{Tag: "devices", Attrs: waBinary.Attrs{"version": "2"}},
 	})
 	if err != nil {
@@ -309,7 +316,7 @@ func parseDeviceList(user string, deviceNode waBinary.Node, appendTo *[]types.JI
 	return *appendTo
 }
 
-func (cli *Client) usync(jids []types.JID, mode, context string, query []waBinary.Node) (*waBinary.Node, error) {
+func (cli *Client) usync(ctx context.Context, jids []types.JID, mode, usyncContext string, query []waBinary.Node) (*waBinary.Node, error) {
 	userList := make([]waBinary.Node, len(jids))
 	for i, jid := range jids {
 		userList[i].Tag = "user"
@@ -339,13 +346,14 @@ func (cli *Client) usync(jids []types.JID, mode, context string, query []waBinar
 				"mode":    mode,
 				"last":    "true",
 				"index":   "0",
-				"context": context,
+				"context": usyncContext,
 			},
 			Content: []waBinary.Node{
 				{Tag: "query", Content: query},
 				{Tag: "list", Content: userList},
 			},
 		}},
+		Context: ctx,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send usync query: %w", err)
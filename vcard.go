@@ -0,0 +1,98 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// VCardPhone is a phone number in a VCard.
+type VCardPhone struct {
+	// The phone number in international format, e.g. +1 234 567 8900.
+	Number string
+	// The WhatsApp account of the phone number. If set, the official clients show message and call buttons.
+	WhatsApp types.JID
+	// The type of the number, like CELL, HOME or WORK. Defaults to CELL.
+	Type string
+}
+
+// VCard contains the fields of a contact card that the official WhatsApp clients display.
+type VCard struct {
+	FullName     string
+	FirstName    string
+	LastName     string
+	Organization string
+	Phones       []VCardPhone
+	Emails       []string
+	URLs         []string
+}
+
+var vcardEscaper = strings.NewReplacer("\\", "\\\\", "\n", "\\n", ",", "\\,", ";", "\\;")
+
+// String formats the contact card as a vCard 3.0 string, which can be used in ContactMessage.
+func (vc *VCard) String() string {
+	var buf strings.Builder
+	buf.WriteString("BEGIN:VCARD\nVERSION:3.0\n")
+	buf.WriteString("N:" + vcardEscaper.Replace(vc.LastName) + ";" + vcardEscaper.Replace(vc.FirstName) + ";;;\n")
+	buf.WriteString("FN:" + vcardEscaper.Replace(vc.FullName) + "\n")
+	if len(vc.Organization) > 0 {
+		buf.WriteString("ORG:" + vcardEscaper.Replace(vc.Organization) + "\n")
+	}
+	for _, phone := range vc.Phones {
+		phoneType := phone.Type
+		if len(phoneType) == 0 {
+			phoneType = "CELL"
+		}
+		buf.WriteString("TEL;type=" + phoneType + ";type=VOICE")
+		if !phone.WhatsApp.IsEmpty() {
+			buf.WriteString(";waid=" + phone.WhatsApp.User)
+		}
+		buf.WriteString(":" + phone.Number + "\n")
+	}
+	for _, email := range vc.Emails {
+		buf.WriteString("EMAIL;type=INTERNET:" + email + "\n")
+	}
+	for _, url := range vc.URLs {
+		buf.WriteString("URL:" + url + "\n")
+	}
+	buf.WriteString("END:VCARD")
+	return buf.String()
+}
+
+// BuildContactMessage builds a contact message with the given display name and vCard.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The vCard can be generated with VCard.String.
+func BuildContactMessage(displayName, vcard string) *waProto.Message {
+	return &waProto.Message{ContactMessage: &waProto.ContactMessage{
+		DisplayName: proto.String(displayName),
+		Vcard:       proto.String(vcard),
+	}}
+}
+
+// BuildContactsArrayMessage builds a message containing multiple contacts.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The display name is shown as the title of the message, e.g. "Alice and 2 other contacts".
+// If only one contact is given, a normal contact message is built instead, like the official clients do.
+//
+// The contacts are always included inline: unlike history syncs and app state, contact arrays have no external blob
+// reference in the message protocol, so very large arrays simply make the message larger.
+func BuildContactsArrayMessage(displayName string, contacts []*waProto.ContactMessage) *waProto.Message {
+	if len(contacts) == 1 {
+		return &waProto.Message{ContactMessage: contacts[0]}
+	}
+	return &waProto.Message{ContactsArrayMessage: &waProto.ContactsArrayMessage{
+		DisplayName: proto.String(displayName),
+		Contacts:    contacts,
+	}}
+}
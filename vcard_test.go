@@ -0,0 +1,31 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestVCardString(t *testing.T) {
+	vc := VCard{
+		FullName:     "Alice Example",
+		FirstName:    "Alice",
+		LastName:     "Example",
+		Organization: "Example, Inc.",
+		Phones: []VCardPhone{{
+			Number:   "+1 234 567 8900",
+			WhatsApp: types.NewJID("12345678900", types.DefaultUserServer),
+		}},
+	}
+	expected := "BEGIN:VCARD\nVERSION:3.0\nN:Example;Alice;;;\nFN:Alice Example\nORG:Example\\, Inc.\n" +
+		"TEL;type=CELL;type=VOICE;waid=12345678900:+1 234 567 8900\nEND:VCARD"
+	if vc.String() != expected {
+		t.Errorf("Unexpected vCard:\n%s", vc.String())
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.mau.fi/whatsmeow/store"
+)
+
+// CheckUpdateURL is the URL that GetLatestVersion uses to find the current WhatsApp web version.
+var CheckUpdateURL = "https://web.whatsapp.com/check-update"
+
+// CheckUpdateResponse is the response to the WhatsApp web version check endpoint.
+type CheckUpdateResponse struct {
+	IsBroken       bool   `json:"isBroken"`
+	IsBelowSoft    bool   `json:"isBelowSoft"`
+	IsBelowHard    bool   `json:"isBelowHard"`
+	HardUpdateTime int64  `json:"hardUpdateTime"`
+	BetaVersion    string `json:"beta"`
+	CurrentVersion string `json:"currentVersion"`
+}
+
+// CheckUpdate asks the WhatsApp web servers whether the version currently set with store.SetWAVersion is outdated.
+//
+// If httpClient is nil, http.DefaultClient is used.
+func CheckUpdate(httpClient *http.Client) (*CheckUpdateResponse, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	query := url.Values{
+		"version":  {store.GetWAVersion().String()},
+		"platform": {"web"},
+	}
+	resp, err := httpClient.Get(CheckUpdateURL + "?" + query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to send version check request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response with status %d to version check request", resp.StatusCode)
+	}
+	var result CheckUpdateResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse version check response: %w", err)
+	}
+	return &result, nil
+}
+
+// GetLatestVersion returns the latest WhatsApp web version number. The result can be passed to store.SetWAVersion.
+//
+// If httpClient is nil, http.DefaultClient is used.
+func GetLatestVersion(httpClient *http.Client) (*store.WAVersionContainer, error) {
+	resp, err := CheckUpdate(httpClient)
+	if err != nil {
+		return nil, err
+	}
+	version, err := store.ParseVersion(resp.CurrentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version in version check response: %w", err)
+	}
+	return &version, nil
+}
+
+// refreshWAVersion fetches the latest WhatsApp web version and updates the version in the store package
+// if the latest version is newer. It returns true if the version was updated.
+func (cli *Client) refreshWAVersion() bool {
+	latest, err := GetLatestVersion(cli.http)
+	if err != nil {
+		cli.Log.Warnf("Failed to fetch latest WhatsApp web version: %v", err)
+		return false
+	}
+	current := store.GetWAVersion()
+	if !current.LessThan(*latest) {
+		cli.Log.Debugf("Current WhatsApp web version %s is not older than latest version %s", current, latest)
+		return false
+	}
+	cli.Log.Infof("Updating WhatsApp web version from %s to %s", current, latest)
+	store.SetWAVersion(*latest)
+	return true
+}
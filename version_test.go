@@ -0,0 +1,43 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.mau.fi/whatsmeow/store"
+)
+
+func TestRefreshWAVersion(t *testing.T) {
+	original := store.GetWAVersion()
+	defer store.SetWAVersion(original)
+	latest := store.WAVersionContainer{original[0], original[1] + 1, 0}
+
+	var gotVersion string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersion = r.URL.Query().Get("version")
+		_, _ = fmt.Fprintf(w, `{"isBroken": false, "isBelowSoft": true, "isBelowHard": true, "currentVersion": %q}`, latest)
+	}))
+	defer server.Close()
+	defer func(oldURL string) { CheckUpdateURL = oldURL }(CheckUpdateURL)
+	CheckUpdateURL = server.URL
+
+	cli := NewClient(&store.Device{}, nil)
+	if !cli.refreshWAVersion() {
+		t.Fatalf("Expected version to be refreshed")
+	} else if gotVersion != original.String() {
+		t.Errorf("Expected current version %s in request, got %s", original, gotVersion)
+	} else if store.GetWAVersion() != latest {
+		t.Errorf("Expected version to be %s after refresh, got %s", latest, store.GetWAVersion())
+	}
+	if cli.refreshWAVersion() {
+		t.Errorf("Version shouldn't be refreshed when it's already the latest")
+	}
+}
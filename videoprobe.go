@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// VideoInfo contains the metadata of a video that is included in video messages.
+type VideoInfo struct {
+	Width    uint32
+	Height   uint32
+	Duration time.Duration
+	// A small JPEG preview of the video, shown before the video is downloaded.
+	Thumbnail []byte
+}
+
+// VideoProber is used to find the metadata of videos when building video messages. See Client.VideoProber.
+type VideoProber interface {
+	ProbeVideo(ctx context.Context, data []byte) (*VideoInfo, error)
+}
+
+// FFmpegProber is a VideoProber that uses the ffprobe and ffmpeg command-line tools.
+type FFmpegProber struct {
+	// The paths to the ffprobe and ffmpeg binaries. If empty, they're looked up from $PATH.
+	FFprobePath string
+	FFmpegPath  string
+	// The maximum width and height of generated thumbnails. Defaults to 140 pixels.
+	ThumbnailSize int
+}
+
+var _ VideoProber = (*FFmpegProber)(nil)
+
+type ffprobeOutput struct {
+	Streams []struct {
+		Width  uint32 `json:"width"`
+		Height uint32 `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func parseFFprobeOutput(data []byte) (*VideoInfo, error) {
+	var output ffprobeOutput
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	} else if len(output.Streams) == 0 {
+		return nil, fmt.Errorf("file doesn't contain a video stream")
+	}
+	info := &VideoInfo{Width: output.Streams[0].Width, Height: output.Streams[0].Height}
+	if seconds, err := strconv.ParseFloat(output.Format.Duration, 64); err == nil {
+		info.Duration = time.Duration(seconds * float64(time.Second))
+	}
+	return info, nil
+}
+
+func runFFmpegTool(ctx context.Context, defaultName, path string, args ...string) ([]byte, error) {
+	if len(path) == 0 {
+		path = defaultName
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w (stderr: %s)", defaultName, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// ProbeVideo finds the dimensions and duration of the given video using ffprobe,
+// and extracts the first frame as a thumbnail using ffmpeg.
+//
+// The video is written to a temporary file, as many containers (e.g. mp4) can't be probed from a non-seekable pipe.
+func (prober *FFmpegProber) ProbeVideo(ctx context.Context, data []byte) (*VideoInfo, error) {
+	file, err := os.CreateTemp("", "whatsmeow-video-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	_ = file.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	output, err := runFFmpegTool(ctx, "ffprobe", prober.FFprobePath,
+		"-v", "error", "-select_streams", "v:0",
+		"-show_entries", "stream=width,height:format=duration",
+		"-of", "json", file.Name())
+	if err != nil {
+		return nil, err
+	}
+	info, err := parseFFprobeOutput(output)
+	if err != nil {
+		return nil, err
+	}
+
+	size := prober.ThumbnailSize
+	if size <= 0 {
+		size = 140
+	}
+	scale := fmt.Sprintf("scale=w=%d:h=%d:force_original_aspect_ratio=decrease", size, size)
+	info.Thumbnail, err = runFFmpegTool(ctx, "ffmpeg", prober.FFmpegPath,
+		"-v", "error", "-i", file.Name(),
+		"-frames:v", "1", "-vf", scale, "-f", "image2", "-c:v", "mjpeg", "pipe:1")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate thumbnail: %w", err)
+	}
+	return info, nil
+}
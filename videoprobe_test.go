@@ -0,0 +1,25 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFFprobeOutput(t *testing.T) {
+	info, err := parseFFprobeOutput([]byte(`{"programs":[],"streams":[{"width":1280,"height":720}],"format":{"duration":"12.500000"}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse output: %v", err)
+	} else if info.Width != 1280 || info.Height != 720 || info.Duration != 12500*time.Millisecond {
+		t.Errorf("Unexpected video info %+v", info)
+	}
+	_, err = parseFFprobeOutput([]byte(`{"streams":[],"format":{"duration":"3.0"}}`))
+	if err == nil {
+		t.Errorf("Expected error for file without video streams")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// Number of received view-once messages to remember for handling view-once opened receipts.
+const viewOnceMessagesSize = 256
+
+type viewOnceMessageKey struct {
+	Chat types.JID
+	ID   types.MessageID
+}
+
+type viewOnceMessage struct {
+	Sender    types.JID
+	MediaHash string
+	Consumed  bool
+}
+
+func getViewOnceMediaHash(msg *waProto.Message) string {
+	downloadables := []DownloadableMessage{msg.GetImageMessage(), msg.GetVideoMessage(), msg.GetAudioMessage()}
+	for _, downloadable := range downloadables {
+		if downloadable != nil && len(downloadable.GetFileEncSha256()) > 0 {
+			return string(downloadable.GetFileEncSha256())
+		}
+	}
+	return ""
+}
+
+func (cli *Client) addViewOnceMessage(info *types.MessageInfo, msg *waProto.Message) {
+	hash := getViewOnceMediaHash(msg)
+	if len(hash) == 0 {
+		return
+	}
+	cli.viewOnceLock.Lock()
+	key := viewOnceMessageKey{info.Chat, info.ID}
+	if oldKey := cli.viewOnceList[cli.viewOncePtr]; oldKey.ID != "" {
+		if old, ok := cli.viewOnceMap[oldKey]; ok {
+			delete(cli.viewOnceMedia, old.MediaHash)
+		}
+		delete(cli.viewOnceMap, oldKey)
+	}
+	cached := &viewOnceMessage{Sender: info.Sender, MediaHash: hash}
+	cli.viewOnceMap[key] = cached
+	cli.viewOnceMedia[hash] = cached
+	cli.viewOnceList[cli.viewOncePtr] = key
+	cli.viewOncePtr++
+	if cli.viewOncePtr >= len(cli.viewOnceList) {
+		cli.viewOncePtr = 0
+	}
+	cli.viewOnceLock.Unlock()
+}
+
+// markViewOnceConsumed marks the given view-once message as opened and returns the sender of the message if it was cached.
+func (cli *Client) markViewOnceConsumed(chat types.JID, id types.MessageID) (sender types.JID, ok bool) {
+	cli.viewOnceLock.Lock()
+	defer cli.viewOnceLock.Unlock()
+	cached, ok := cli.viewOnceMap[viewOnceMessageKey{chat, id}]
+	if !ok {
+		return
+	}
+	cached.Consumed = true
+	return cached.Sender, true
+}
+
+func (cli *Client) isViewOnceConsumed(msg DownloadableMessage) bool {
+	hash := msg.GetFileEncSha256()
+	if len(hash) == 0 {
+		return false
+	}
+	cli.viewOnceLock.RLock()
+	cached, ok := cli.viewOnceMedia[string(hash)]
+	cli.viewOnceLock.RUnlock()
+	return ok && cached.Consumed
+}
+
+// handleViewOnceOpenedReceipt checks if the given receipt means that another device of the current user
+// opened a view-once message, and returns the corresponding events.
+//
+// Played receipts are also sent for normal voice messages, so events are only returned for messages
+// that are known to be view-once, i.e. ones that were received while the client was running.
+func (cli *Client) handleViewOnceOpenedReceipt(receipt *events.Receipt) []*events.ViewOnceOpened {
+	if !receipt.IsFromMe || (receipt.Type != events.ReceiptTypePlayed && receipt.Type != events.ReceiptTypePlayedSelf) {
+		return nil
+	}
+	var evts []*events.ViewOnceOpened
+	for _, id := range receipt.MessageIDs {
+		sender, ok := cli.markViewOnceConsumed(receipt.Chat, id)
+		if !ok {
+			continue
+		}
+		evts = append(evts, &events.ViewOnceOpened{
+			Chat:      receipt.Chat,
+			Sender:    sender,
+			MessageID: id,
+			Timestamp: receipt.Timestamp,
+		})
+	}
+	return evts
+}
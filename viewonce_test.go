@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+func TestViewOnceOpened(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 0)
+	otherID := types.NewJID("1987654321", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+
+	image := &waProto.ImageMessage{
+		DirectPath:    proto.String("/v/t62.7118-24/123"),
+		MediaKey:      make([]byte, 32),
+		FileEncSha256: []byte("01234567890123456789012345678901"),
+		FileSha256:    []byte("12345678901234567890123456789012"),
+	}
+	msg := &waProto.Message{ViewOnceMessage: &waProto.FutureProofMessage{Message: &waProto.Message{ImageMessage: image}}}
+	info := &types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: otherID, Sender: otherID},
+		ID:            "3EB0C5A277F7F9B6C599",
+		Timestamp:     time.Unix(1640000000, 0),
+	}
+	cli.handleDecryptedMessage(info, msg)
+
+	receipt, err := cli.parseReceipt(&waBinary.Node{
+		Tag: "receipt",
+		Attrs: waBinary.Attrs{
+			"from":      types.NewADJID(ownID.User, 0, 0),
+			"recipient": otherID,
+			"id":        info.ID,
+			"type":      "played-self",
+			"t":         "1640000100",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse receipt: %v", err)
+	}
+	evts := cli.handleViewOnceOpenedReceipt(receipt)
+	if len(evts) != 1 {
+		t.Fatalf("Expected 1 ViewOnceOpened event, got %d", len(evts))
+	}
+	expected := events.ViewOnceOpened{Chat: otherID, Sender: otherID, MessageID: info.ID, Timestamp: time.Unix(1640000100, 0)}
+	if *evts[0] != expected {
+		t.Errorf("Unexpected ViewOnceOpened event: %+v", evts[0])
+	}
+
+	_, err = cli.DownloadAny(&waProto.Message{ImageMessage: image})
+	if !errors.Is(err, ErrViewOnceConsumed) {
+		t.Errorf("Expected ErrViewOnceConsumed from DownloadAny, got %v", err)
+	}
+}
+
+func TestVoiceMessagePlayedReceipt(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 0)
+	otherID := types.NewJID("1987654321", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+
+	msg := &waProto.Message{AudioMessage: &waProto.AudioMessage{Ptt: proto.Bool(true)}}
+	info := &types.MessageInfo{
+		MessageSource: types.MessageSource{Chat: otherID, Sender: otherID},
+		ID:            "3EB0C5A277F7F9B6C599",
+		Timestamp:     time.Unix(1640000000, 0),
+	}
+	cli.handleDecryptedMessage(info, msg)
+
+	receipt, err := cli.parseReceipt(&waBinary.Node{
+		Tag: "receipt",
+		Attrs: waBinary.Attrs{
+			"from":      types.NewADJID(ownID.User, 0, 0),
+			"recipient": otherID,
+			"id":        info.ID,
+			"type":      "played",
+			"t":         "1640000100",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse receipt: %v", err)
+	}
+	if evts := cli.handleViewOnceOpenedReceipt(receipt); len(evts) != 0 {
+		t.Errorf("Expected no ViewOnceOpened events for played voice message, got %+v", evts)
+	}
+}
+
+func TestViewOnceOpenedUnknownMessage(t *testing.T) {
+	ownID := types.NewADJID("1234567890", 0, 0)
+	otherID := types.NewJID("1987654321", types.DefaultUserServer)
+	cli := NewClient(&store.Device{ID: &ownID}, nil)
+
+	receipt, err := cli.parseReceipt(&waBinary.Node{
+		Tag: "receipt",
+		Attrs: waBinary.Attrs{
+			"from":      types.NewADJID(ownID.User, 0, 0),
+			"recipient": otherID,
+			"id":        "3EB0C5A277F7F9B6C599",
+			"type":      "played",
+			"t":         "1640000100",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse receipt: %v", err)
+	}
+	if evts := cli.handleViewOnceOpenedReceipt(receipt); len(evts) != 0 {
+		t.Errorf("Expected no ViewOnceOpened events for message not known to be view-once, got %+v", evts)
+	}
+}
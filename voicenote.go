@@ -0,0 +1,171 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+const (
+	// VoiceNoteMimetype is the mime type that the official clients use for voice notes.
+	VoiceNoteMimetype = "audio/ogg; codecs=opus"
+	// WaveformLength is the number of samples in the waveform of a voice note.
+	WaveformLength = 64
+
+	// Opus granule positions are always in 48 kHz samples regardless of the input sample rate.
+	opusGranuleRate = 48000
+)
+
+type oggOpusInfo struct {
+	// The sizes of the audio packets (i.e. excluding the OpusHead and OpusTags header packets) in order.
+	packetSizes []int
+	// The duration of the audio, calculated from the last granule position and the pre-skip in the header.
+	duration time.Duration
+}
+
+// parseOggOpus reads the packet sizes and duration from an Ogg Opus file without decoding the audio.
+func parseOggOpus(data []byte) (*oggOpusInfo, error) {
+	var info oggOpusInfo
+	var packets [][]byte
+	var current []byte
+	var lastGranule int64
+	for ptr := 0; ptr < len(data); {
+		if len(data) < ptr+27 || !bytes.Equal(data[ptr:ptr+4], []byte("OggS")) {
+			return nil, ErrInvalidOggOpus
+		}
+		granule := int64(binary.LittleEndian.Uint64(data[ptr+6 : ptr+14]))
+		segmentCount := int(data[ptr+26])
+		segmentTable := ptr + 27
+		body := segmentTable + segmentCount
+		if len(data) < body {
+			return nil, ErrInvalidOggOpus
+		}
+		for _, lacing := range data[segmentTable:body] {
+			if len(data) < body+int(lacing) {
+				return nil, ErrInvalidOggOpus
+			}
+			current = append(current, data[body:body+int(lacing)]...)
+			body += int(lacing)
+			// Lacing values of 255 mean the packet continues in the next segment (possibly on the next page)
+			if lacing < 255 {
+				packets = append(packets, current)
+				current = nil
+			}
+		}
+		// Pages where no packet ends have a granule position of -1
+		if granule >= 0 {
+			lastGranule = granule
+		}
+		ptr = body
+	}
+	if len(packets) < 2 || len(packets[0]) < 19 || !bytes.HasPrefix(packets[0], []byte("OpusHead")) {
+		return nil, ErrInvalidOggOpus
+	}
+	preSkip := int64(binary.LittleEndian.Uint16(packets[0][10:12]))
+	if lastGranule > preSkip {
+		info.duration = time.Duration(lastGranule-preSkip) * time.Second / opusGranuleRate
+	}
+	info.packetSizes = make([]int, len(packets)-2)
+	for i, packet := range packets[2:] {
+		info.packetSizes[i] = len(packet)
+	}
+	return &info, nil
+}
+
+// ComputeWaveform computes the waveform that the official clients show for voice notes.
+// The result contains WaveformLength values between 0 and 100.
+//
+// The audio isn't decoded: Opus is a variable bitrate codec, so the size of each packet is used to approximate
+// the loudness of that part of the audio, which is close enough for visualization.
+func ComputeWaveform(oggOpusData []byte) ([]byte, error) {
+	info, err := parseOggOpus(oggOpusData)
+	if err != nil {
+		return nil, err
+	}
+	return waveformFromPacketSizes(info.packetSizes), nil
+}
+
+func waveformFromPacketSizes(sizes []int) []byte {
+	waveform := make([]byte, WaveformLength)
+	if len(sizes) == 0 {
+		return waveform
+	}
+	var averages [WaveformLength]float64
+	var max float64
+	for i := range averages {
+		start := i * len(sizes) / WaveformLength
+		end := (i + 1) * len(sizes) / WaveformLength
+		if end <= start {
+			end = start + 1
+		}
+		var sum int
+		for _, size := range sizes[start:end] {
+			sum += size
+		}
+		averages[i] = float64(sum) / float64(end-start)
+		if averages[i] > max {
+			max = averages[i]
+		}
+	}
+	if max == 0 {
+		return waveform
+	}
+	for i, avg := range averages {
+		waveform[i] = byte(avg / max * 100)
+	}
+	return waveform
+}
+
+// BuildVoiceNote uploads the given Ogg Opus file and builds a voice note (push-to-talk audio) message with it.
+// The built message can be sent normally using Client.SendMessage.
+//
+// If the duration is zero, it's calculated from the file. The waveform is always computed using ComputeWaveform.
+func (cli *Client) BuildVoiceNote(ctx context.Context, oggOpusData []byte, durationSeconds uint32) (*waProto.Message, error) {
+	info, err := parseOggOpus(oggOpusData)
+	if err != nil {
+		return nil, err
+	}
+	if durationSeconds == 0 {
+		durationSeconds = uint32((info.duration + time.Second/2) / time.Second)
+	}
+	uploaded, err := cli.Upload(ctx, oggOpusData, MediaAudio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload voice note: %w", err)
+	}
+	return &waProto.Message{AudioMessage: &waProto.AudioMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+		Mimetype:          proto.String(VoiceNoteMimetype),
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		Seconds:           proto.Uint32(durationSeconds),
+		Ptt:               proto.Bool(true),
+		Waveform:          waveformFromPacketSizes(info.packetSizes),
+	}}, nil
+}
+
+// SendVoiceNote uploads the given Ogg Opus file and sends it to the given chat as a voice note.
+// If the duration is zero, it's calculated from the file.
+func (cli *Client) SendVoiceNote(ctx context.Context, to types.JID, oggOpusData []byte, durationSeconds uint32) (SendResponse, error) {
+	msg, err := cli.BuildVoiceNote(ctx, oggOpusData, durationSeconds)
+	if err != nil {
+		return SendResponse{}, err
+	}
+	return cli.SendMessageContext(ctx, to, "", msg)
+}
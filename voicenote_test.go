@@ -0,0 +1,70 @@
+// Copyright (c) 2021 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+func makeOggPage(granule int64, packets ...[]byte) []byte {
+	page := make([]byte, 27)
+	copy(page[0:4], "OggS")
+	binary.LittleEndian.PutUint64(page[6:14], uint64(granule))
+	var body []byte
+	for _, packet := range packets {
+		size := len(packet)
+		for ; size >= 255; size -= 255 {
+			page = append(page, 255)
+		}
+		page = append(page, byte(size))
+		body = append(body, packet...)
+	}
+	page[26] = byte(len(page) - 27)
+	return append(page, body...)
+}
+
+func makeOggOpus(packetSizes []int) []byte {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	binary.LittleEndian.PutUint16(head[10:12], 312)
+	data := append(makeOggPage(0, head), makeOggPage(0, []byte("OpusTags"))...)
+	for i, size := range packetSizes {
+		// 20ms packets = 960 samples at 48 kHz
+		data = append(data, makeOggPage(312+int64(i+1)*960, make([]byte, size))...)
+	}
+	return data
+}
+
+func TestParseOggOpus(t *testing.T) {
+	sizes := make([]int, 500)
+	for i := range sizes {
+		sizes[i] = 10
+		if i >= 250 {
+			sizes[i] = 300
+		}
+	}
+	info, err := parseOggOpus(makeOggOpus(sizes))
+	if err != nil {
+		t.Fatalf("Failed to parse file: %v", err)
+	} else if info.duration != 10*time.Second {
+		t.Errorf("Expected 10s duration, got %s", info.duration)
+	} else if len(info.packetSizes) != 500 || info.packetSizes[499] != 300 {
+		t.Errorf("Unexpected packet sizes")
+	}
+	waveform := waveformFromPacketSizes(info.packetSizes)
+	if len(waveform) != WaveformLength || waveform[0] != 3 || waveform[WaveformLength-1] != 100 {
+		t.Errorf("Unexpected waveform %v", waveform)
+	}
+
+	_, err = parseOggOpus(makeOggPage(0, []byte("not opus"), []byte("tags")))
+	if !errors.Is(err, ErrInvalidOggOpus) {
+		t.Errorf("Expected ErrInvalidOggOpus for non-opus file, got %v", err)
+	}
+}